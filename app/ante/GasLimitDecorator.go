@@ -12,6 +12,7 @@ import (
 type EVMKeeper interface {
 	innertx.InnerTxKeeper
 	GetParams(ctx sdk.Context) evmtypes.Params
+	GetChainConfig(ctx sdk.Context) (evmtypes.ChainConfig, bool)
 	IsAddressBlocked(ctx sdk.Context, addr sdk.AccAddress) bool
 	IsMatchSysContractAddress(ctx sdk.Context, addr sdk.AccAddress) bool
 }
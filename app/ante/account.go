@@ -156,7 +156,7 @@ func ethGasConsume(ek EVMKeeper, ak accountKeeperInterface, sk types.SupplyKeepe
 	gasLimit := msgEthTx.GetGas()
 
 	if shouldIntrinsicGas(ek, ctx, msgEthTx) {
-		gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, []ethtypes.AccessTuple{}, msgEthTx.To() == nil, true, false)
+		gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, intrinsicAccessList(ek, ctx, msgEthTx), msgEthTx.To() == nil, true, false)
 		if err != nil {
 			return sdkerrors.Wrap(err, "failed to compute intrinsic gas cost")
 		}
@@ -197,6 +197,20 @@ func shouldIntrinsicGas(ek EVMKeeper, ctx *sdk.Context, msgEthTx *evmtypes.MsgEt
 	return !IsE2CTx(ek, ctx, msgEthTx)
 }
 
+// intrinsicAccessList returns the access list charged as intrinsic gas, which is only warmed up after berlin
+func intrinsicAccessList(ek EVMKeeper, ctx *sdk.Context, msgEthTx *evmtypes.MsgEthereumTx) ethtypes.AccessList {
+	if len(msgEthTx.Data.Accesses) == 0 {
+		return nil
+	}
+	currentGasmeter := ctx.GasMeter()
+	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	defer ctx.SetGasMeter(currentGasmeter)
+	if config, found := ek.GetChainConfig(*ctx); found && config.IsBerlin(big.NewInt(ctx.BlockHeight())) {
+		return msgEthTx.Data.Accesses
+	}
+	return nil
+}
+
 func IsE2CTx(ek EVMKeeper, ctx *sdk.Context, msgEthTx *evmtypes.MsgEthereumTx) bool {
 	currentGasmeter := ctx.GasMeter()
 	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
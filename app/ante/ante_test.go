@@ -12,13 +12,20 @@ import (
 
 	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
 	tmcrypto "github.com/okx/okbchain/libs/tendermint/crypto"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 
 	"github.com/okx/okbchain/app"
 	"github.com/okx/okbchain/app/ante"
 	"github.com/okx/okbchain/app/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	authztypes "github.com/okx/okbchain/x/authz/types"
 	evmtypes "github.com/okx/okbchain/x/evm/types"
+	feegranttypes "github.com/okx/okbchain/x/feegrant/types"
+	govante "github.com/okx/okbchain/x/gov/ante"
+	govtypes "github.com/okx/okbchain/x/gov/types"
+	stakingtypes "github.com/okx/okbchain/x/staking/types"
 )
 
 func requireValidTx(
@@ -89,6 +96,47 @@ func (suite *AnteTestSuite) TestValidTx() {
 	requireValidTx(suite.T(), suite.anteHandler, suite.ctx, tx, false)
 }
 
+func (suite *AnteTestSuite) TestFeeGrantedTx() {
+	suite.ctx.SetBlockHeight(1)
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+
+	grantee, priv := newTestAddrKey()
+	granter, _ := newTestAddrKey()
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, grantee)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	granterAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter)
+	_ = granterAcc.SetCoins(newTestCoins())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, granterAcc)
+
+	fee := newTestStdFee()
+	fee.Granter = granter
+	msgs := []sdk.Msg{newTestMsg(grantee)}
+	tx := newTestSDKTx(suite.ctx, msgs, []tmcrypto.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, fee)
+
+	// the granter pays nothing without an allowance
+	requireInvalidTx(suite.T(), suite.anteHandler, suite.ctx, tx, false)
+
+	allowance := feegranttypes.NewBasicAllowance(fee.Amount.Add(fee.Amount...), time.Time{})
+	err := suite.app.FeegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance)
+	suite.Require().NoError(err)
+
+	// the fee granter is rejected before venus8
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	requireInvalidTx(suite.T(), suite.anteHandler, suite.ctx, tx, false)
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+
+	requireValidTx(suite.T(), suite.anteHandler, suite.ctx, tx, false)
+	suite.Require().True(suite.app.AccountKeeper.GetAccount(suite.ctx, grantee).GetCoins().IsZero())
+	suite.Require().Equal(newTestCoins().Sub(fee.Amount), suite.app.AccountKeeper.GetAccount(suite.ctx, granter).GetCoins())
+
+	grant, found := suite.app.FeegrantKeeper.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().True(found)
+	suite.Require().Equal(fee.Amount, grant.Allowance.(*feegranttypes.BasicAllowance).SpendLimit)
+}
+
 func (suite *AnteTestSuite) TestSDKInvalidSigs() {
 	suite.ctx.SetBlockHeight(1)
 
@@ -258,7 +306,7 @@ func (suite *AnteTestSuite) TestEthInvalidMempoolFees() {
 	suite.ctx = suite.app.BaseApp.NewContext(true, abci.Header{Height: 1, ChainID: "ethermint-3", Time: time.Now().UTC()})
 	suite.app.EvmKeeper.SetParams(suite.ctx, evmtypes.DefaultParams())
 
-	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.SupplyKeeper, nil, suite.app.WasmHandler, suite.app.IBCKeeper, suite.app.StakingKeeper, suite.app.ParamsKeeper)
+	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.SupplyKeeper, suite.app.FeegrantKeeper, nil, suite.app.WasmHandler, suite.app.IBCKeeper, suite.app.StakingKeeper, suite.app.ParamsKeeper)
 	suite.ctx.SetMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.NativeToken, sdk.NewDecFromBigIntWithPrec(big.NewInt(500000), sdk.Precision))))
 	addr1, priv1 := newTestAddrKey()
 	addr2, _ := newTestAddrKey()
@@ -315,3 +363,26 @@ func (suite *AnteTestSuite) TestAnteHandlerSequences() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestAuthzExecValidatorOnlyProposal() {
+	suite.ctx.SetBlockHeight(1)
+
+	granter, _ := newTestAddrKey()
+	grantee, _ := newTestAddrKey()
+
+	content := stakingtypes.NewProposeValidatorProposal("title", "description", true, stakingtypes.ProposeValidator{})
+	proposal := govtypes.NewMsgSubmitProposal(content, newTestCoins(), granter)
+	decorator := govante.NewAnteDecorator(suite.app.StakingKeeper, suite.app.AccountKeeper, suite.app.ParamsKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, msg := range []sdk.Msg{
+		proposal,
+		authztypes.NewMsgExec(grantee, []sdk.Msg{proposal}),
+		authztypes.NewMsgExec(grantee, []sdk.Msg{authztypes.NewMsgExec(grantee, []sdk.Msg{proposal})}),
+	} {
+		// a non validator can't submit a validator only proposal, even on behalf of a granter
+		tx := auth.NewStdTx([]sdk.Msg{msg}, newTestStdFee(), nil, "")
+		_, err := decorator.AnteHandle(suite.ctx, tx, false, next)
+		suite.Require().Equal(stakingtypes.ErrCodeProposerMustBeValidator, err)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	icahost "github.com/okx/okbchain/libs/ibc-go/modules/apps/27-interchain-accounts/host"
 	"github.com/okx/okbchain/x/icamauth"
 
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/common"
 	ibccommon "github.com/okx/okbchain/libs/ibc-go/modules/core/common"
 
 	icacontrollertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/27-interchain-accounts/controller/types"
@@ -31,6 +32,12 @@ import (
 
 	icatypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/27-interchain-accounts/types"
 	ibcfeetypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/29-fee/types"
+	icqkeeper "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/keeper"
+	icqtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+	nfttransferkeeper "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/keeper"
+	nfttransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	packetforwardkeeper "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/keeper"
+	packetforwardtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -38,6 +45,9 @@ import (
 	"google.golang.org/grpc/encoding/proto"
 
 	ibcfee "github.com/okx/okbchain/libs/ibc-go/modules/apps/29-fee"
+	icq "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq"
+	nfttransfer "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer"
+	packetforward "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware"
 
 	"github.com/okx/okbchain/app/utils/appstatus"
 
@@ -59,6 +69,7 @@ import (
 	"github.com/okx/okbchain/libs/cosmos-sdk/version"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
 	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/bank"
 	capabilityModule "github.com/okx/okbchain/libs/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/keeper"
@@ -86,14 +97,22 @@ import (
 	sm "github.com/okx/okbchain/libs/tendermint/state"
 	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	dbm "github.com/okx/okbchain/libs/tm-db"
+	"github.com/okx/okbchain/x/authz"
+	authzkeeper "github.com/okx/okbchain/x/authz/keeper"
+	authztypes "github.com/okx/okbchain/x/authz/types"
+	authztypesadapter "github.com/okx/okbchain/x/authz/typesadapter"
 	commonversion "github.com/okx/okbchain/x/common/version"
 	distr "github.com/okx/okbchain/x/distribution"
 	"github.com/okx/okbchain/x/erc20"
 	erc20client "github.com/okx/okbchain/x/erc20/client"
+	"github.com/okx/okbchain/x/erc721"
 	"github.com/okx/okbchain/x/evidence"
 	"github.com/okx/okbchain/x/evm"
 	evmclient "github.com/okx/okbchain/x/evm/client"
 	evmtypes "github.com/okx/okbchain/x/evm/types"
+	"github.com/okx/okbchain/x/feegrant"
+	feegrantkeeper "github.com/okx/okbchain/x/feegrant/keeper"
+	feegranttypes "github.com/okx/okbchain/x/feegrant/types"
 	"github.com/okx/okbchain/x/feesplit"
 	fsclient "github.com/okx/okbchain/x/feesplit/client"
 	"github.com/okx/okbchain/x/genutil"
@@ -185,24 +204,32 @@ var (
 		feesplit.AppModuleBasic{},
 		ica.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
+		erc721.AppModuleBasic{},
+		icq.AppModuleBasic{},
 		icamauth.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:       nil,
-		distr.ModuleName:            nil,
-		mint.ModuleName:             {supply.Minter},
-		staking.BondedPoolName:      {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName:   {supply.Burner, supply.Staking},
-		gov.ModuleName:              nil,
-		token.ModuleName:            {supply.Minter, supply.Burner},
-		ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-		erc20.ModuleName:            {authtypes.Minter, authtypes.Burner},
-		wasm.ModuleName:             nil,
-		feesplit.ModuleName:         nil,
-		ibcfeetypes.ModuleName:      nil,
-		icatypes.ModuleName:         nil,
+		auth.FeeCollectorName:            nil,
+		distr.ModuleName:                 nil,
+		mint.ModuleName:                  {supply.Minter},
+		staking.BondedPoolName:           {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:        {supply.Burner, supply.Staking},
+		staking.TokenizedDepositPoolName: {supply.Minter, supply.Burner},
+		gov.ModuleName:                   nil,
+		token.ModuleName:                 {supply.Minter, supply.Burner},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		erc20.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		wasm.ModuleName:                  nil,
+		feesplit.ModuleName:              nil,
+		ibcfeetypes.ModuleName:           nil,
+		icatypes.ModuleName:              nil,
 	}
 
 	onceLog              sync.Once
@@ -245,6 +272,8 @@ type OKBChainApp struct {
 	WasmPermissionKeeper wasm.ContractOpsKeeper
 	InfuraKeeper         infura.Keeper
 	FeeSplitKeeper       feesplit.Keeper
+	AuthzKeeper          authzkeeper.Keeper
+	FeegrantKeeper       feegrantkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -254,20 +283,26 @@ type OKBChainApp struct {
 
 	configurator module.Configurator
 	// ibc
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedIBCMockKeeper  capabilitykeeper.ScopedKeeper
-	TransferKeeper       ibctransferkeeper.Keeper
-	CapabilityKeeper     *capabilitykeeper.Keeper
-	IBCKeeper            *ibc.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	IBCFeeKeeper         ibcfeekeeper.Keeper
-	marshal              *codec.CodecProxy
-	heightTasks          map[int64]*upgradetypes.HeightTasks
-	Erc20Keeper          erc20.Keeper
-	ICAMauthKeeper       icamauthkeeper.Keeper
-	ICAControllerKeeper  icacontrollerkeeper.Keeper
-	ICAHostKeeper        icahostkeeper.Keeper
-	VMBridgeKeeper       *vmbridge.Keeper
+	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper    capabilitykeeper.ScopedKeeper
+	ScopedIBCMockKeeper     capabilitykeeper.ScopedKeeper
+	TransferKeeper          ibctransferkeeper.Keeper
+	ScopedNFTTransferKeeper capabilitykeeper.ScopedKeeper
+	NFTTransferKeeper       nfttransferkeeper.Keeper
+	ScopedICQKeeper         capabilitykeeper.ScopedKeeper
+	ICQKeeper               icqkeeper.Keeper
+	CapabilityKeeper        *capabilitykeeper.Keeper
+	IBCKeeper               *ibc.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	IBCFeeKeeper            ibcfeekeeper.Keeper
+	PacketForwardKeeper     packetforwardkeeper.Keeper
+	marshal                 *codec.CodecProxy
+	heightTasks             map[int64]*upgradetypes.HeightTasks
+	Erc20Keeper             erc20.Keeper
+	Erc721Keeper            erc721.Keeper
+	ICAMauthKeeper          icamauthkeeper.Keeper
+	ICAControllerKeeper     icacontrollerkeeper.Keeper
+	ICAHostKeeper           icahostkeeper.Keeper
+	VMBridgeKeeper          *vmbridge.Keeper
 
 	WasmHandler wasmkeeper.HandlerOption
 }
@@ -313,6 +348,11 @@ func NewOKBChainApp(
 		feesplit.StoreKey,
 		icacontrollertypes.StoreKey, icahosttypes.StoreKey, ibcfeetypes.StoreKey,
 		icamauthtypes.StoreKey,
+		packetforwardtypes.StoreKey,
+		nfttransfertypes.StoreKey, erc721.StoreKey,
+		icqtypes.StoreKey,
+		authztypes.StoreKey,
+		feegranttypes.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -348,6 +388,7 @@ func NewOKBChainApp(
 	app.subspaces[feesplit.ModuleName] = app.ParamsKeeper.Subspace(feesplit.ModuleName)
 	app.subspaces[icacontrollertypes.SubModuleName] = app.ParamsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	app.subspaces[icahosttypes.SubModuleName] = app.ParamsKeeper.Subspace(icahosttypes.SubModuleName)
+	app.subspaces[icqtypes.ModuleName] = app.ParamsKeeper.Subspace(icqtypes.ModuleName)
 
 	//proxy := codec.NewMarshalProxy(cc, cdc)
 	app.marshal = codecProxy
@@ -411,6 +452,8 @@ func NewOKBChainApp(
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	scopedICAMauthKeeper := app.CapabilityKeeper.ScopeToModule(icamauthtypes.ModuleName)
+	scopedNFTTransferKeeper := app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
+	scopedICQKeeper := app.CapabilityKeeper.ScopeToModule(icqtypes.ModuleName)
 
 	v2keeper := ibc.NewKeeper(
 		codecProxy, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), &stakingKeeper, app.UpgradeKeeper, &scopedIBCKeeper, interfaceReg,
@@ -448,6 +491,13 @@ func NewOKBChainApp(
 		supplyKeeperAdapter, scopedICAHostKeeper, app.MsgServiceRouter(),
 	)
 
+	// Interchain query keeper, host queries are answered through the grpc query router
+	app.ICQKeeper = icqkeeper.NewKeeper(
+		codecProxy, keys[icqtypes.StoreKey], app.GetSubspace(icqtypes.ModuleName),
+		app.IBCKeeper.V2Keeper.ChannelKeeper, &app.IBCKeeper.V2Keeper.PortKeeper,
+		scopedICQKeeper, app.GRPCQueryRouter(),
+	)
+
 	app.ICAMauthKeeper = icamauthkeeper.NewKeeper(
 		codecProxy,
 		keys[icamauthtypes.StoreKey],
@@ -456,13 +506,17 @@ func NewOKBChainApp(
 	)
 
 	app.Erc20Keeper = erc20.NewKeeper(app.marshal.GetCdc(), app.keys[erc20.ModuleName], app.subspaces[erc20.ModuleName],
-		app.AccountKeeper, app.SupplyKeeper, app.BankKeeper, app.EvmKeeper, app.TransferKeeper)
+		app.AccountKeeper, app.SupplyKeeper, app.BankKeeper, app.EvmKeeper, app.TransferKeeper, v2keeper.ChannelKeeper)
 
 	app.FeeSplitKeeper = feesplit.NewKeeper(
 		app.keys[feesplit.StoreKey], app.marshal.GetCdc(), app.subspaces[feesplit.ModuleName],
 		app.EvmKeeper, app.SupplyKeeper, app.AccountKeeper)
 	app.ParamsKeeper.RegisterSignal(feesplit.SetParamsNeedUpdate)
 
+	// the msgs executed on behalf of granters are dispatched by the baseapp router
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], app.marshal.GetCdc(), app.Router())
+	app.FeegrantKeeper = feegrantkeeper.NewKeeper(keys[feegranttypes.StoreKey], app.marshal.GetCdc(), &app.AccountKeeper)
+
 	//wasm keeper
 	wasmDir := wasm.WasmDir()
 	wasmConfig := wasm.WasmConfig()
@@ -477,6 +531,8 @@ func NewOKBChainApp(
 		app.subspaces[wasm.ModuleName],
 		&app.AccountKeeper,
 		bank.NewBankKeeperAdapter(app.BankKeeper),
+		stakingKeeper,
+		app.DistrKeeper,
 		v2keeper.ChannelKeeper,
 		&v2keeper.PortKeeper,
 		nil,
@@ -486,10 +542,18 @@ func NewOKBChainApp(
 		wasmDir,
 		wasmConfig,
 		supportedFeatures,
-		vmbridge.GetWasmOpts(app.marshal.GetProtocMarshal()),
+		vmbridge.GetWasmOpts(app.marshal.GetProtocMarshal(), app.EvmKeeper)...,
 	)
 	(&app.WasmKeeper).SetInnerTxKeeper(app.EvmKeeper)
 
+	// ICS-721 tokens are held by erc721 and cw721 contracts, the voucher classes are mapped to module erc721 contracts
+	app.Erc721Keeper = erc721.NewKeeper(app.marshal.GetCdc(), keys[erc721.StoreKey], app.AccountKeeper, app.EvmKeeper, &app.WasmKeeper)
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		codecProxy, keys[nfttransfertypes.StoreKey],
+		v2keeper.ChannelKeeper, &v2keeper.PortKeeper,
+		&app.Erc721Keeper, scopedNFTTransferKeeper,
+	)
+
 	app.ParamsKeeper.RegisterSignal(wasm.SetNeedParamsUpdate)
 
 	// register the proposal types
@@ -528,14 +592,44 @@ func NewOKBChainApp(
 	app.FeeSplitKeeper.SetGovKeeper(app.GovKeeper)
 	app.DistrKeeper.SetGovKeeper(app.GovKeeper)
 
+	wasmModule := wasm.NewAppModule(*app.marshal, &app.WasmKeeper)
+	app.WasmPermissionKeeper = wasmModule.GetPermissionKeeper()
+	app.Erc721Keeper.SetWasmKeeper(app.WasmPermissionKeeper)
+	app.Erc20Keeper.SetWasmKeeper(app.WasmPermissionKeeper)
+
 	// Set IBC hooks
 	app.TransferKeeper = *app.TransferKeeper.SetHooks(erc20.NewIBCTransferHooks(app.Erc20Keeper))
 	transferModule := ibctransfer.NewAppModule(app.TransferKeeper, codecProxy)
 
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(codecProxy, keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper, v2keeper.ChannelKeeper, supplyKeeperAdapter,
+		app.IBCFeeKeeper, // the acknowledgements of the forwarded packets are written through the fee middleware
+	)
+
 	middle := ibctransfer.NewIBCModule(app.TransferKeeper, transferModule)
 	right := ibcfee.NewIBCMiddleware(middle, app.IBCFeeKeeper)
+	// the packets are forwarded and the contracts sending the transfers are called back from venus8 on
+	forwardRight := ibcfee.NewIBCMiddleware(
+		erc20.NewIBCMiddleware(
+			packetforward.NewIBCMiddleware(middle, app.PacketForwardKeeper,
+				packetforward.DefaultRetriesOnTimeout, packetforward.DefaultForwardTransferPacketTimeout),
+			app.Erc20Keeper, erc20.DefaultCallbackGasLimit,
+		),
+		app.IBCFeeKeeper,
+	)
 	transferStack := ibcporttypes.NewFacadedMiddleware(middle,
 		ibccommon.DefaultFactory(tmtypes.HigherThanVenus4, ibc.IBCV4, right),
+		ibccommon.DefaultFactory(tmtypes.HigherThanVenus8, ibc.IBCV8, forwardRight),
+	)
+	nftTransferStack := ibcporttypes.NewFacadedMiddleware(common.NewDisaleProxyMiddleware(),
+		ibccommon.DefaultFactory(tmtypes.HigherThanVenus8, ibc.IBCV8, nfttransfer.NewIBCModule(app.NFTTransferKeeper)),
+	)
+
+	app.VMBridgeKeeper = vmbridge.NewKeeper(app.marshal, app.Logger(), app.EvmKeeper, app.WasmPermissionKeeper, app.AccountKeeper, app.BankKeeper, app.ICQKeeper)
+	// the icq module is copied into the router, so the callbacks must be set before the route is added
+	app.ICQKeeper.SetCallbacks(vmbridge.NewInterchainQueryCallbacks(*app.VMBridgeKeeper), icq.DefaultCallbackGasLimit)
+	icqStack := ibcporttypes.NewFacadedMiddleware(common.NewDisaleProxyMiddleware(),
+		ibccommon.DefaultFactory(tmtypes.HigherThanVenus8, ibc.IBCV8, icq.NewIBCModule(app.ICQKeeper)),
 	)
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -553,6 +647,8 @@ func NewOKBChainApp(
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	ibcRouter.AddRoute(icamauthtypes.ModuleName, icaControllerStack)
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nftTransferStack)
+	ibcRouter.AddRoute(icqtypes.ModuleName, icqStack)
 
 	//ibcRouter.AddRoute(ibcmock.ModuleName, mockModule)
 	v2keeper.SetRouter(ibcRouter)
@@ -563,10 +659,15 @@ func NewOKBChainApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
-	wasmModule := wasm.NewAppModule(*app.marshal, &app.WasmKeeper)
-	app.WasmPermissionKeeper = wasmModule.GetPermissionKeeper()
-	app.VMBridgeKeeper = vmbridge.NewKeeper(app.marshal, app.Logger(), app.EvmKeeper, app.WasmPermissionKeeper, app.AccountKeeper, app.BankKeeper)
-	app.EvmKeeper.SetCallToCM(vmbridge.PrecompileHooks(app.VMBridgeKeeper))
+	app.EvmKeeper.SetCallToCM(
+		evm.NewPrecompileHooks(
+			vmbridge.PrecompileHooks(app.VMBridgeKeeper),
+			staking.NewPrecompile(app.StakingKeeper),
+			distr.NewPrecompile(app.DistrKeeper),
+			gov.NewPrecompile(app.GovKeeper),
+			erc20.NewPrecompile(app.Erc20Keeper),
+		),
+	)
 	// Set EVM hooks
 	app.EvmKeeper.SetHooks(
 		evm.NewMultiEvmHooks(
@@ -575,6 +676,7 @@ func NewOKBChainApp(
 				erc20.NewSendNative20ToIbcEventHandler(app.Erc20Keeper),
 				vmbridge.NewSendToWasmEventHandler(*app.VMBridgeKeeper),
 				vmbridge.NewCallToWasmEventHandler(*app.VMBridgeKeeper),
+				vmbridge.NewSendInterchainQueryEventHandler(*app.VMBridgeKeeper),
 			),
 			app.FeeSplitKeeper.Hooks(),
 		),
@@ -606,8 +708,15 @@ func NewOKBChainApp(
 		wasmModule,
 		feesplit.NewAppModule(app.FeeSplitKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper),
+		erc721.NewAppModule(app.Erc721Keeper),
+		icq.NewAppModule(app.ICQKeeper),
 		ica.NewAppModule(codecProxy, &app.ICAControllerKeeper, &app.ICAHostKeeper),
 		icamauth.NewAppModule(codecProxy, app.ICAMauthKeeper),
+		authz.NewAppModule(app.AuthzKeeper),
+		feegrant.NewAppModule(app.FeegrantKeeper),
+		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -651,6 +760,11 @@ func NewOKBChainApp(
 		wasm.ModuleName,
 		feesplit.ModuleName,
 		icatypes.ModuleName, ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		nfttransfertypes.ModuleName, erc721.ModuleName,
+		icqtypes.ModuleName,
+		authztypes.ModuleName,
+		feegranttypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -693,9 +807,9 @@ func NewOKBChainApp(
 		WasmConfig:        &wasmConfig,
 		TXCounterStoreKey: keys[wasm.StoreKey],
 	}
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(), app.WasmHandler, app.IBCKeeper, app.StakingKeeper, app.ParamsKeeper))
+	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, app.FeegrantKeeper, validateMsgHook(), app.WasmHandler, app.IBCKeeper, app.StakingKeeper, app.ParamsKeeper))
 	app.SetEndBlocker(app.EndBlocker)
-	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper))
+	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper, app.FeegrantKeeper))
 	app.SetAccNonceHandler(NewAccNonceHandler(app.AccountKeeper))
 
 	app.SetUpdateWasmTxCount(fixCosmosTxCountInWasmForParallelTx(app.WasmHandler.TXCounterStoreKey))
@@ -710,6 +824,18 @@ func NewOKBChainApp(
 	app.SetGetGasConfigHandler(NewGetGasConfigHandler(app.ParamsKeeper))
 	app.SetGetBlockConfigHandler(NewGetBlockConfigHandler(app.ParamsKeeper))
 	mpt.AccountStateRootRetriever = app.AccountKeeper
+
+	// must be before Loading version
+	// requires the snapshot store to be created and registered as a BaseAppOption
+	if manager := app.SnapshotManager(); manager != nil {
+		err := manager.RegisterExtensions(
+			wasmkeeper.NewWasmSnapshotter(app.GetCMS(), &app.WasmKeeper),
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		err := app.LoadLatestVersion(app.keys[bam.MainStoreKey])
 		if err != nil {
@@ -727,6 +853,8 @@ func NewOKBChainApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
+	app.ScopedICQKeeper = scopedICQKeeper
 
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
@@ -750,6 +878,9 @@ func (app *OKBChainApp) InitUpgrade(ctx sdk.Context) {
 		tmtypes.InitMilestoneVenus7Height(int64(info.EffectiveHeight))
 		app.WasmKeeper.UpdateMilestone(ctx, "wasm_v1", info.EffectiveHeight)
 	})
+	app.ParamsKeeper.ClaimReadyForUpgrade(tmtypes.MILESTONE_VENUS8_NAME, func(info paramstypes.UpgradeInfo) {
+		tmtypes.InitMilestoneVenus8Height(int64(info.EffectiveHeight))
+	})
 	if err := app.ParamsKeeper.ApplyEffectiveUpgrade(ctx); err != nil {
 		tmos.Exit(fmt.Sprintf("failed apply effective upgrade height info: %s", err))
 	}
@@ -869,11 +1000,13 @@ func validateMsgHook() ante.ValidateMsgHandler {
 		var err error
 
 		for _, msg := range msgs {
-			switch msg.(type) {
+			switch msg := msg.(type) {
 			case *evmtypes.MsgEthereumTx:
 				if len(msgs) > 1 {
 					return wrongMsgErr
 				}
+			case authztypes.MsgExec, *authztypesadapter.MsgExec:
+				err = validateAuthzExecMsgs([]sdk.Msg{msg})
 			}
 
 			if err != nil {
@@ -884,6 +1017,29 @@ func validateMsgHook() ante.ValidateMsgHandler {
 	}
 }
 
+// validateAuthzExecMsgs forbids the execution of evm txs on behalf of a granter
+func validateAuthzExecMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *evmtypes.MsgEthereumTx:
+			return sdk.ErrUnknownRequest("It is not allowed to execute an evm message on behalf of a granter")
+		case authztypes.MsgExec:
+			if err := validateAuthzExecMsgs(msg.Msgs); err != nil {
+				return err
+			}
+		case *authztypesadapter.MsgExec:
+			execMsg, err := msg.ToMsgExec()
+			if err != nil {
+				return err
+			}
+			if err = validateAuthzExecMsgs(execMsg.Msgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func NewAccNonceHandler(ak auth.AccountKeeper) sdk.AccNonceHandler {
 	return func(
 		ctx sdk.Context, addr sdk.AccAddress,
@@ -121,8 +121,8 @@ func getTxFeeAndFromHandler(ek appante.EVMKeeper) sdk.GetTxFeeAndFromHandler {
 					needUpdateTXCounter = true
 				}
 				txMsgs := tx.GetMsgs()
-				// only support one message
-				if len(txMsgs) == 1 {
+				// only support one message, paid by its sender
+				if len(txMsgs) == 1 && authante.GetFeeGranter(tx).Empty() {
 					if msg, ok := txMsgs[0].(interface{ CalFromAndToForPara() (string, string) }); ok {
 						from, to = msg.CalFromAndToForPara()
 						if types.HigherThanMercury(ctx.BlockHeight()) {
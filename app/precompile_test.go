@@ -0,0 +1,238 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/libs/tendermint/crypto/ed25519"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/distribution"
+	distrtypes "github.com/okx/okbchain/x/distribution/types"
+	"github.com/okx/okbchain/x/erc20"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+	"github.com/okx/okbchain/x/gov"
+	govtypes "github.com/okx/okbchain/x/gov/types"
+	"github.com/okx/okbchain/x/staking"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	app    *OKBChainApp
+	caller common.Address
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	suite.app = Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-3", Time: time.Now().UTC()})
+
+	suite.caller = ethsecp256k1.GenerateAddress()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, suite.caller.Bytes())
+	suite.Require().NoError(acc.SetCoins(sdk.NewCoins(coin100)))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	params := suite.app.StakingKeeper.GetParams(suite.ctx)
+	params.EnableDposOp = true
+	suite.app.StakingKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *PrecompileTestSuite) TearDownTest() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) callPrecompile(data []byte) (*evmtypes.ResultData, error) {
+	suite.ctx.SetGasMeter(sdk.NewGasMeter(1000000))
+	_, result, err := suite.app.VMBridgeKeeper.CallEvm(suite.ctx, suite.caller, &evmtypes.PrecompileAddress, big.NewInt(0), data)
+	suite.ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	return result, err
+}
+
+func (suite *PrecompileTestSuite) balance() sdk.Dec {
+	return suite.app.AccountKeeper.GetAccount(suite.ctx, suite.caller.Bytes()).GetCoins().AmountOf(sdk.DefaultBondDenom)
+}
+
+func (suite *PrecompileTestSuite) TestBeforeVenus8() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	content := govtypes.NewTextProposal("Test", "description")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.Require().NoError(err)
+
+	// the precompiles are not dispatched to before venus8
+	data, err := staking.PrecompileABI.Pack(staking.PrecompileDeposit, sdk.NewDec(60).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+	_, found := suite.app.StakingKeeper.GetDelegator(suite.ctx, suite.caller.Bytes())
+	suite.Require().False(found)
+
+	data, err = distribution.PrecompileABI.Pack(distribution.PrecompileWithdrawDelegatorReward,
+		sdk.ValAddress(suite.caller.Bytes()).String())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+
+	data, err = gov.PrecompileABI.Pack(gov.PrecompileDeposit, proposal.ProposalID, sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+	_, found = suite.app.GovKeeper.GetDeposit(suite.ctx, proposal.ProposalID, suite.caller.Bytes())
+	suite.Require().False(found)
+
+	// the ics-20 transfer is passed to the vmbridge, which doesn't know the method
+	data, err = erc20.PrecompileABI.Pack(erc20.PrecompileTransfer, "transfer", "channel-0", sdk.DefaultBondDenom,
+		sdk.NewDec(10).BigInt(), "receiver", clienttypes.NewHeight(0, 100), uint64(0), "")
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "no method with id")
+	suite.Require().Equal(sdk.NewDec(100), suite.balance())
+}
+
+func (suite *PrecompileTestSuite) TestStakingDepositAndWithdraw() {
+	data, err := staking.PrecompileABI.Pack(staking.PrecompileDeposit, sdk.NewDec(60).BigInt())
+	suite.Require().NoError(err)
+	result, err := suite.callPrecompile(data)
+	suite.Require().NoError(err)
+	ret, err := staking.PrecompileABI.Unpack(staking.PrecompileDeposit, result.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{true}, ret)
+
+	delegator, found := suite.app.StakingKeeper.GetDelegator(suite.ctx, suite.caller.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(60), delegator.Tokens)
+	suite.Require().Equal(sdk.NewDec(40), suite.balance())
+
+	data, err = staking.PrecompileABI.Pack(staking.PrecompileWithdraw, sdk.NewDec(20).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().NoError(err)
+	delegator, found = suite.app.StakingKeeper.GetDelegator(suite.ctx, suite.caller.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(40), delegator.Tokens)
+}
+
+func (suite *PrecompileTestSuite) TestStakingDepositFailed() {
+	// the failed precompile call reverts all the changes
+	data, err := staking.PrecompileABI.Pack(staking.PrecompileDeposit, sdk.NewDec(200).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+
+	_, found := suite.app.StakingKeeper.GetDelegator(suite.ctx, suite.caller.Bytes())
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewDec(100), suite.balance())
+
+	data, err = staking.PrecompileABI.Pack(staking.PrecompileAddShares, []string{"invalid"})
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+}
+
+func (suite *PrecompileTestSuite) TestDistributionWithdrawDelegatorReward() {
+	// no rewards can be withdrawn from an unknown validator
+	unknown := sdk.ValAddress(ethsecp256k1.GenerateAddress().Bytes())
+	data, err := distribution.PrecompileABI.Pack(distribution.PrecompileWithdrawDelegatorReward, unknown.String())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.app.DistrKeeper.SetDistributionType(suite.ctx, distrtypes.DistributionTypeOnChain)
+	params := suite.app.StakingKeeper.GetParams(suite.ctx)
+	params.MinSelfDelegation = sdk.NewDec(1)
+	suite.app.StakingKeeper.SetParams(suite.ctx, params)
+
+	valOwner := ethsecp256k1.GenerateAddress()
+	valAcc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, valOwner.Bytes())
+	suite.Require().NoError(valAcc.SetCoins(sdk.NewCoins(coin100)))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, valAcc)
+	valAddr := sdk.ValAddress(valOwner.Bytes())
+	stakingHandler := staking.NewHandler(suite.app.StakingKeeper)
+	_, err = stakingHandler(suite.ctx, staking.NewMsgCreateValidator(valAddr, ed25519.GenPrivKey().PubKey(),
+		staking.Description{}, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(1))))
+	suite.Require().NoError(err)
+	suite.ctx.SetBlockTime(suite.ctx.BlockTime().Add(48 * time.Hour))
+	_, err = stakingHandler(suite.ctx, staking.NewMsgEditValidatorCommissionRate(valAddr, sdk.NewDecWithPrec(5, 1)))
+	suite.Require().NoError(err)
+
+	// the caller delegates to the validator through the staking precompile
+	data, err = staking.PrecompileABI.Pack(staking.PrecompileDeposit, sdk.NewDec(50).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().NoError(err)
+	data, err = staking.PrecompileABI.Pack(staking.PrecompileAddShares, []string{valAddr.String()})
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().NoError(err)
+
+	// half of the rewards allocated to the validator in the next block goes to the delegators
+	suite.ctx.SetBlockHeight(suite.ctx.BlockHeight() + 1)
+	rewards := sdk.SysCoins{sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(20))}
+	suite.Require().NoError(suite.app.SupplyKeeper.SendCoinsFromAccountToModule(suite.ctx, valOwner.Bytes(),
+		distribution.ModuleName, rewards))
+	suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, suite.app.StakingKeeper.Validator(suite.ctx, valAddr), rewards)
+
+	before := suite.balance()
+	data, err = distribution.PrecompileABI.Pack(distribution.PrecompileWithdrawDelegatorReward, valAddr.String())
+	suite.Require().NoError(err)
+	result, err := suite.callPrecompile(data)
+	suite.Require().NoError(err)
+	ret, err := distribution.PrecompileABI.Unpack(distribution.PrecompileWithdrawDelegatorReward, result.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]interface{}{true}, ret)
+	suite.Require().True(suite.balance().GT(before))
+	suite.Require().True(suite.balance().LTE(before.Add(sdk.NewDec(10))))
+}
+
+func (suite *PrecompileTestSuite) TestGovDepositAndVote() {
+	content := govtypes.NewTextProposal("Test", "description")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.Require().NoError(err)
+
+	data, err := gov.PrecompileABI.Pack(gov.PrecompileDeposit, proposal.ProposalID, sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().NoError(err)
+
+	deposit, found := suite.app.GovKeeper.GetDeposit(suite.ctx, proposal.ProposalID, suite.caller.Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(10), deposit.Amount.AmountOf(sdk.DefaultBondDenom))
+	suite.Require().Equal(sdk.NewDec(90), suite.balance())
+
+	// the proposal is not in voting period yet
+	data, err = gov.PrecompileABI.Pack(gov.PrecompileVote, proposal.ProposalID, uint8(govtypes.OptionYes))
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+}
+
+func (suite *PrecompileTestSuite) TestErc20Transfer() {
+	// the channel doesn't exist
+	data, err := erc20.PrecompileABI.Pack(erc20.PrecompileTransfer, "transfer", "channel-0", sdk.DefaultBondDenom,
+		sdk.NewDec(10).BigInt(), "receiver", clienttypes.NewHeight(0, 100), uint64(0), "memo")
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(100), suite.balance())
+
+	// invalid receiver
+	data, err = erc20.PrecompileABI.Pack(erc20.PrecompileTransfer, "transfer", "channel-0", sdk.DefaultBondDenom,
+		sdk.NewDec(10).BigInt(), "", clienttypes.NewHeight(0, 100), uint64(0), "")
+	suite.Require().NoError(err)
+	_, err = suite.callPrecompile(data)
+	suite.Require().Error(err)
+}
@@ -16,8 +16,8 @@ import (
 	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 )
 
-func NewGasRefundHandler(ak auth.AccountKeeper, sk types.SupplyKeeper, ik innertx.InnerTxKeeper) sdk.GasRefundHandler {
-	evmGasRefundHandler := NewGasRefundDecorator(ak, sk, ik)
+func NewGasRefundHandler(ak auth.AccountKeeper, sk types.SupplyKeeper, ik innertx.InnerTxKeeper, fk types.FeegrantKeeper) sdk.GasRefundHandler {
+	evmGasRefundHandler := NewGasRefundDecorator(ak, sk, ik, fk)
 
 	return func(
 		ctx sdk.Context, tx sdk.Tx,
@@ -41,10 +41,11 @@ type Handler struct {
 	ak           keeper.AccountKeeper
 	supplyKeeper types.SupplyKeeper
 	ik           innertx.InnerTxKeeper
+	fk           types.FeegrantKeeper
 }
 
 func (handler Handler) GasRefund(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, error) {
-	return gasRefund(handler.ik, handler.ak, handler.supplyKeeper, ctx, tx)
+	return gasRefund(handler.ik, handler.ak, handler.supplyKeeper, handler.fk, ctx, tx)
 }
 
 type accountKeeperInterface interface {
@@ -52,7 +53,7 @@ type accountKeeperInterface interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) exported.Account
 }
 
-func gasRefund(ik innertx.InnerTxKeeper, ak accountKeeperInterface, sk types.SupplyKeeper, ctx sdk.Context, tx sdk.Tx) (refundGasFee sdk.Coins, err error) {
+func gasRefund(ik innertx.InnerTxKeeper, ak accountKeeperInterface, sk types.SupplyKeeper, fk types.FeegrantKeeper, ctx sdk.Context, tx sdk.Tx) (refundGasFee sdk.Coins, err error) {
 	currentGasMeter := ctx.GasMeter()
 	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
 
@@ -80,7 +81,13 @@ func gasRefund(ik innertx.InnerTxKeeper, ak accountKeeperInterface, sk types.Sup
 		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
+	// the unused fee goes back to whoever paid it
 	feePayer := feeTx.FeePayer(ctx)
+	grantee := feePayer
+	feeGranter := ante.GetFeeGranter(tx)
+	if !feeGranter.Empty() {
+		feePayer = feeGranter
+	}
 	feePayerAcc := ak.GetAccount(ctx, feePayer)
 	if feePayerAcc == nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
@@ -102,14 +109,20 @@ func gasRefund(ik innertx.InnerTxKeeper, ak accountKeeperInterface, sk types.Sup
 	}
 	ak.SetAccount(ctx, feePayerAcc)
 
+	// the fee paid by a granter was deducted from the allowance of the grantee
+	if fk != nil && !feeGranter.Empty() && !feeGranter.Equals(grantee) {
+		fk.RefundGrantedFees(ctx, feeGranter, grantee, gasFees)
+	}
+
 	return gasFees, nil
 }
 
-func NewGasRefundDecorator(ak auth.AccountKeeper, sk types.SupplyKeeper, ik innertx.InnerTxKeeper) sdk.GasRefundHandler {
+func NewGasRefundDecorator(ak auth.AccountKeeper, sk types.SupplyKeeper, ik innertx.InnerTxKeeper, fk types.FeegrantKeeper) sdk.GasRefundHandler {
 	chandler := Handler{
 		ak:           ak,
 		supplyKeeper: sk,
 		ik:           ik,
+		fk:           fk,
 	}
 	return chandler.GasRefund
 }
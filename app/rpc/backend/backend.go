@@ -100,6 +100,7 @@ type EthermintBackend struct {
 	logsTimeout       int // timeout second
 	blockCache        *lru.Cache
 	pruneEverything   bool
+	metrics           *Metrics
 }
 
 // New creates a new EthermintBackend instance
@@ -118,6 +119,7 @@ func New(clientCtx clientcontext.CLIContext, log log.Logger, rateLimiters map[st
 		logsLimit:         viper.GetInt(FlagLogsLimit),
 		logsTimeout:       viper.GetInt(FlagLogsTimeout),
 		pruneEverything:   viper.GetString(server.FlagPruning) == types.PruningOptionEverything,
+		metrics:           GetMetrics(),
 	}
 	b.blockCache, _ = lru.New(blockCacheSize)
 	return b
@@ -605,12 +607,15 @@ func (b *EthermintBackend) ConvertToBlockNumber(blockNumberOrHash rpctypes.Block
 func (b *EthermintBackend) cacheBlock(block *coretypes.ResultBlock) {
 	if b.blockCache != nil {
 		b.blockCache.Add(block.Block.Height, block)
+		b.metrics.observeSize(cacheResultBlock, b.blockCache.Len())
 	}
 }
 
 func (b *EthermintBackend) getBlockFromCache(height int64) *coretypes.ResultBlock {
 	if b.blockCache != nil {
-		if v, ok := b.blockCache.Get(height); ok {
+		v, ok := b.blockCache.Get(height)
+		b.metrics.observeGet(cacheResultBlock, ok)
+		if ok {
 			return v.(*coretypes.ResultBlock)
 		}
 	}
@@ -25,6 +25,7 @@ type LruCache struct {
 	lruBlock           *lru.Cache
 	lruBlockInfo       *lru.Cache
 	lruBlockWithFullTx *lru.Cache
+	metrics            *Metrics
 }
 
 func NewLruCache() *LruCache {
@@ -55,6 +56,7 @@ func NewLruCache() *LruCache {
 		lruBlock:           lruBlock,
 		lruBlockInfo:       lruBlockInfo,
 		lruBlockWithFullTx: lruBlockWithFullTx,
+		metrics:            GetMetrics(),
 	}
 }
 
@@ -70,8 +72,10 @@ func (lc *LruCache) GetBlockByHash(hash common.Hash, fullTx bool) (*evmtypes.Blo
 	var ok bool
 	if fullTx {
 		data, ok = lc.lruBlockWithFullTx.Get(hash)
+		lc.metrics.observeGet(cacheBlockWithFullTx, ok)
 	} else {
 		data, ok = lc.lruBlock.Get(hash)
+		lc.metrics.observeGet(cacheBlock, ok)
 	}
 	if !ok {
 		return nil, ErrLruDataNotFound
@@ -85,8 +89,10 @@ func (lc *LruCache) GetBlockByHash(hash common.Hash, fullTx bool) (*evmtypes.Blo
 func (lc *LruCache) AddOrUpdateBlock(hash common.Hash, block *evmtypes.Block, fullTx bool) {
 	if fullTx {
 		lc.lruBlockWithFullTx.PeekOrAdd(hash, block)
+		lc.metrics.observeSize(cacheBlockWithFullTx, lc.lruBlockWithFullTx.Len())
 	} else {
 		lc.lruBlock.PeekOrAdd(hash, block)
+		lc.metrics.observeSize(cacheBlock, lc.lruBlock.Len())
 	}
 	lc.AddOrUpdateBlockHash(uint64(block.Number), hash)
 	if block.Transactions != nil && fullTx {
@@ -100,6 +106,7 @@ func (lc *LruCache) AddOrUpdateBlock(hash common.Hash, block *evmtypes.Block, fu
 }
 func (lc *LruCache) GetTransaction(hash common.Hash) (*watcher.Transaction, error) {
 	data, ok := lc.lruTx.Get(hash)
+	lc.metrics.observeGet(cacheTx, ok)
 	if !ok {
 		return nil, ErrLruDataNotFound
 	}
@@ -111,9 +118,11 @@ func (lc *LruCache) GetTransaction(hash common.Hash) (*watcher.Transaction, erro
 }
 func (lc *LruCache) AddOrUpdateTransaction(hash common.Hash, tx *watcher.Transaction) {
 	lc.lruTx.PeekOrAdd(hash, tx)
+	lc.metrics.observeSize(cacheTx, lc.lruTx.Len())
 }
 func (lc *LruCache) GetBlockHash(number uint64) (common.Hash, error) {
 	data, ok := lc.lruBlockInfo.Get(number)
+	lc.metrics.observeGet(cacheBlockHash, ok)
 	if !ok {
 		return common.Hash{}, ErrLruDataNotFound
 	}
@@ -125,4 +134,5 @@ func (lc *LruCache) GetBlockHash(number uint64) (common.Hash, error) {
 }
 func (lc *LruCache) AddOrUpdateBlockHash(number uint64, hash common.Hash) {
 	lc.lruBlockInfo.PeekOrAdd(number, hash)
+	lc.metrics.observeSize(cacheBlockHash, lc.lruBlockInfo.Len())
 }
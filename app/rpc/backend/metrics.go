@@ -0,0 +1,87 @@
+package backend
+
+import (
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/prometheus"
+	"github.com/okx/okbchain/app/rpc/monitor"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "rpc_backend"
+
+	// MetricsCacheLabel distinguishes the caches of the backend
+	MetricsCacheLabel = "cache"
+
+	cacheTx              = "tx"
+	cacheBlock           = "block"
+	cacheBlockWithFullTx = "block_full_tx"
+	cacheBlockHash       = "block_hash"
+	cacheResultBlock     = "result_block"
+)
+
+var (
+	backendMetrics     *Metrics
+	initBackendMetrics sync.Once
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of cache hits, labeled by cache.
+	CacheHits metrics.Counter
+	// Number of cache misses, labeled by cache.
+	CacheMisses metrics.Counter
+	// Number of entries in a cache, labeled by cache.
+	CacheSize metrics.Gauge
+}
+
+// GetMetrics returns the Metrics of the backend caches, which are shared by
+// all the backend instances so that they are registered only once.
+func GetMetrics() *Metrics {
+	initBackendMetrics.Do(func() {
+		backendMetrics = PrometheusMetrics(monitor.MetricsNamespace)
+	})
+	return backendMetrics
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		CacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of cache hits of the rpc backend.",
+		}, []string{MetricsCacheLabel}),
+		CacheMisses: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of cache misses of the rpc backend.",
+		}, []string{MetricsCacheLabel}),
+		CacheSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_size",
+			Help:      "Number of entries in a cache of the rpc backend.",
+		}, []string{MetricsCacheLabel}),
+	}
+}
+
+// observeGet records the result of a lookup in cache.
+func (m *Metrics) observeGet(cache string, hit bool) {
+	if hit {
+		m.CacheHits.With(MetricsCacheLabel, cache).Add(1)
+	} else {
+		m.CacheMisses.With(MetricsCacheLabel, cache).Add(1)
+	}
+}
+
+// observeSize records the number of entries in cache.
+func (m *Metrics) observeSize(cache string, size int) {
+	m.CacheSize.With(MetricsCacheLabel, cache).Set(float64(size))
+}
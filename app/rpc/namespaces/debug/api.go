@@ -3,6 +3,7 @@ package debug
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/spf13/viper"
@@ -12,6 +13,9 @@ import (
 
 	"github.com/okx/okbchain/app/rpc/backend"
 	"github.com/okx/okbchain/app/rpc/monitor"
+	rpctypes "github.com/okx/okbchain/app/rpc/types"
+	ethermint "github.com/okx/okbchain/app/types"
+	authclient "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
 	"github.com/okx/okbchain/libs/tendermint/libs/log"
 
 	evmtypes "github.com/okx/okbchain/x/evm/types"
@@ -23,18 +27,25 @@ const (
 
 // PublicTxPoolAPI offers and API for the transaction pool. It only operates on data that is non confidential.
 type PublicDebugAPI struct {
-	clientCtx clientcontext.CLIContext
-	logger    log.Logger
-	backend   backend.Backend
-	Metrics   *monitor.RpcMetrics
+	clientCtx    clientcontext.CLIContext
+	chainIDEpoch *big.Int
+	logger       log.Logger
+	backend      backend.Backend
+	Metrics      *monitor.RpcMetrics
 }
 
 // NewPublicTxPoolAPI creates a new tx pool service that gives information about the transaction pool.
 func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicDebugAPI {
+	epoch, err := ethermint.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		panic(err)
+	}
+
 	api := &PublicDebugAPI{
-		clientCtx: clientCtx,
-		backend:   backend,
-		logger:    log.With("module", "json-rpc", "namespace", "debug"),
+		clientCtx:    clientCtx,
+		chainIDEpoch: epoch,
+		backend:      backend,
+		logger:       log.With("module", "json-rpc", "namespace", "debug"),
 	}
 	if viper.GetBool(monitor.FlagEnableMonitor) {
 		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
@@ -83,3 +94,147 @@ func (api *PublicDebugAPI) TraceTransaction(txHash common.Hash, config evmtypes.
 
 	return decodedResult, nil
 }
+
+// TraceBlockByNumber returns the structured logs created during the execution of
+// EVM of all the transactions in the block with the given number.
+func (api *PublicDebugAPI) TraceBlockByNumber(blockNum rpctypes.BlockNumber, config evmtypes.TraceConfig) ([]sdk.TraceTxResult, error) {
+	monitor := monitor.GetMonitor("debug_traceBlockByNumber", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+	height := blockNum.Int64()
+	switch blockNum {
+	case rpctypes.PendingBlockNumber:
+		return nil, fmt.Errorf("tracing pending block is not supported")
+	case rpctypes.LatestBlockNumber:
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+	return api.traceBlock(height, config)
+}
+
+// TraceBlockByHash returns the structured logs created during the execution of
+// EVM of all the transactions in the block with the given hash.
+func (api *PublicDebugAPI) TraceBlockByHash(hash common.Hash, config evmtypes.TraceConfig) ([]sdk.TraceTxResult, error) {
+	monitor := monitor.GetMonitor("debug_traceBlockByHash", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+	blockNum, err := api.backend.ConvertToBlockNumber(rpctypes.BlockNumberOrHashWithHash(hash, false))
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(blockNum.Int64(), config)
+}
+
+func (api *PublicDebugAPI) traceBlock(height int64, config evmtypes.TraceConfig) ([]sdk.TraceTxResult, error) {
+	err := evmtypes.TestTracerConfig(&config)
+	if err != nil {
+		return nil, fmt.Errorf("tracer err : %s", err.Error())
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	queryParam := sdk.QueryTraceBlock{
+		Height:      height,
+		ConfigBytes: configBytes,
+	}
+	queryBytes, err := json.Marshal(&queryParam)
+	if err != nil {
+		return nil, err
+	}
+	resTrace, _, err := api.clientCtx.QueryWithData("app/traceBlock", queryBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []sdk.TraceTxResult
+	if err := json.Unmarshal(resTrace, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TraceCall returns the structured logs created during the execution of EVM
+// of the given call on the state of the given block, the call is not sent to the chain.
+func (api *PublicDebugAPI) TraceCall(args rpctypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceCallConfig) (interface{}, error) {
+	monitor := monitor.GetMonitor("debug_traceCall", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("args", args, "block number", blockNrOrHash)
+
+	if config == nil {
+		config = &evmtypes.TraceCallConfig{}
+	}
+	err := evmtypes.TestTracerConfig(&config.TraceConfig)
+	if err != nil {
+		return nil, fmt.Errorf("tracer err : %s", err.Error())
+	}
+	configBytes, err := json.Marshal(config.TraceConfig)
+	if err != nil {
+		return nil, err
+	}
+	var overridesBytes []byte
+	if config.StateOverrides != nil {
+		if err := config.StateOverrides.Check(); err != nil {
+			return nil, err
+		}
+		if overridesBytes, err = config.StateOverrides.GetBytes(); err != nil {
+			return nil, fmt.Errorf("fail to encode overrides")
+		}
+	}
+
+	blockNr, err := api.backend.ConvertToBlockNumber(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	clientCtx := api.clientCtx
+	// pass the given block height to the context if the height is not pending or latest
+	if !(blockNr == rpctypes.PendingBlockNumber || blockNr == rpctypes.LatestBlockNumber) {
+		clientCtx = api.clientCtx.WithHeight(blockNr.Int64())
+	}
+
+	txBytes, from, err := buildCallTx(args, api.chainIDEpoch)
+	if err != nil {
+		return nil, err
+	}
+	queryParam := sdk.QueryTraceCall{
+		TxBytes:        txBytes,
+		OverridesBytes: overridesBytes,
+		ConfigBytes:    configBytes,
+	}
+	queryBytes, err := json.Marshal(&queryParam)
+	if err != nil {
+		return nil, err
+	}
+	resTrace, _, err := clientCtx.QueryWithData(fmt.Sprintf("app/traceCall/%s", from.String()), queryBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var res sdk.Result
+	if err := clientCtx.Codec.UnmarshalBinaryBare(resTrace, &res); err != nil {
+		return nil, err
+	}
+	var decodedResult interface{}
+	if err := json.Unmarshal(res.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// buildCallTx generates the unsigned evm tx bytes of the call, the same way with eth_call
+func buildCallTx(args rpctypes.CallArgs, chainID *big.Int) ([]byte, common.Address, error) {
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+
+	msg := rpctypes.NewCallMsg(args, chainID, 0, big.NewInt(ethermint.DefaultRPCGasLimit))
+	txEncoder := authclient.GetTxEncoder(nil, authclient.WithEthereumTx())
+	// rlp encoder need pointer type, amino encoder will first dereference pointers.
+	txBytes, err := txEncoder(msg)
+	if err != nil {
+		return nil, from, err
+	}
+	return txBytes, from, nil
+}
@@ -0,0 +1,39 @@
+package debug
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/okx/okbchain/app/rpc/types"
+	ethermint "github.com/okx/okbchain/app/types"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+func TestBuildCallTx(t *testing.T) {
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	gas := hexutil.Uint64(ethermint.DefaultRPCGasLimit + 1)
+	data := hexutil.Bytes{0x1, 0x2}
+
+	txBytes, sender, err := buildCallTx(rpctypes.CallArgs{From: &from, To: &to, Gas: &gas, Data: &data}, big.NewInt(65))
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+
+	var msg evmtypes.MsgEthereumTx
+	require.NoError(t, authtypes.EthereumTxDecode(txBytes, &msg))
+	// the gas is capped by the rpc gas cap like eth_call
+	require.Equal(t, uint64(ethermint.DefaultRPCGasLimit), msg.Data.GasLimit)
+	require.Equal(t, new(big.Int).SetUint64(ethermint.DefaultGasPrice), msg.Data.Price)
+	require.Equal(t, &to, msg.Data.Recipient)
+	require.Equal(t, []byte(data), msg.Data.Payload)
+
+	// the sender is empty if not given
+	_, sender, err = buildCallTx(rpctypes.CallArgs{To: &to}, big.NewInt(65))
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, sender)
+}
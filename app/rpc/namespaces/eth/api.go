@@ -53,6 +53,7 @@ import (
 	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/erc20"
 	"github.com/okx/okbchain/x/evm"
+	"github.com/okx/okbchain/x/evm/tracers/native"
 	evmtypes "github.com/okx/okbchain/x/evm/types"
 	"github.com/okx/okbchain/x/evm/watcher"
 	"github.com/okx/okbchain/x/vmbridge"
@@ -69,6 +70,9 @@ const (
 	EvmDefaultGasLimit = uint64(21000)
 
 	FlagAllowUnprotectedTxs = "rpc.allow-unprotected-txs"
+
+	// MaxAccessListIterations caps the traces of eth_createAccessList waiting for the access list to settle
+	MaxAccessListIterations = 16
 )
 
 // PublicEthereumAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec.
@@ -358,6 +362,21 @@ func (api *PublicEthereumAPI) accounts() ([]common.Address, error) {
 	return addresses, nil
 }
 
+// spendableBalance returns the balance of the account which is not locked by its vesting schedule
+// at the time of the block.
+func (api *PublicEthereumAPI) spendableBalance(account *ethermint.EthAccount, blockNum rpctypes.BlockNumber) (*big.Int, error) {
+	if account.VestingSchedule == nil {
+		return account.Balance(sdk.DefaultBondDenom).BigInt(), nil
+	}
+
+	header, err := api.backend.HeaderByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	blockTime := time.Unix(int64(header.Time), 0).UTC()
+	return account.SpendableBalance(sdk.DefaultBondDenom, blockTime).BigInt(), nil
+}
+
 // BlockNumber returns the current block number.
 func (api *PublicEthereumAPI) BlockNumber() (hexutil.Uint64, error) {
 	monitor := monitor.GetMonitor("eth_blockNumber", api.logger, api.Metrics).OnBegin()
@@ -377,7 +396,10 @@ func (api *PublicEthereumAPI) GetBalance(address common.Address, blockNrOrHash r
 	if useWatchBackend {
 		acc, err := api.wrappedBackend.MustGetAccount(address.Bytes())
 		if err == nil {
-			balance := acc.GetCoins().AmountOf(sdk.DefaultBondDenom).BigInt()
+			balance, err := api.spendableBalance(acc, blockNum)
+			if err != nil {
+				return nil, err
+			}
 			if balance == nil {
 				return (*hexutil.Big)(sdk.ZeroInt().BigInt()), nil
 			}
@@ -411,7 +433,10 @@ func (api *PublicEthereumAPI) GetBalance(address common.Address, blockNrOrHash r
 		return nil, err
 	}
 
-	val := account.Balance(sdk.DefaultBondDenom).BigInt()
+	val, err := api.spendableBalance(&account, blockNum)
+	if err != nil {
+		return nil, err
+	}
 	if useWatchBackend {
 		api.watcherBackend.CommitAccountToRpcDb(account)
 	}
@@ -916,29 +941,6 @@ func (api *PublicEthereumAPI) doCall(
 		clientCtx = api.clientCtx.WithHeight(blockNum.Int64())
 	}
 
-	// Set default gas & gas price if none were set
-	// Change this to uint64(math.MaxUint64 / 2) if gas cap can be configured
-	gas := uint64(ethermint.DefaultRPCGasLimit)
-	if args.Gas != nil {
-		gas = uint64(*args.Gas)
-	}
-	if globalGasCap != nil && globalGasCap.Uint64() < gas {
-		api.logger.Debug("Caller gas above allowance, capping", "requested", gas, "cap", globalGasCap)
-		gas = globalGasCap.Uint64()
-	}
-
-	// Set gas price using default or parameter if passed in
-	gasPrice := new(big.Int).SetUint64(ethermint.DefaultGasPrice)
-	if args.GasPrice != nil {
-		gasPrice = args.GasPrice.ToInt()
-	}
-
-	// Set value for transaction
-	value := new(big.Int)
-	if args.Value != nil {
-		value = args.Value.ToInt()
-	}
-
 	// Set Data if provided
 	var data []byte
 	if args.Data != nil {
@@ -958,7 +960,7 @@ func (api *PublicEthereumAPI) doCall(
 	}
 
 	// Create new call message
-	msg := evmtypes.NewMsgEthereumTx(nonce, args.To, value, gas, gasPrice, data)
+	msg := rpctypes.NewCallMsg(args, api.chainIDEpoch, nonce, globalGasCap)
 	var overridesBytes []byte
 	if overrides != nil {
 		if overridesBytes, err = overrides.GetBytes(); err != nil {
@@ -1035,6 +1037,7 @@ func (api *PublicEthereumAPI) doCall(
 
 	return &simResponse, nil
 }
+
 func (api *PublicEthereumAPI) simDoCall(args rpctypes.CallArgs, cap uint64) (uint64, error) {
 	// Create a helper to check if a gas allowance results in an executable transaction
 	executable := func(gas uint64) (*sdk.SimulationResponse, error) {
@@ -1127,6 +1130,128 @@ func (api *PublicEthereumAPI) EstimateGas(args rpctypes.CallArgs) (hexutil.Uint6
 	return hexutil.Uint64(gas), nil
 }
 
+// CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
+// BlockNrOrHash can be specified to create the accessList on top of a certain state, pending by default.
+func (api *PublicEthereumAPI) CreateAccessList(args rpctypes.CallArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error) {
+	monitor := monitor.GetMonitor("eth_createAccessList", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("args", args, "block number", blockNrOrHash)
+
+	bNrOrHash := rpctypes.BlockNumberOrHashWithNumber(rpctypes.PendingBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	blockNr, err := api.backend.ConvertToBlockNumber(bNrOrHash)
+	if err != nil {
+		return nil, TransformDataError(err, "eth_createAccessList")
+	}
+	acl, gasUsed, vmErr, err := api.accessList(args, blockNr)
+	if err != nil {
+		return nil, TransformDataError(err, "eth_createAccessList")
+	}
+	return &rpctypes.AccessListResult{Accesslist: &acl, Error: vmErr, GasUsed: hexutil.Uint64(gasUsed)}, nil
+}
+
+// accessList traces the call with the accessListTracer repeatedly until the access list
+// of the tx stops changing, as the access list itself changes the gas and the touched slots.
+// It gives up after MaxAccessListIterations traces.
+func (api *PublicEthereumAPI) accessList(args rpctypes.CallArgs, blockNr rpctypes.BlockNumber) (ethtypes.AccessList, uint64, string, error) {
+	clientCtx := api.clientCtx
+	// pass the given block height to the context if the height is not pending or latest
+	if !(blockNr == rpctypes.PendingBlockNumber || blockNr == rpctypes.LatestBlockNumber) {
+		clientCtx = api.clientCtx.WithHeight(blockNr.Int64())
+	}
+
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+	if args.GasPrice == nil || args.GasPrice.ToInt().Sign() <= 0 {
+		args.GasPrice = api.gasPrice
+	}
+
+	prevTracer := ethtypes.AccessList{}
+	if args.AccessList != nil {
+		prevTracer = *args.AccessList
+	}
+	txEncoder := authclient.GetTxEncoder(nil, authclient.WithEthereumTx())
+	for i := 0; i < MaxAccessListIterations; i++ {
+		// Retrieve the current access list to expand
+		accessList := prevTracer
+		args.AccessList = &accessList
+
+		msg := rpctypes.NewCallMsg(args, api.chainIDEpoch, 0, big.NewInt(ethermint.DefaultRPCGasLimit))
+		txBytes, err := txEncoder(msg)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		tracerConfig, err := json.Marshal(map[string]interface{}{"accessList": accessList})
+		if err != nil {
+			return nil, 0, "", err
+		}
+		configBytes, err := json.Marshal(evmtypes.TraceConfig{Tracer: native.AccessListTracerName, TracerConfig: tracerConfig})
+		if err != nil {
+			return nil, 0, "", err
+		}
+		queryBytes, err := json.Marshal(&sdk.QueryTraceCall{TxBytes: txBytes, ConfigBytes: configBytes})
+		if err != nil {
+			return nil, 0, "", err
+		}
+		resTrace, _, err := clientCtx.QueryWithData(fmt.Sprintf("app/traceCall/%s", from.String()), queryBytes)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		var res sdk.Result
+		if err := clientCtx.Codec.UnmarshalBinaryBare(resTrace, &res); err != nil {
+			return nil, 0, "", err
+		}
+		var traced rpctypes.AccessListResult
+		if err := json.Unmarshal(res.Data, &traced); err != nil {
+			return nil, 0, "", err
+		}
+		acl := ethtypes.AccessList{}
+		if traced.Accesslist != nil {
+			acl = *traced.Accesslist
+		}
+		if accessListEqual(acl, prevTracer) {
+			return acl, uint64(traced.GasUsed), traced.Error, nil
+		}
+		prevTracer = acl
+	}
+	return nil, 0, "", fmt.Errorf("access list is not settled after %d iterations", MaxAccessListIterations)
+}
+
+// accessListEqual returns whether the two access lists contain the same addresses and slots.
+func accessListEqual(a, b ethtypes.AccessList) bool {
+	slots := func(acl ethtypes.AccessList) map[common.Address]map[common.Hash]struct{} {
+		m := make(map[common.Address]map[common.Hash]struct{}, len(acl))
+		for _, tuple := range acl {
+			if _, ok := m[tuple.Address]; !ok {
+				m[tuple.Address] = make(map[common.Hash]struct{})
+			}
+			for _, key := range tuple.StorageKeys {
+				m[tuple.Address][key] = struct{}{}
+			}
+		}
+		return m
+	}
+	ma, mb := slots(a), slots(b)
+	if len(ma) != len(mb) {
+		return false
+	}
+	for addr, keysA := range ma {
+		keysB, ok := mb[addr]
+		if !ok || len(keysA) != len(keysB) {
+			return false
+		}
+		for key := range keysA {
+			if _, ok := keysB[key]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // GetBlockByHash returns the block identified by hash.
 func (api *PublicEthereumAPI) GetBlockByHash(hash common.Hash, fullTx bool) (*evmtypes.Block, error) {
 	monitor := monitor.GetMonitor("eth_getBlockByHash", api.logger, api.Metrics).OnBegin()
@@ -86,6 +86,9 @@ type CallArgs struct {
 	GasPrice *hexutil.Big    `json:"gasPrice"`
 	Value    *hexutil.Big    `json:"value"`
 	Data     *hexutil.Bytes  `json:"data"`
+
+	// AccessList makes the call an EIP-2930 access list tx
+	AccessList *ethtypes.AccessList `json:"accessList,omitempty"`
 }
 
 func (ca CallArgs) String() string {
@@ -108,9 +111,23 @@ func (ca CallArgs) String() string {
 	if ca.Data != nil {
 		arg += fmt.Sprintf("Data: %s, ", ca.Data.String())
 	}
+	if ca.AccessList != nil {
+		for _, tuple := range *ca.AccessList {
+			arg += fmt.Sprintf("AccessList: %s %v, ", tuple.Address.String(), tuple.StorageKeys)
+		}
+	}
 	return strings.TrimRight(arg, ", ")
 }
 
+// AccessListResult returns an optional accesslist
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	Accesslist *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
 // EthHeaderWithBlockHash represents a block header in the Ethereum blockchain with block hash generated from Tendermint Block
 type EthHeaderWithBlockHash struct {
 	ParentHash  common.Hash         `json:"parentHash"`
@@ -12,6 +12,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	ethermint "github.com/okx/okbchain/app/types"
 	clientcontext "github.com/okx/okbchain/libs/cosmos-sdk/client/context"
 	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
@@ -58,6 +59,7 @@ func ToTransaction(tx *evmtypes.MsgEthereumTx, from *common.Address) *watcher.Tr
 		R:        (*hexutil.Big)(tx.Data.R),
 		S:        (*hexutil.Big)(tx.Data.S),
 	}
+	rpcTx.SetTypedTxFields(tx)
 	return rpcTx
 }
 
@@ -331,6 +333,7 @@ func RawTxResultToEthReceipt(chainID *big.Int, tr *ctypes.ResultTx, realTx sdk.T
 		TransactionIndex: hexutil.Uint64(tr.Index),
 		From:             ethTx.GetFrom(),
 		To:               ethTx.To(),
+		Type:             hexutil.Uint64(ethTx.Data.Type),
 	}
 
 	rpcTx, err := watcher.NewTransaction(ethTx, common.BytesToHash(tr.Hash),
@@ -365,3 +368,38 @@ func GetEthSender(tr *ctypes.ResultTx) (string, error) {
 	}
 	return "", errors.New("No sender in Event")
 }
+
+// NewCallMsg creates the unsigned evm tx of the call. The default gas and gas price are used if none were set, and the
+// gas is capped by the globalGasCap if it's not nil
+func NewCallMsg(args CallArgs, chainID *big.Int, nonce uint64, globalGasCap *big.Int) *evmtypes.MsgEthereumTx {
+	// Set default gas & gas price if none were set
+	gas := uint64(ethermint.DefaultRPCGasLimit)
+	if args.Gas != nil {
+		gas = uint64(*args.Gas)
+	}
+	if globalGasCap != nil && globalGasCap.Uint64() < gas {
+		gas = globalGasCap.Uint64()
+	}
+
+	// Set gas price using default or parameter if passed in
+	gasPrice := new(big.Int).SetUint64(ethermint.DefaultGasPrice)
+	if args.GasPrice != nil {
+		gasPrice = args.GasPrice.ToInt()
+	}
+
+	// Set value for transaction
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+
+	var data []byte
+	if args.Data != nil {
+		data = []byte(*args.Data)
+	}
+
+	if args.AccessList != nil {
+		return evmtypes.NewMsgEthereumTxAccessList(chainID, nonce, args.To, value, gas, gasPrice, data, *args.AccessList)
+	}
+	return evmtypes.NewMsgEthereumTx(nonce, args.To, value, gas, gasPrice, data)
+}
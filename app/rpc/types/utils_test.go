@@ -0,0 +1,51 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	ethermint "github.com/okx/okbchain/app/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+func TestNewCallMsg(t *testing.T) {
+	chainID := big.NewInt(65)
+	to := common.HexToAddress("0x1")
+	data := hexutil.Bytes{0x1, 0x2}
+
+	// defaults
+	msg := NewCallMsg(CallArgs{To: &to, Data: &data}, chainID, 1, nil)
+	require.Equal(t, uint64(1), msg.Data.AccountNonce)
+	require.Equal(t, uint64(ethermint.DefaultRPCGasLimit), msg.Data.GasLimit)
+	require.Equal(t, new(big.Int).SetUint64(ethermint.DefaultGasPrice), msg.Data.Price)
+	require.Equal(t, big.NewInt(0), msg.Data.Amount)
+	require.Equal(t, []byte(data), msg.Data.Payload)
+	require.Equal(t, &to, msg.Data.Recipient)
+	require.Equal(t, uint8(evmtypes.LegacyTxType), msg.Data.Type)
+
+	// the given gas above the cap is capped
+	gas := hexutil.Uint64(ethermint.DefaultRPCGasLimit * 2)
+	gasPrice := (*hexutil.Big)(big.NewInt(2))
+	value := (*hexutil.Big)(big.NewInt(3))
+	args := CallArgs{To: &to, Gas: &gas, GasPrice: gasPrice, Value: value}
+	msg = NewCallMsg(args, chainID, 0, big.NewInt(ethermint.DefaultRPCGasLimit))
+	require.Equal(t, uint64(ethermint.DefaultRPCGasLimit), msg.Data.GasLimit)
+	require.Equal(t, big.NewInt(2), msg.Data.Price)
+	require.Equal(t, big.NewInt(3), msg.Data.Amount)
+
+	// the given gas below the cap is kept
+	msg = NewCallMsg(args, chainID, 0, nil)
+	require.Equal(t, uint64(gas), msg.Data.GasLimit)
+
+	// access list tx
+	accessList := ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{0x1}}}}
+	msg = NewCallMsg(CallArgs{To: &to, AccessList: &accessList}, chainID, 0, nil)
+	require.Equal(t, uint8(evmtypes.AccessListTxType), msg.Data.Type)
+	require.Equal(t, chainID, msg.Data.ChainID)
+	require.Equal(t, accessList, msg.Data.Accesses)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/exported"
 	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
 	"github.com/tendermint/go-amino"
 )
 
@@ -33,15 +34,18 @@ func init() {
 
 // EthAccount implements the auth.Account interface and embeds an
 // auth.BaseAccount type. It is compatible with the auth.AccountKeeper.
+// The account vests its coins if it has a vesting schedule.
 type EthAccount struct {
 	*authtypes.BaseAccount `json:"base_account" yaml:"base_account"`
-	CodeHash               []byte      `json:"code_hash" yaml:"code_hash"`
-	StateRoot              ethcmn.Hash `json:"state_root" yaml:"state_root"` // merkle root of the storage trie
+	CodeHash               []byte                        `json:"code_hash" yaml:"code_hash"`
+	StateRoot              ethcmn.Hash                   `json:"state_root" yaml:"state_root"` // merkle root of the storage trie
+	VestingSchedule        *vestingtypes.VestingSchedule `json:"vesting_schedule,omitempty" yaml:"vesting_schedule,omitempty"`
 }
 
 func (acc *EthAccount) UnmarshalFromAmino(cdc *amino.Codec, data []byte) error {
 	var dataLen uint64 = 0
 	var baseAccountFlag bool
+	var vestingScheduleFlag bool
 
 	for {
 		data = data[dataLen:]
@@ -89,6 +93,13 @@ func (acc *EthAccount) UnmarshalFromAmino(cdc *amino.Codec, data []byte) error {
 			copy(acc.CodeHash, subData)
 		case 3:
 			acc.StateRoot.SetBytes(subData)
+		case 4:
+			vestingScheduleFlag = true
+			acc.VestingSchedule = &vestingtypes.VestingSchedule{}
+			err = vestingtypes.VestingCdc.UnmarshalBinaryBare(subData, acc.VestingSchedule)
+			if err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -96,6 +107,9 @@ func (acc *EthAccount) UnmarshalFromAmino(cdc *amino.Codec, data []byte) error {
 	if !baseAccountFlag {
 		acc.BaseAccount = nil
 	}
+	if !vestingScheduleFlag {
+		acc.VestingSchedule = nil
+	}
 	return nil
 }
 
@@ -117,6 +131,10 @@ func (acc EthAccount) Copy() sdk.Account {
 	cacc.ethAccount.BaseAccount = &cacc.baseAccount
 	cacc.ethAccount.CodeHash = acc.CodeHash
 	cacc.ethAccount.StateRoot = acc.StateRoot
+	if acc.VestingSchedule != nil {
+		schedule := *acc.VestingSchedule
+		cacc.ethAccount.VestingSchedule = &schedule
+	}
 
 	return &cacc.ethAccount
 }
@@ -144,6 +162,13 @@ func (acc EthAccount) AminoSize(cdc *amino.Codec) int {
 		size += 1 + amino.ByteSliceSize(acc.CodeHash)
 	}
 	size += 1 + amino.ByteSliceSize(acc.StateRoot.Bytes())
+	if acc.VestingSchedule != nil {
+		bz, err := vestingtypes.VestingCdc.MarshalBinaryBare(acc.VestingSchedule)
+		if err != nil {
+			panic(err)
+		}
+		size += 1 + amino.ByteSliceSize(bz)
+	}
 	return size
 }
 
@@ -191,6 +216,19 @@ func (acc EthAccount) MarshalAminoTo(cdc *amino.Codec, buf *bytes.Buffer) error
 		return err
 	}
 
+	// field 4
+	if acc.VestingSchedule != nil {
+		const pbKey = 4<<3 | 2
+		bz, err := vestingtypes.VestingCdc.MarshalBinaryBare(acc.VestingSchedule)
+		if err != nil {
+			return err
+		}
+		err = amino.EncodeByteSliceWithKeyToBuffer(buf, bz, pbKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -247,6 +285,8 @@ type ethermintAccountPretty struct {
 	Sequence      uint64         `json:"sequence" yaml:"sequence"`
 	CodeHash      string         `json:"code_hash" yaml:"code_hash"`
 	StateRoot     string         `josn:"state_root" yaml:"state_root"`
+
+	VestingSchedule *vestingtypes.VestingSchedule `json:"vesting_schedule,omitempty" yaml:"vesting_schedule,omitempty"`
 }
 
 // MarshalYAML returns the YAML representation of an account.
@@ -259,6 +299,8 @@ func (acc EthAccount) MarshalYAML() (interface{}, error) {
 		Sequence:      acc.Sequence,
 		CodeHash:      ethcmn.Bytes2Hex(acc.CodeHash),
 		StateRoot:     acc.StateRoot.String(),
+
+		VestingSchedule: acc.VestingSchedule,
 	}
 
 	var err error
@@ -294,6 +336,8 @@ func (acc EthAccount) MarshalJSON() ([]byte, error) {
 		Sequence:      acc.Sequence,
 		CodeHash:      ethcmn.Bytes2Hex(acc.CodeHash),
 		StateRoot:     acc.StateRoot.String(),
+
+		VestingSchedule: acc.VestingSchedule,
 	}
 
 	var err error
@@ -358,6 +402,7 @@ func (acc *EthAccount) UnmarshalJSON(bz []byte) error {
 	}
 	acc.CodeHash = ethcmn.Hex2Bytes(alias.CodeHash)
 	acc.StateRoot = ethcmn.HexToHash(alias.StateRoot)
+	acc.VestingSchedule = alias.VestingSchedule
 
 	if alias.PubKey != "" {
 		acc.BaseAccount.PubKey, err = sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, alias.PubKey)
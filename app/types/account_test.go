@@ -14,6 +14,7 @@ import (
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/exported"
 	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
 	tmcrypto "github.com/okx/okbchain/libs/tendermint/crypto"
 	"github.com/okx/okbchain/libs/tendermint/crypto/ed25519"
 	tmamino "github.com/okx/okbchain/libs/tendermint/crypto/encoding/amino"
@@ -215,6 +216,7 @@ func TestEthAccountAmino(t *testing.T) {
 			),
 			ethcrypto.Keccak256(nil),
 			ethtypes.EmptyRootHash,
+			nil,
 		},
 		{
 			auth.NewBaseAccount(
@@ -226,6 +228,7 @@ func TestEthAccountAmino(t *testing.T) {
 			),
 			ethcrypto.Keccak256(nil),
 			ethtypes.EmptyRootHash,
+			nil,
 		},
 		{
 			auth.NewBaseAccount(
@@ -237,10 +240,34 @@ func TestEthAccountAmino(t *testing.T) {
 			),
 			ethcrypto.Keccak256(nil),
 			ethtypes.EmptyRootHash,
+			nil,
 		},
 		{
 			BaseAccount: &auth.BaseAccount{},
 		},
+		{
+			BaseAccount: auth.NewBaseAccount(
+				addr,
+				sdk.NewCoins(NewPhotonCoin(sdk.NewInt(100))),
+				pubKey,
+				2,
+				3,
+			),
+			CodeHash:  ethcrypto.Keccak256(nil),
+			StateRoot: ethtypes.EmptyRootHash,
+			VestingSchedule: &vestingtypes.VestingSchedule{
+				OriginalVesting:  sdk.NewCoins(NewPhotonCoin(sdk.NewInt(60))),
+				DelegatedFree:    sdk.NewCoins(NewPhotonCoin(sdk.NewInt(10))),
+				DelegatedVesting: sdk.NewCoins(NewPhotonCoin(sdk.NewInt(20))),
+				StartTime:        1000,
+				EndTime:          2000,
+				VestingPeriods: vestingtypes.Periods{
+					{Length: 500, Amount: sdk.NewCoins(NewPhotonCoin(sdk.NewInt(30)))},
+					{Length: 500, Amount: sdk.NewCoins(NewPhotonCoin(sdk.NewInt(30)))},
+				},
+				FunderAddress: addr,
+			},
+		},
 	}
 
 	for _, testAccount := range accounts {
@@ -314,6 +341,7 @@ func TestEthAccountDeepCopy(t *testing.T) {
 			),
 			ethcrypto.Keccak256(nil),
 			ethtypes.EmptyRootHash,
+			nil,
 		},
 		{
 			auth.NewBaseAccount(
@@ -325,6 +353,7 @@ func TestEthAccountDeepCopy(t *testing.T) {
 			),
 			ethcrypto.Keccak256(nil),
 			ethtypes.EmptyRootHash,
+			nil,
 		},
 		{
 			auth.NewBaseAccount(
@@ -336,6 +365,29 @@ func TestEthAccountDeepCopy(t *testing.T) {
 			),
 			ethcrypto.Keccak256(nil),
 			ethtypes.EmptyRootHash,
+			nil,
+		}, {
+			BaseAccount: auth.NewBaseAccount(
+				addr,
+				sdk.NewCoins(NewPhotonCoin(sdk.NewInt(100))),
+				pubKey,
+				2,
+				3,
+			),
+			CodeHash:  ethcrypto.Keccak256(nil),
+			StateRoot: ethtypes.EmptyRootHash,
+			VestingSchedule: &vestingtypes.VestingSchedule{
+				OriginalVesting:  sdk.NewCoins(NewPhotonCoin(sdk.NewInt(60))),
+				DelegatedFree:    sdk.NewCoins(NewPhotonCoin(sdk.NewInt(10))),
+				DelegatedVesting: sdk.NewCoins(NewPhotonCoin(sdk.NewInt(20))),
+				StartTime:        1000,
+				EndTime:          2000,
+				VestingPeriods: vestingtypes.Periods{
+					{Length: 500, Amount: sdk.NewCoins(NewPhotonCoin(sdk.NewInt(30)))},
+					{Length: 500, Amount: sdk.NewCoins(NewPhotonCoin(sdk.NewInt(30)))},
+				},
+				FunderAddress: addr,
+			},
 		},
 	}
 
@@ -454,6 +506,7 @@ func (acc EthAccount) utOldCopy() sdk.Account {
 		authtypes.NewBaseAccount(acc.Address, acc.Coins, acc.PubKey, acc.AccountNumber, acc.Sequence),
 		acc.CodeHash,
 		acc.StateRoot,
+		acc.VestingSchedule,
 	}
 }
 
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	vestingtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
+)
+
+var _ vestingtypes.VestingScheduleAccount = (*EthAccount)(nil)
+
+// GetVestingSchedule returns the vesting schedule of the account, nil if the account doesn't vest coins
+func (acc EthAccount) GetVestingSchedule() *vestingtypes.VestingSchedule {
+	return acc.VestingSchedule
+}
+
+// SetVestingSchedule sets the vesting schedule of the account
+func (acc *EthAccount) SetVestingSchedule(schedule *vestingtypes.VestingSchedule) {
+	acc.VestingSchedule = schedule
+}
+
+// SpendableCoins returns the coins of the account which are not locked by the vesting schedule
+func (acc EthAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	if acc.VestingSchedule == nil {
+		return acc.BaseAccount.SpendableCoins(blockTime)
+	}
+	return acc.VestingSchedule.SpendableCoins(acc.BaseAccount, blockTime)
+}
+
+// SpendableBalance returns the balance of an account which is not locked by the vesting schedule.
+func (acc EthAccount) SpendableBalance(denom string, blockTime time.Time) sdk.Dec {
+	if acc.VestingSchedule == nil {
+		return acc.Balance(denom)
+	}
+	return acc.SpendableCoins(blockTime).AmountOf(denom)
+}
+
+// TrackDelegation tracks the delegated coins with the vesting schedule, it performs a no-op if the
+// account doesn't vest coins
+func (acc *EthAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	if acc.VestingSchedule != nil {
+		acc.VestingSchedule.TrackDelegation(acc.BaseAccount, blockTime, amount)
+	}
+}
+
+// TrackUndelegation tracks the undelegated coins with the vesting schedule, it performs a no-op if the
+// account doesn't vest coins
+func (acc *EthAccount) TrackUndelegation(amount sdk.Coins) {
+	if acc.VestingSchedule != nil {
+		acc.VestingSchedule.TrackUndelegation(acc.BaseAccount, amount)
+	}
+}
+
+// GetVestedCoins returns the coins vested by the vesting schedule at the block time
+func (acc EthAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if acc.VestingSchedule == nil {
+		return nil
+	}
+	return acc.VestingSchedule.GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the coins still vesting by the vesting schedule at the block time
+func (acc EthAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	if acc.VestingSchedule == nil {
+		return nil
+	}
+	return acc.VestingSchedule.GetVestingCoins(blockTime)
+}
+
+// GetStartTime returns the time the vesting schedule starts at
+func (acc EthAccount) GetStartTime() int64 {
+	if acc.VestingSchedule == nil {
+		return 0
+	}
+	return acc.VestingSchedule.StartTime
+}
+
+// GetEndTime returns the time the vesting schedule ends at
+func (acc EthAccount) GetEndTime() int64 {
+	if acc.VestingSchedule == nil {
+		return 0
+	}
+	return acc.VestingSchedule.EndTime
+}
+
+// GetOriginalVesting returns the coins vesting upon the creation of the vesting schedule
+func (acc EthAccount) GetOriginalVesting() sdk.Coins {
+	if acc.VestingSchedule == nil {
+		return nil
+	}
+	return acc.VestingSchedule.OriginalVesting
+}
+
+// GetDelegatedFree returns the delegated coins which are vested
+func (acc EthAccount) GetDelegatedFree() sdk.Coins {
+	if acc.VestingSchedule == nil {
+		return nil
+	}
+	return acc.VestingSchedule.DelegatedFree
+}
+
+// GetDelegatedVesting returns the delegated coins which are still vesting
+func (acc EthAccount) GetDelegatedVesting() sdk.Coins {
+	if acc.VestingSchedule == nil {
+		return nil
+	}
+	return acc.VestingSchedule.DelegatedVesting
+}
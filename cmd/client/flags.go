@@ -110,6 +110,7 @@ func RegisterAppFlag(cmd *cobra.Command) {
 
 	cmd.Flags().String(tmdb.FlagGoLeveldbOpts, "", "Options of goleveldb. (cache_size=128MB,handlers_num=1024)")
 	cmd.Flags().String(tmdb.FlagRocksdbOpts, "", "Options of rocksdb. (block_size=4KB,block_cache=1GB,statistics=true,allow_mmap_reads=true,max_open_files=-1,unordered_write=true,pipelined_write=true)")
+	cmd.Flags().String(tmdb.FlagPebbledbOpts, "", "Options of pebbledb. (cache_size=128MB,max_open_files=1024)")
 	cmd.Flags().String(types.FlagNodeMode, "", "Node mode (rpc|val|archive) is used to manage flags")
 
 	cmd.Flags().Bool(consensus.EnablePrerunTx, true, "enable proactively runtx mode, default open")
@@ -49,8 +49,12 @@ import (
 )
 
 const (
-	flagHeight  = "height"
-	flagPruning = "enable_pruning"
+	flagHeight      = "height"
+	flagPruning     = "enable_pruning"
+	flagFromBackend = "from-backend"
+	flagToBackend   = "to-backend"
+
+	convertBatchSize = 10000
 
 	blockDBName = "blockstore"
 	stateDBName = "state"
@@ -89,7 +93,7 @@ func pruningCmd(ctx *server.Context) *cobra.Command {
 
 	cmd.PersistentFlags().Int64P(flagHeight, "r", 0, "Removes block or state up to (but not including) a height")
 	cmd.PersistentFlags().BoolP(flagPruning, "p", true, "Enable pruning")
-	cmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	cmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 	return cmd
 }
 
@@ -249,11 +253,22 @@ func pruneBlockCmd(ctx *server.Context) *cobra.Command {
 func dbConvertCmd(ctx *server.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "convert",
-		Short: "Convert " + system.ChainName + " data from goleveldb to rocksdb",
+		Short: "Convert " + system.ChainName + " data from one db backend to another",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config := ctx.Config
 			config.SetRoot(viper.GetString(flags.FlagHome))
 
+			fromBackend := dbm.BackendType(viper.GetString(flagFromBackend))
+			toBackend := dbm.BackendType(viper.GetString(flagToBackend))
+			if fromBackend == toBackend {
+				return fmt.Errorf("the source and target db backend are both %s", fromBackend)
+			}
+			for _, backend := range []dbm.BackendType{fromBackend, toBackend} {
+				if err := checkBackend(backend); err != nil {
+					return err
+				}
+			}
+
 			// {home}/data/*
 			fromDir := ctx.Config.DBDir()
 			toDir := filepath.Join(ctx.Config.RootDir, "data_convert")
@@ -274,7 +289,7 @@ func dbConvertCmd(ctx *server.Context) *cobra.Command {
 					wg.Add(1)
 					go func(name, fromDir, toDir string) {
 						defer wg.Done()
-						LtoR(name, fromDir, toDir)
+						convertDB(name, fromDir, toDir, fromBackend, toBackend)
 					}(str[0], fromDir, toDir)
 				} else {
 					// cp
@@ -300,9 +315,46 @@ func dbConvertCmd(ctx *server.Context) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagFromBackend, string(dbm.GoLevelDBBackend), "Source database backend: goleveldb | rocksdb | pebbledb")
+	cmd.Flags().String(flagToBackend, string(dbm.RocksDBBackend), "Target database backend: goleveldb | rocksdb | pebbledb")
 	return cmd
 }
 
+// convertDB copies all the key-value pairs of the db named name from fromDir to toDir,
+// then compacts the new db.
+func convertDB(name, fromDir, toDir string, fromBackend, toBackend dbm.BackendType) {
+	log.Printf("convert %s(%s => %s) start...\n", name, fromBackend, toBackend)
+
+	fromDB := dbm.NewDB(name, fromBackend, fromDir)
+	defer fromDB.Close()
+	toDB := dbm.NewDB(name, toBackend, toDir)
+	defer toDB.Close()
+
+	iter, err := fromDB.Iterator(nil, nil)
+	panicError(err)
+	defer iter.Close()
+
+	batch := toDB.NewBatch()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		batch.Set(iter.Key(), iter.Value())
+		count++
+		if count%convertBatchSize == 0 {
+			panicError(batch.Write())
+			batch.Close()
+			batch = toDB.NewBatch()
+		}
+	}
+	panicError(iter.Error())
+	panicError(batch.Write())
+	batch.Close()
+	log.Printf("convert %s(%s => %s) end.\n", name, fromBackend, toBackend)
+
+	log.Printf("compact %s start...\n", name)
+	panicError(toDB.Compact())
+	log.Printf("compact %s end.\n", name)
+}
+
 func getPruneBlockParams(blockStoreDB dbm.DB) (baseHeight, retainHeight int64) {
 	baseHeight, size := getBlockInfo(blockStoreDB)
 
@@ -559,7 +611,7 @@ func queryCmd(ctx *server.Context) *cobra.Command {
 	}
 
 	cmd.AddCommand(queryBlockState, queryAppState)
-	cmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	cmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 
 	return cmd
 }
@@ -585,6 +637,16 @@ func init() {
 	}
 
 	registerDBCompactor(dbm.GoLevelDBBackend, dbCompactor)
+
+	pebbleDBCompactor := func(db dbm.DB) {
+		if pdb, ok := db.(*dbm.PebbleDB); ok {
+			if err := pdb.Compact(); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	registerDBCompactor(dbm.PebbleDBBackend, pebbleDBCompactor)
 }
 
 func pruneMpt() {
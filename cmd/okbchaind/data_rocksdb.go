@@ -4,8 +4,6 @@
 package main
 
 import (
-	"log"
-
 	"github.com/cosmos/gorocksdb"
 	dbm "github.com/okx/okbchain/libs/tm-db"
 )
@@ -19,32 +17,3 @@ func init() {
 
 	registerDBCompactor(dbm.RocksDBBackend, dbCompactor)
 }
-
-func LtoR(name, fromDir, toDir string) {
-	log.Printf("convert %s(goleveldb => rocksdb) start...\n", name)
-
-	ldb, err := dbm.NewGoLevelDB(name, fromDir)
-	if err != nil {
-		panic(err)
-	}
-
-	rdb, err := dbm.NewRocksDB(name, toDir)
-	if err != nil {
-		panic(err)
-	}
-
-	iter, err := ldb.Iterator(nil, nil)
-	if err != nil {
-		panic(err)
-	}
-
-	for ; iter.Valid(); iter.Next() {
-		rdb.Set(iter.Key(), iter.Value())
-	}
-	iter.Close()
-	log.Printf("convert %s(goleveldb => rocksdb) end.\n", name)
-
-	log.Printf("compact %s start...\n", name)
-	rdb.DB().CompactRange(gorocksdb.Range{})
-	log.Printf("compact %s end.\n", name)
-}
@@ -46,7 +46,7 @@ func displayAccount(ctx *server.Context) *cobra.Command {
 	}
 	cmd.Flags().String(FlagDisplayAddress, "", "target contract address to display")
 	cmd.Flags().Int64(FlagDisplayVersion, 0, "target state version to display")
-	cmd.Flags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	cmd.Flags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 
 	return cmd
 }
@@ -63,7 +63,7 @@ func displayContract(ctx *server.Context) *cobra.Command {
 	}
 	cmd.Flags().String(FlagDisplayAddress, "", "target contract address to display")
 	cmd.Flags().Int64(FlagDisplayVersion, 0, "target state version to display")
-	cmd.Flags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	cmd.Flags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 
 	return cmd
 }
@@ -21,7 +21,7 @@ func exportAppCmd(ctx *server.Context) *cobra.Command {
 			log.Println("--------- export success ---------")
 		},
 	}
-	cmd.Flags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	cmd.Flags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 	return cmd
 }
 
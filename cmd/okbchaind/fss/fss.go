@@ -27,5 +27,5 @@ include create sub command`,
 
 func init() {
 	fssCmd.PersistentFlags().StringP(flagDataDir, "d", "./", "The chain data file location")
-	fssCmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	fssCmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 }
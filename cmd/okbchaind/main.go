@@ -30,6 +30,7 @@ import (
 	clientkeys "github.com/okx/okbchain/libs/cosmos-sdk/client/keys"
 	"github.com/okx/okbchain/libs/cosmos-sdk/crypto/keys"
 	"github.com/okx/okbchain/libs/cosmos-sdk/server"
+	snapshottypes "github.com/okx/okbchain/libs/cosmos-sdk/snapshots/types"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
 
@@ -157,6 +158,23 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		panic(err)
 	}
 
+	baseAppOptions := []func(*baseapp.BaseApp){
+		baseapp.SetPruning(pruningOpts),
+		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
+		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
+	}
+	if snapshotInterval := viper.GetUint64(server.FlagStateSyncSnapshotInterval); snapshotInterval > 0 {
+		snapshotStore, err := server.OpenSnapshotStore(viper.GetString(flags.FlagHome))
+		if err != nil {
+			panic(err)
+		}
+		snapshotOptions := snapshottypes.NewSnapshotOptions(
+			snapshotInterval,
+			viper.GetUint32(server.FlagStateSyncSnapshotKeepRecent),
+		)
+		baseAppOptions = append(baseAppOptions, baseapp.SetSnapshot(snapshotStore, snapshotOptions))
+	}
+
 	return app.NewOKBChainApp(
 		logger,
 		db,
@@ -164,9 +182,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		true,
 		map[int64]bool{},
 		viper.GetUint(flagInvCheckPeriod),
-		baseapp.SetPruning(pruningOpts),
-		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
-		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
+		baseAppOptions...,
 	)
 }
 
@@ -21,7 +21,7 @@ func MptCmd(ctx *server.Context) *cobra.Command {
 		genSnapCmd(ctx),
 	)
 
-	cmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb")
+	cmd.PersistentFlags().String(sdk.FlagDBBackend, tmtypes.DBBackend, "Database backend: goleveldb | rocksdb | pebbledb")
 
 	return cmd
 }
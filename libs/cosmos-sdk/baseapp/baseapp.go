@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/snapshots"
 	"github.com/okx/okbchain/libs/cosmos-sdk/store"
 	"github.com/okx/okbchain/libs/cosmos-sdk/store/mpt"
 	"github.com/okx/okbchain/libs/cosmos-sdk/store/rootmulti"
@@ -183,6 +184,9 @@ type BaseApp struct { // nolint: maligned
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
+	// manages state sync snapshots, nil if snapshots are disabled
+	snapshotManager *snapshots.Manager
+
 	// block height at which to halt the chain and gracefully shutdown
 	haltHeight uint64
 
@@ -28,5 +28,9 @@ func (m *modeHandlerSimulate) handleStartHeight(info *runTxInfo, height int64) e
 	if info.overridesBytes != nil {
 		info.ctx.SetOverrideBytes(info.overridesBytes)
 	}
+	if info.traceConfigBytes != nil {
+		info.ctx.SetIsTraceTxLog(true)
+		info.ctx.SetTraceTxLogConfig(info.traceConfigBytes)
+	}
 	return err
 }
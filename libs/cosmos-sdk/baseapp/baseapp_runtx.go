@@ -31,6 +31,7 @@ type runTxInfo struct {
 
 	reusableCacheMultiStore sdk.CacheMultiStore
 	overridesBytes          []byte
+	traceConfigBytes        []byte
 	outOfGas                bool
 }
 
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
 	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
@@ -58,6 +59,72 @@ func (app *BaseApp) TraceTx(queryTraceTx sdk.QueryTraceTx, targetTx sdk.Tx, txIn
 	}
 	return info.result, err
 }
+
+// TraceBlock returns the trace logs for all the evm txs in the target block.
+// The block is begun only once and every tx is run in order on the same traceState,
+// so each tx is traced on the state left by its predecessors.
+// Non-evm txs are run to keep the state right, but they are not in the results.
+func (app *BaseApp) TraceBlock(queryTraceBlock sdk.QueryTraceBlock, block *tmtypes.Block) ([]sdk.TraceTxResult, error) {
+	results := make([]sdk.TraceTxResult, 0, len(block.Txs))
+	if len(block.Txs) == 0 {
+		return results, nil
+	}
+
+	//begin trace block to init traceState and traceBlockCache
+	traceState, err := app.beginBlockForTracing(block.Txs[0], block)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to beginblock for tracing")
+	}
+	traceState.ctx.SetTraceTxLogConfig(queryTraceBlock.ConfigBytes)
+
+	for _, txBytes := range block.Txs {
+		txHash := common.BytesToHash(txBytes.Hash())
+		tx, err := app.txDecoder(txBytes, block.Height)
+		if err != nil {
+			//evm txs are always decoded, so a tx failing to decode is a non-evm one
+			continue
+		}
+
+		isEvmTx := tx.GetType() == sdk.EvmTxType
+		traceState.ctx.SetIsTraceTxLog(isEvmTx)
+		info, err := app.tracetx(txBytes, tx, block.Height, traceState)
+		if !isEvmTx {
+			//ignore the err when run non-evm tx
+			continue
+		}
+
+		result := sdk.TraceTxResult{TxHash: txHash}
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+		case info == nil || info.result == nil:
+			result.Error = "trace result is nil"
+		default:
+			result.Result = info.result.Data
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// TraceCall returns the trace log for the unsigned tx, which is simulated on the
+// state of the given height with the overrides applied.
+func (app *BaseApp) TraceCall(queryTraceCall sdk.QueryTraceCall, tx sdk.Tx, height int64, from ...string) (*sdk.Result, error) {
+	if tx.GetType() != sdk.EvmTxType {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only evm tx can be traced")
+	}
+	info := &runTxInfo{
+		overridesBytes:   queryTraceCall.OverridesBytes,
+		traceConfigBytes: queryTraceCall.ConfigBytes,
+	}
+	if info.traceConfigBytes == nil {
+		// an empty config means the default struct logger
+		info.traceConfigBytes = []byte("{}")
+	}
+	err := app.runtxWithInfo(info, runTxModeSimulate, queryTraceCall.TxBytes, tx, height, from...)
+	return info.result, err
+}
+
 func (app *BaseApp) tracetx(txBytes []byte, tx sdk.Tx, height int64, traceState *state) (info *runTxInfo, err error) {
 
 	mode := runTxModeTrace
@@ -0,0 +1,89 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	dbm "github.com/okx/okbchain/libs/tm-db"
+)
+
+// the txTest with a counter not less than evmTxTestCounter is decoded as an evm tx
+const evmTxTestCounter = 100
+
+// txEvmTest is a txTest of the evm tx type
+type txEvmTest struct {
+	*txTest
+}
+
+func (tx txEvmTest) GetType() sdk.TransactionType {
+	return sdk.EvmTxType
+}
+
+func traceTestTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
+	decoder := testTxDecoder(cdc)
+	return func(txBytes []byte, heights ...int64) (sdk.Tx, error) {
+		tx, err := decoder(txBytes, heights...)
+		if err != nil {
+			return nil, err
+		}
+		if tx.(*txTest).Counter >= evmTxTestCounter {
+			return txEvmTest{tx.(*txTest)}, nil
+		}
+		return tx, nil
+	}
+}
+
+func TestTraceBlock(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	deliverKey := []byte("deliver-key")
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), traceTestTxDecoder(cdc))
+	app.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion(capKey1))
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit(abci.RequestCommit{})
+
+	marshal := func(tx *txTest) []byte {
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+	// the evm tx expects the counter increased by the non-evm tx run before it
+	evmTx := marshal(newTxCounter(evmTxTestCounter, 1))
+	failedTx := newTxCounter(evmTxTestCounter+1, 2)
+	failedTx.setFailOnHandler(true)
+	failedEvmTx := marshal(failedTx)
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Height: 2},
+		Data: tmtypes.Data{Txs: tmtypes.Txs{
+			[]byte("undecodable"),
+			marshal(newTxCounter(0, 0)),
+			evmTx,
+			failedEvmTx,
+		}},
+	}
+
+	results, err := app.TraceBlock(sdk.QueryTraceBlock{Height: 2}, block)
+	require.NoError(t, err)
+	// the undecodable and the non-evm txs are not in the results
+	require.Len(t, results, 2)
+	require.Equal(t, common.BytesToHash(tmtypes.Tx(evmTx).Hash()), results[0].TxHash)
+	require.Empty(t, results[0].Error)
+	require.Equal(t, common.BytesToHash(tmtypes.Tx(failedEvmTx).Hash()), results[1].TxHash)
+	require.Contains(t, results[1].Error, "message handler failure")
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/okx/okbchain/libs/cosmos-sdk/snapshots"
+	snapshottypes "github.com/okx/okbchain/libs/cosmos-sdk/snapshots/types"
 	"github.com/okx/okbchain/libs/cosmos-sdk/store"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/okx/okbchain/libs/tendermint/rpc/client"
@@ -44,6 +46,11 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetSnapshot sets the snapshot store and options, enabling state sync snapshots.
+func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -71,6 +78,27 @@ func (app *BaseApp) SetDB(db dbm.DB) {
 	app.db = db
 }
 
+// SetSnapshot sets the snapshot store and options. A nil store disables snapshots.
+func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) {
+	if app.sealed {
+		panic("SetSnapshot() on sealed BaseApp")
+	}
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+	snapshotter, ok := app.cms.(snapshottypes.Snapshotter)
+	if !ok {
+		panic(fmt.Sprintf("multistore %T does not support snapshots", app.cms))
+	}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, snapshotter, nil, app.logger)
+}
+
+// SnapshotManager returns the snapshot manager of the app, nil if snapshots are disabled.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
 func (app *BaseApp) SetCMS(cms store.CommitMultiStore) {
 	if app.sealed {
 		panic("SetEndBlocker() on sealed BaseApp")
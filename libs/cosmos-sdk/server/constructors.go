@@ -11,6 +11,7 @@ import (
 	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	dbm "github.com/okx/okbchain/libs/tm-db"
 
+	"github.com/okx/okbchain/libs/cosmos-sdk/snapshots"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 )
 
@@ -32,6 +33,19 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return db, err
 }
 
+// OpenSnapshotStore opens the state sync snapshot store under the data directory of rootDir.
+func OpenSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
+		return nil, err
+	}
+	snapshotDB, err := sdk.NewDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile != "" {
 		w, err = os.OpenFile(
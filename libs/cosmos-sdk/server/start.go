@@ -75,6 +75,9 @@ const (
 	FlagEventBlockTime = "event-block-time"
 
 	FlagStartFromSnapshot = "start-from-snapshot"
+
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -200,6 +203,22 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		"ConsensusStateChainID", tmNode.ConsensusState().GetState().ChainID,
 		"GenesisDocChainID", tmNode.GenesisDoc().ChainID,
 	)
+
+	// the node loads the mempool journal on start, set up the mempool before
+	baseapp.SetGlobalMempool(tmNode.Mempool(), cfg.Mempool.SortTxByGp, cfg.Mempool.EnablePendingPool)
+
+	if cfg.Mempool.EnablePendingPool {
+		cliCtx := context.NewCLIContext().WithProxy(cdc)
+		cliCtx.Client = local.New(tmNode)
+		cliCtx.TrustNode = true
+		accRetriever := types.NewAccountRetriever(cliCtx)
+		tmNode.Mempool().SetAccountRetriever(accRetriever)
+	}
+
+	if parser, ok := app.(mempool.TxInfoParser); ok {
+		tmNode.Mempool().SetTxInfoParser(parser)
+	}
+
 	if err := tmNode.Start(); err != nil {
 		return nil, err
 	}
@@ -245,20 +264,6 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		go grpc.StartGRPCServer(cdc, registry, app.(app2.ApplicationAdapter), cfg.GRPC, tmNode)
 	}
 
-	baseapp.SetGlobalMempool(tmNode.Mempool(), cfg.Mempool.SortTxByGp, cfg.Mempool.EnablePendingPool)
-
-	if cfg.Mempool.EnablePendingPool {
-		cliCtx := context.NewCLIContext().WithProxy(cdc)
-		cliCtx.Client = local.New(tmNode)
-		cliCtx.TrustNode = true
-		accRetriever := types.NewAccountRetriever(cliCtx)
-		tmNode.Mempool().SetAccountRetriever(accRetriever)
-	}
-
-	if parser, ok := app.(mempool.TxInfoParser); ok {
-		tmNode.Mempool().SetTxInfoParser(parser)
-	}
-
 	// run forever (the node will not be returned)
 	select {}
 }
@@ -178,6 +178,9 @@ func RegisterServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().String(FlagEvmImportPath, "", "Evm contract & storage db or files used for InitGenesis")
 	cmd.Flags().Uint64(FlagGoroutineNum, 0, "Limit on the number of goroutines used to import evm data(ignored if evm-import-mode is 'default')")
 
+	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval in blocks, 0 disables snapshots")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "Number of recent state sync snapshots to keep")
+
 	cmd.Flags().Bool(tmtypes.FlagDownloadDDS, false, "Download delta")
 	cmd.Flags().Bool(tmtypes.FlagUploadDDS, false, "Upload delta")
 	cmd.Flags().Bool(tmtypes.FlagAppendPid, false, "Append pid to the identity of delta producer")
@@ -0,0 +1,245 @@
+package snapshots_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/snapshots"
+	"github.com/okx/okbchain/libs/cosmos-sdk/snapshots/types"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	dbm "github.com/okx/okbchain/libs/tm-db"
+)
+
+type mockSnapshotter struct {
+	items [][]byte
+
+	mtx    sync.Mutex
+	pinned map[uint64]bool
+	// pinnedOnSnapshot records whether the height was pinned while being snapshotted
+	pinnedOnSnapshot map[uint64]bool
+}
+
+func (m *mockSnapshotter) PinSnapshotHeight(height uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.pinned == nil {
+		m.pinned = make(map[uint64]bool)
+	}
+	m.pinned[height] = true
+}
+
+func (m *mockSnapshotter) UnpinSnapshotHeight(height uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	delete(m.pinned, height)
+}
+
+func (m *mockSnapshotter) isPinned(height uint64) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.pinned[height]
+}
+
+func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	m.mtx.Lock()
+	if m.pinnedOnSnapshot == nil {
+		m.pinnedOnSnapshot = make(map[uint64]bool)
+	}
+	m.pinnedOnSnapshot[height] = m.pinned[height]
+	m.mtx.Unlock()
+
+	for _, item := range m.items {
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_MPT{
+				MPT: &types.SnapshotMPTItem{Key: item, Value: item},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (types.SnapshotItem, error) {
+	if format != types.CurrentFormat {
+		return types.SnapshotItem{}, types.ErrUnknownFormat
+	}
+	if m.items != nil {
+		return types.SnapshotItem{}, errors.New("already has contents")
+	}
+
+	var item types.SnapshotItem
+	m.items = [][]byte{}
+	for {
+		item = types.SnapshotItem{}
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return types.SnapshotItem{}, err
+		}
+		payload := item.GetMPT()
+		if payload == nil {
+			break
+		}
+		m.items = append(m.items, payload.Key)
+	}
+	return item, nil
+}
+
+type mockExtension struct {
+	payloads [][]byte
+}
+
+func (m *mockExtension) SnapshotName() string       { return "mock" }
+func (m *mockExtension) SnapshotFormat() uint32     { return 1 }
+func (m *mockExtension) SupportedFormats() []uint32 { return []uint32{1} }
+func (m *mockExtension) SnapshotExtension(height uint64, payloadWriter types.ExtensionPayloadWriter) error {
+	for _, payload := range m.payloads {
+		if err := payloadWriter(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockExtension) RestoreExtension(height uint64, format uint32, payloadReader types.ExtensionPayloadReader) error {
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		m.payloads = append(m.payloads, payload)
+	}
+}
+
+func setupStore(t *testing.T) (*snapshots.Store, func()) {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.NoError(t, err)
+	store, err := snapshots.NewStore(dbm.NewMemDB(), dir)
+	require.NoError(t, err)
+	return store, func() { os.RemoveAll(dir) }
+}
+
+func setupManager(t *testing.T, snapshotter types.Snapshotter, extension *mockExtension) (*snapshots.Manager, func()) {
+	store, teardown := setupStore(t)
+	manager := snapshots.NewManager(store, types.NewSnapshotOptions(10, 1), snapshotter, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extension))
+	return manager, teardown
+}
+
+func TestManager_CreateRestore(t *testing.T) {
+	source := &mockSnapshotter{items: [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}}
+	sourceExt := &mockExtension{payloads: [][]byte{{10}, {11}}}
+	manager, teardown := setupManager(t, source, sourceExt)
+	defer teardown()
+
+	_, err := manager.Create(0)
+	require.Error(t, err)
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, snapshot.Height)
+	assert.EqualValues(t, types.CurrentFormat, snapshot.Format)
+	assert.Len(t, snapshot.Metadata.ChunkHashes, int(snapshot.Chunks))
+
+	// creating an older snapshot conflicts with the latest one
+	_, err = manager.Create(4)
+	require.True(t, errors.Is(err, types.ErrConflict))
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Equal(t, []*types.Snapshot{snapshot}, list)
+
+	// restore the snapshot into a fresh manager by passing it the chunks one by one
+	target := &mockSnapshotter{}
+	targetExt := &mockExtension{}
+	restorer, teardownTarget := setupManager(t, target, targetExt)
+	defer teardownTarget()
+
+	require.NoError(t, restorer.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := restorer.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	assert.Equal(t, source.items, target.items)
+	assert.Equal(t, sourceExt.payloads, targetExt.payloads)
+
+	// no restore is in progress anymore
+	_, err = restorer.RestoreChunk([]byte{1})
+	require.Error(t, err)
+}
+
+func TestManager_Restore_Errors(t *testing.T) {
+	manager, teardown := setupManager(t, &mockSnapshotter{}, &mockExtension{})
+	defer teardown()
+
+	err := manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat})
+	require.True(t, errors.Is(err, types.ErrInvalidMetadata))
+
+	err = manager.Restore(types.Snapshot{Height: 3, Format: 9, Chunks: 1,
+		Metadata: types.Metadata{ChunkHashes: [][]byte{{1}}}})
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// a chunk whose hash doesn't match the metadata is rejected, and the restore continues
+	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Chunks: 1,
+		Metadata: types.Metadata{ChunkHashes: [][]byte{{1}}}})
+	require.NoError(t, err)
+	_, err = manager.RestoreChunk([]byte{2})
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+}
+
+func TestManager_Prune(t *testing.T) {
+	manager, teardown := setupManager(t, &mockSnapshotter{items: [][]byte{{1}}}, &mockExtension{})
+	defer teardown()
+
+	for _, height := range []uint64{1, 2, 3} {
+		_, err := manager.Create(height)
+		require.NoError(t, err)
+	}
+	pruned, err := manager.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, pruned)
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.EqualValues(t, 3, list[0].Height)
+	assert.EqualValues(t, 2, list[1].Height)
+}
+
+func TestManager_SnapshotIfApplicable(t *testing.T) {
+	source := &mockSnapshotter{items: [][]byte{{1}}}
+	manager, teardown := setupManager(t, source, &mockExtension{})
+	defer teardown()
+
+	// the snapshot interval is 10
+	manager.SnapshotIfApplicable(5)
+	manager.SnapshotIfApplicable(10)
+	require.Eventually(t, func() bool {
+		list, err := manager.List()
+		return err == nil && len(list) == 1 && !source.isPinned(10)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	source.mtx.Lock()
+	defer source.mtx.Unlock()
+	require.Equal(t, map[uint64]bool{10: true}, source.pinnedOnSnapshot)
+	require.Empty(t, source.pinned)
+}
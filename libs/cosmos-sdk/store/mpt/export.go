@@ -0,0 +1,243 @@
+package mpt
+
+import (
+	"bytes"
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+// Export walks the account trie and every storage trie committed at the given version, and
+// emits the raw disk key/value pairs needed to rebuild that state on another node: trie nodes,
+// key preimages, contract code and finally the version's root hash mapping.
+func (ms *MptStore) Export(version int64, emit func(key, value []byte) error) error {
+	root := ms.GetMptRootHash(uint64(version))
+	if root == (ethcmn.Hash{}) {
+		return fmt.Errorf("mpt root of version %d does not exist", version)
+	}
+
+	// keep the trie alive in the trie database while it is being exported
+	triedb := ms.db.TrieDB()
+	triedb.Reference(root, ethcmn.Hash{})
+	defer triedb.Dereference(root)
+
+	if root != ethtypes.EmptyRootHash {
+		tr, err := ms.db.OpenTrie(root)
+		if err != nil {
+			return err
+		}
+
+		storageRoots := make(map[ethcmn.Hash]struct{})
+		codeHashes := make(map[ethcmn.Hash]struct{})
+		it := tr.NodeIterator(nil)
+		for it.Next(true) {
+			if it.Hash() != (ethcmn.Hash{}) {
+				if err := emit(it.Hash().Bytes(), it.NodeBlob()); err != nil {
+					return err
+				}
+			}
+			if !it.Leaf() {
+				continue
+			}
+
+			keyHash := it.LeafKey()
+			if err := emitPreimage(tr.GetKey(keyHash), keyHash, emit); err != nil {
+				return err
+			}
+
+			storageRoot, codeHash := ms.retriever.GetStateRootAndCodeHash(it.LeafBlob())
+			if storageRoot != ethtypes.EmptyRootHash && storageRoot != (ethcmn.Hash{}) {
+				if _, ok := storageRoots[storageRoot]; !ok {
+					storageRoots[storageRoot] = struct{}{}
+					if err := ms.exportStorage(ethcmn.BytesToHash(keyHash), storageRoot, emit); err != nil {
+						return err
+					}
+				}
+			}
+			if len(codeHash) != 0 && !bytes.Equal(codeHash, EmptyCodeHashBytes) {
+				hash := ethcmn.BytesToHash(codeHash)
+				if _, ok := codeHashes[hash]; ok {
+					continue
+				}
+				codeHashes[hash] = struct{}{}
+				code := rawdb.ReadCode(triedb.DiskDB(), hash)
+				if len(code) == 0 {
+					return fmt.Errorf("missing code of hash %s", hash)
+				}
+				if err := emit(append(rawdb.CodePrefix, hash.Bytes()...), code); err != nil {
+					return err
+				}
+			}
+		}
+		if it.Error() != nil {
+			return it.Error()
+		}
+	}
+
+	return emit(append(KeyPrefixAccRootMptHash, sdk.Uint64ToBigEndian(uint64(version))...), root.Bytes())
+}
+
+// PinVersion keeps the trie of the given version alive in the trie database until UnpinVersion,
+// so that it can be exported after later commits.
+func (ms *MptStore) PinVersion(version int64) {
+	if root := ms.GetMptRootHash(uint64(version)); root != (ethcmn.Hash{}) {
+		ms.db.TrieDB().Reference(root, ethcmn.Hash{})
+	}
+}
+
+// UnpinVersion releases the trie pinned by PinVersion.
+func (ms *MptStore) UnpinVersion(version int64) {
+	if root := ms.GetMptRootHash(uint64(version)); root != (ethcmn.Hash{}) {
+		ms.db.TrieDB().Dereference(root)
+	}
+}
+
+func (ms *MptStore) exportStorage(addrHash, root ethcmn.Hash, emit func(key, value []byte) error) error {
+	tr, err := ms.db.OpenStorageTrie(addrHash, root)
+	if err != nil {
+		return err
+	}
+	it := tr.NodeIterator(nil)
+	for it.Next(true) {
+		if it.Hash() != (ethcmn.Hash{}) {
+			if err := emit(it.Hash().Bytes(), it.NodeBlob()); err != nil {
+				return err
+			}
+		}
+		if it.Leaf() {
+			if err := emitPreimage(tr.GetKey(it.LeafKey()), it.LeafKey(), emit); err != nil {
+				return err
+			}
+		}
+	}
+	return it.Error()
+}
+
+func emitPreimage(preimage, keyHash []byte, emit func(key, value []byte) error) error {
+	if preimage == nil {
+		return nil
+	}
+	return emit(append(rawdb.PreimagePrefix, keyHash...), preimage)
+}
+
+// MptImporter writes the key/value pairs produced by Export back into the mpt database.
+type MptImporter struct {
+	ms      *MptStore
+	version int64
+	batch   ethdb.Batch
+}
+
+// Import returns an importer which restores the state of the given version. The store must
+// be empty, i.e. not have any committed version yet.
+func (ms *MptStore) Import(version int64) (*MptImporter, error) {
+	if version <= 0 {
+		return nil, fmt.Errorf("imported version must be greater than zero, got %d", version)
+	}
+	if ms.GetLatestStoredBlockHeight() != 0 {
+		return nil, fmt.Errorf("mpt store is not empty, latest stored version is %d", ms.GetLatestStoredBlockHeight())
+	}
+	return &MptImporter{
+		ms:      ms,
+		version: version,
+		batch:   ms.db.TrieDB().DiskDB().NewBatch(),
+	}, nil
+}
+
+// Add adds a key/value pair produced by Export.
+func (mi *MptImporter) Add(key, value []byte) error {
+	if mi.batch == nil {
+		return fmt.Errorf("mpt importer is closed")
+	}
+	if err := mi.verify(key, value); err != nil {
+		return err
+	}
+	if err := mi.batch.Put(key, value); err != nil {
+		return err
+	}
+	if mi.batch.ValueSize() >= ethdb.IdealBatchSize {
+		if err := mi.batch.Write(); err != nil {
+			return err
+		}
+		mi.batch.Reset()
+	}
+	return nil
+}
+
+// verify checks that the pair is one emitted by Export: trie nodes, preimages and code are keyed
+// by the keccak hash of their value, and the root hash is mapped from the imported version.
+func (mi *MptImporter) verify(key, value []byte) error {
+	var hash []byte
+	switch {
+	case len(key) == ethcmn.HashLength:
+		hash = key
+	case len(key) == len(rawdb.CodePrefix)+ethcmn.HashLength && bytes.HasPrefix(key, rawdb.CodePrefix):
+		hash = key[len(rawdb.CodePrefix):]
+	case len(key) == len(rawdb.PreimagePrefix)+ethcmn.HashLength && bytes.HasPrefix(key, rawdb.PreimagePrefix):
+		hash = key[len(rawdb.PreimagePrefix):]
+	case bytes.Equal(key, append(KeyPrefixAccRootMptHash, sdk.Uint64ToBigEndian(uint64(mi.version))...)):
+		if len(value) != ethcmn.HashLength {
+			return fmt.Errorf("invalid mpt root hash %X of version %d", value, mi.version)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected mpt key %X", key)
+	}
+	if !bytes.Equal(crypto.Keccak256(value), hash) {
+		return fmt.Errorf("hash of the value mismatches the mpt key %X", key)
+	}
+	return nil
+}
+
+// Commit flushes the remaining pairs, verifies that the exported root exists and reopens the
+// store at the imported version.
+func (mi *MptImporter) Commit() error {
+	if mi.batch == nil {
+		return fmt.Errorf("mpt importer is closed")
+	}
+	if err := mi.batch.Write(); err != nil {
+		return err
+	}
+	mi.batch = nil
+
+	ms := mi.ms
+	root := ms.GetMptRootHash(uint64(mi.version))
+	if root == (ethcmn.Hash{}) {
+		return fmt.Errorf("mpt root of version %d was not imported", mi.version)
+	}
+	if root != ethtypes.EmptyRootHash {
+		if _, err := ms.db.TrieDB().Node(root); err != nil {
+			return fmt.Errorf("mpt root node %s of version %d was not imported: %w", root, mi.version, err)
+		}
+	}
+	ms.SetLatestStoredBlockHeight(uint64(mi.version))
+
+	tr, err := ms.db.OpenTrie(root)
+	if err != nil {
+		return err
+	}
+	ms.StopPrefetcher()
+	ms.trie = tr
+	ms.storageTrieForWrite = make(map[ethcmn.Address]ethstate.Trie)
+	ms.version = mi.version
+	ms.startVersion = mi.version
+	ms.originalRoot = root
+	if ms.snaps != nil {
+		ms.snaps.Rebuild(root)
+		ms.prepareSnap(root)
+	}
+	ms.StartPrefetcher("mptStore")
+
+	ms.logger.Info("imported acc mpt trie", "version", mi.version, "trieHash", root)
+	return nil
+}
+
+// Close releases the importer without committing.
+func (mi *MptImporter) Close() {
+	mi.batch = nil
+}
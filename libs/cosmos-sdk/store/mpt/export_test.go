@@ -0,0 +1,83 @@
+package mpt
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImport(t *testing.T) {
+	src, err := mockMptStore(nil, types.CommitID{})
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		src.Set(AddressStoreKey(randBytes(20)), randBytes(32))
+	}
+	for i, key := range commonKeys {
+		src.Set(key, []byte(commonValues[i]))
+	}
+	commitID, _ := src.CommitterCommit(nil)
+
+	dst, err := mockMptStore(nil, types.CommitID{})
+	require.NoError(t, err)
+	importer, err := dst.Import(commitID.Version)
+	require.NoError(t, err)
+
+	err = src.Export(commitID.Version, importer.Add)
+	require.NoError(t, err)
+	require.NoError(t, importer.Commit())
+
+	require.Equal(t, commitID, dst.LastCommitID())
+	require.Equal(t, uint64(commitID.Version), dst.GetLatestStoredBlockHeight())
+	for i, key := range commonKeys {
+		require.Equal(t, []byte(commonValues[i]), dst.Get(key))
+	}
+
+	// the imported store can keep committing on top of the restored version
+	dst.Set(commonKeys[0], []byte("updated"))
+	src.Set(commonKeys[0], []byte("updated"))
+	next, _ := dst.CommitterCommit(nil)
+	expected, _ := src.CommitterCommit(nil)
+	require.Equal(t, expected, next)
+
+	_, err = dst.Import(next.Version)
+	require.Error(t, err)
+	require.Error(t, src.Export(100, importer.Add))
+}
+
+func TestImportVerify(t *testing.T) {
+	src, err := mockMptStore(nil, types.CommitID{})
+	require.NoError(t, err)
+	src.Set(AddressStoreKey(randBytes(20)), randBytes(32))
+	commitID, _ := src.CommitterCommit(nil)
+
+	dst, err := mockMptStore(nil, types.CommitID{})
+	require.NoError(t, err)
+	importer, err := dst.Import(commitID.Version)
+	require.NoError(t, err)
+	var pairs [][2][]byte
+	require.NoError(t, src.Export(commitID.Version, func(key, value []byte) error {
+		require.NoError(t, importer.Add(key, value))
+		pairs = append(pairs, [2][]byte{key, value})
+		return nil
+	}))
+	require.NotEmpty(t, pairs)
+
+	// every emitted pair but the root mapping fails once its value is tampered
+	for _, pair := range pairs[:len(pairs)-1] {
+		require.Error(t, importer.Add(pair[0], append(pair[1], 0)))
+	}
+	root := pairs[len(pairs)-1]
+	require.Error(t, importer.Add(root[0], root[1][1:]))
+	require.Error(t, importer.Add(append(KeyPrefixAccRootMptHash, sdk.Uint64ToBigEndian(uint64(commitID.Version+1))...), root[1]))
+
+	code := []byte("code")
+	require.NoError(t, importer.Add(append(rawdb.CodePrefix, crypto.Keccak256(code)...), code))
+	require.Error(t, importer.Add(append(rawdb.CodePrefix, crypto.Keccak256(code)...), []byte("other code")))
+	require.Error(t, importer.Add([]byte("unknown"), code))
+	require.NoError(t, importer.Commit())
+}
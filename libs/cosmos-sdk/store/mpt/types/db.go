@@ -31,6 +31,11 @@ const (
 	//   - use rocksdb build tag (go build -tags rocksdb)
 	RocksDBBackend BackendType = "rocksdb"
 
+	// PebbleDBBackend represents pebble (github.com/cockroachdb/pebble)
+	//   - pure go
+	//   - EXPERIMENTAL
+	PebbleDBBackend BackendType = "pebbledb"
+
 	// MemDBBackend represents in-memory key value store, which is mostly used
 	// for testing.
 	MemDBBackend BackendType = "memdb"
@@ -24,3 +24,32 @@ func Keccak256HashWithSyncPool(data ...[]byte) (h ethcmn.Hash) {
 	d.Read(h[:])
 	return h
 }
+
+// record is a pending write of a wrapped batch, a nil value marks a deletion.
+type record struct {
+	key   []byte
+	value []byte
+}
+
+func nonNilBytes(bz []byte) []byte {
+	if bz == nil {
+		return []byte{}
+	}
+	return bz
+}
+
+// BytesPrefix returns key range that satisfy the given prefix.
+// This only applicable for the standard 'bytes comparer'.
+func bytesPrefix(prefix []byte) []byte {
+	var limit []byte
+	for i := len(prefix) - 1; i >= 0; i-- {
+		c := prefix[i]
+		if c < 0xff {
+			limit = make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i] = c + 1
+			break
+		}
+	}
+	return limit
+}
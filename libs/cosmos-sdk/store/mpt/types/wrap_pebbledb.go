@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	tmdb "github.com/okx/okbchain/libs/tm-db"
+	"github.com/pkg/errors"
+)
+
+// ------------------------------------------
+//
+//	Register go-ethereum pebbledb
+//
+// ------------------------------------------
+func init() {
+	dbCreator := func(name string, dir string) (ethdb.KeyValueStore, error) {
+		return NewWrapPebbleDB(name, dir)
+	}
+	registerDBCreator(PebbleDBBackend, dbCreator, false)
+}
+
+type WrapPebbleDB struct {
+	*tmdb.PebbleDB
+}
+
+func NewWrapPebbleDB(name string, dir string) (*WrapPebbleDB, error) {
+	pdb, err := tmdb.NewPebbleDB(name, dir)
+	return &WrapPebbleDB{pdb}, err
+}
+
+func (db *WrapPebbleDB) Put(key []byte, value []byte) error {
+	return db.Set(key, value)
+}
+
+func (db *WrapPebbleDB) NewBatch() ethdb.Batch {
+	return NewWrapPebbleDBBatch(db.PebbleDB)
+}
+
+func (db *WrapPebbleDB) NewIterator(prefix []byte, start []byte) ethdb.Iterator {
+	limit := bytesPrefix(prefix)
+	// copy the prefix so that appending start never writes into the caller's slice
+	key := append(append(make([]byte, 0, len(prefix)+len(start)), prefix...), start...)
+	return NewWrapPebbleDBIterator(db.PebbleDB, key, limit)
+}
+
+func (db *WrapPebbleDB) Stat(property string) (string, error) {
+	stats := db.PebbleDB.Stats()
+	if pro, ok := stats[property]; ok {
+		return pro, nil
+	}
+
+	return "", errors.New("property not exist")
+}
+
+// Compact compacts the given key range, a nil start or limit means the whole key range.
+func (db *WrapPebbleDB) Compact(start []byte, limit []byte) error {
+	if start == nil || limit == nil {
+		return db.PebbleDB.Compact()
+	}
+	return db.DB().Compact(start, limit, true)
+}
+
+func (db *WrapPebbleDB) NewBatchWithSize(size int) ethdb.Batch {
+	panic(fmt.Errorf("not support NewBatchWithSize"))
+}
+
+func (db *WrapPebbleDB) NewSnapshot() (ethdb.Snapshot, error) {
+	panic(fmt.Errorf("not support NewSnapshot"))
+}
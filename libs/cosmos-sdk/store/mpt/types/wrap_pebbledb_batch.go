@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/ethdb"
+	tmdb "github.com/okx/okbchain/libs/tm-db"
+)
+
+var _ ethdb.Batch = (*WrapPebbleDBBatch)(nil)
+
+type WrapPebbleDBBatch struct {
+	db      *tmdb.PebbleDB
+	records []record
+	size    int
+}
+
+func NewWrapPebbleDBBatch(db *tmdb.PebbleDB) *WrapPebbleDBBatch {
+	return &WrapPebbleDBBatch{db: db}
+}
+
+func (wpb *WrapPebbleDBBatch) Put(key []byte, value []byte) error {
+	wpb.records = append(wpb.records, record{
+		key:   key,
+		value: nonNilBytes(value),
+	})
+	wpb.size += len(key) + len(value)
+	return nil
+}
+
+func (wpb *WrapPebbleDBBatch) Delete(key []byte) error {
+	wpb.records = append(wpb.records, record{
+		key: key,
+	})
+	wpb.size += len(key)
+	return nil
+}
+
+func (wpb *WrapPebbleDBBatch) ValueSize() int {
+	return wpb.size
+}
+
+func (wpb *WrapPebbleDBBatch) Write() error {
+	batch := tmdb.NewPebbleDBBatch(wpb.db)
+	defer batch.Close()
+	for _, rcd := range wpb.records {
+		if rcd.value != nil {
+			batch.Set(rcd.key, rcd.value)
+		} else {
+			batch.Delete(rcd.key)
+		}
+	}
+
+	return batch.Write()
+}
+
+// Replay replays the batch contents.
+func (wpb *WrapPebbleDBBatch) Replay(w ethdb.KeyValueWriter) error {
+	var err error
+	for _, rcd := range wpb.records {
+		if rcd.value != nil {
+			err = w.Put(rcd.key, rcd.value)
+		} else {
+			err = w.Delete(rcd.key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wpb *WrapPebbleDBBatch) Reset() {
+	wpb.records = wpb.records[:0]
+	wpb.size = 0
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/ethdb"
+	tmdb "github.com/okx/okbchain/libs/tm-db"
+)
+
+var _ ethdb.Iterator = (*WrapPebbleDBIterator)(nil)
+
+type WrapPebbleDBIterator struct {
+	*tmdb.PebbleDBIterator
+	key, value []byte
+}
+
+func NewWrapPebbleDBIterator(db *tmdb.PebbleDB, start, end []byte) *WrapPebbleDBIterator {
+	itr, _ := db.Iterator(start, end)
+	return &WrapPebbleDBIterator{itr.(*tmdb.PebbleDBIterator), nil, nil}
+}
+
+func (wpdi *WrapPebbleDBIterator) Key() []byte {
+	return wpdi.key
+}
+
+func (wpdi *WrapPebbleDBIterator) Value() []byte {
+	return wpdi.value
+}
+
+func (wpdi *WrapPebbleDBIterator) Next() bool {
+	if !wpdi.Valid() {
+		return false
+	}
+	wpdi.key, wpdi.value = wpdi.PebbleDBIterator.Key(), wpdi.PebbleDBIterator.Value()
+	if wpdi.Error() != nil {
+		return false
+	}
+	wpdi.PebbleDBIterator.Next()
+	return true
+}
+
+func (wpdi *WrapPebbleDBIterator) Error() error {
+	return wpdi.PebbleDBIterator.Error()
+}
+
+// Release releases associated resources. Release should always succeed and can
+// be called multiple times without causing error.
+func (wpdi *WrapPebbleDBIterator) Release() {
+	if wpdi.PebbleDBIterator != nil {
+		wpdi.PebbleDBIterator.Close()
+		wpdi.PebbleDBIterator = nil
+	}
+}
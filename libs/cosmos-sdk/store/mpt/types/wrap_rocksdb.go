@@ -66,19 +66,3 @@ func (db *WrapRocksDB) NewSnapshot() (ethdb.Snapshot, error) {
 	panic(fmt.Errorf("not support NewSnapshot"))
 	return nil, nil
 }
-
-// BytesPrefix returns key range that satisfy the given prefix.
-// This only applicable for the standard 'bytes comparer'.
-func bytesPrefix(prefix []byte) []byte {
-	var limit []byte
-	for i := len(prefix) - 1; i >= 0; i-- {
-		c := prefix[i]
-		if c < 0xff {
-			limit = make([]byte, i+1)
-			copy(limit, prefix)
-			limit[i] = c + 1
-			break
-		}
-	}
-	return limit
-}
@@ -10,11 +10,6 @@ import (
 
 var _ ethdb.Batch = (*WrapRocksDBBatch)(nil)
 
-type record struct {
-	key   []byte
-	value []byte
-}
-
 type WrapRocksDBBatch struct {
 	db      *tmdb.RocksDB
 	records []record
@@ -80,10 +75,3 @@ func (wrb *WrapRocksDBBatch) Reset() {
 	wrb.records = wrb.records[:0]
 	wrb.size = 0
 }
-
-func nonNilBytes(bz []byte) []byte {
-	if bz == nil {
-		return []byte{}
-	}
-	return bz
-}
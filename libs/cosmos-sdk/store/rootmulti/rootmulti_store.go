@@ -62,6 +62,7 @@ type Store struct {
 	lazyLoading    bool
 	pruneHeights   []int64
 	versions       []int64
+	snapshotPins   *pinnedHeights
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
@@ -102,6 +103,7 @@ func NewStore(db dbm.DB) *Store {
 		keysByName:     make(map[string]types.StoreKey),
 		pruneHeights:   make([]int64, 0),
 		versions:       make([]int64, 0),
+		snapshotPins:   newPinnedHeights(),
 		upgradeVersion: -1,
 	}
 
@@ -646,16 +648,18 @@ func (rs *Store) CommitterCommitMap(inputDeltaMap *tmtypes.TreeDelta) (types.Com
 }
 
 // pruneStores will batch delete a list of heights from each mounted sub-store.
-// Afterwards, pruneHeights is reset.
+// Afterwards, pruneHeights is reset to the heights pinned by the snapshots in progress,
+// which are pruned after the snapshots are done.
 func (rs *Store) pruneStores() {
-	pruneCnt := len(rs.pruneHeights)
+	pruneHeights, pinned := rs.snapshotPins.split(rs.pruneHeights)
+	pruneCnt := len(pruneHeights)
 	if pruneCnt == 0 {
 		return
 	}
 
 	if rs.logger != nil {
 		rs.logger.Info("pruning start", "pruning-count", pruneCnt, "curr-height", rs.lastCommitInfo.Version+1)
-		rs.logger.Debug("pruning", "pruning-heights", rs.pruneHeights)
+		rs.logger.Debug("pruning", "pruning-heights", pruneHeights, "pinned-heights", pinned)
 	}
 	defer func() {
 		if rs.logger != nil {
@@ -669,7 +673,7 @@ func (rs *Store) pruneStores() {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
+			if err := store.(*iavl.Store).DeleteVersions(pruneHeights...); err != nil {
 				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
 					panic(err)
 				}
@@ -677,7 +681,7 @@ func (rs *Store) pruneStores() {
 		}
 	}
 
-	rs.pruneHeights = make([]int64, 0)
+	rs.pruneHeights = pinned
 }
 
 func (rs *Store) FlushPruneHeights(pruneHeights []int64, versions []int64) {
@@ -1357,10 +1361,8 @@ func getVersions(db dbm.DB) ([]int64, error) {
 	return versions, nil
 }
 
-// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
-// identical across nodes such that chunks from different sources fit together. If the output for a
-// given format changes (at the byte level), the snapshot format must be bumped - see
-// TestMultistoreSnapshot_Checksum test.
+// Export copies the latest version of every IAVL store into the matching store of the given
+// multistore, as version initVersion.
 func (rs *Store) Export(to *Store, initVersion int64) error {
 	curVersion := rs.lastCommitInfo.Version
 	// Collect stores to snapshot (only IAVL stores are supported)
@@ -1475,6 +1477,7 @@ func (src Store) Copy() *Store {
 		lazyLoading:  src.lazyLoading,
 		pruneHeights: make([]int64, 0),
 		versions:     make([]int64, 0),
+		snapshotPins: src.snapshotPins,
 
 		traceWriter:     src.traceWriter,
 		traceContext:    src.traceContext,
@@ -0,0 +1,329 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	snapshottypes "github.com/okx/okbchain/libs/cosmos-sdk/snapshots/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/iavl"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/mpt"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	iavltree "github.com/okx/okbchain/libs/iavl"
+)
+
+var _ snapshottypes.Snapshotter = (*Store)(nil)
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped.
+//
+// Stores are serialized as a stream of SnapshotItem messages, sorted by store name. Every store
+// committed at the height starts with a SnapshotStoreItem, followed by its IAVL nodes or its raw
+// MPT key/value pairs. Stores which are not persisted (e.g. memory stores) carry no items.
+func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitVersion()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, int64(height))
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(cInfo.StoreInfos))
+	for _, si := range cInfo.StoreInfos {
+		names = append(names, si.Name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("store %q of height %v is not mounted", name, height)
+		}
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			if err := snapshotIAVL(store, int64(height), protoWriter); err != nil {
+				return fmt.Errorf("failed to snapshot store %q: %w", name, err)
+			}
+		case *mpt.MptStore:
+			err := store.Export(int64(height), func(key, value []byte) error {
+				return protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_MPT{
+						MPT: &snapshottypes.SnapshotMPTItem{
+							Key:   key,
+							Value: value,
+						},
+					},
+				})
+			})
+			if err != nil {
+				return fmt.Errorf("failed to snapshot store %q: %w", name, err)
+			}
+		default:
+			// non-persisted stores only contribute their (empty) commit id
+			if store.GetStoreType() != types.StoreTypeMemory {
+				return fmt.Errorf("don't know how to snapshot store %q of type %T", name, store)
+			}
+		}
+	}
+
+	return nil
+}
+
+func snapshotIAVL(store *iavl.Store, height int64, protoWriter protoio.Writer) error {
+	exporter, err := store.Export(height)
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// PinSnapshotHeight implements snapshottypes.Snapshotter. The pinned height is left out of the
+// pruned heights, and the mpt roots of the height are kept alive in the trie database.
+func (rs *Store) PinSnapshotHeight(height uint64) {
+	rs.snapshotPins.pin(int64(height))
+	for _, key := range rs.keysByName {
+		if store, ok := rs.GetCommitKVStore(key).(*mpt.MptStore); ok {
+			store.PinVersion(int64(height))
+		}
+	}
+}
+
+// UnpinSnapshotHeight implements snapshottypes.Snapshotter.
+func (rs *Store) UnpinSnapshotHeight(height uint64) {
+	for _, key := range rs.keysByName {
+		if store, ok := rs.GetCommitKVStore(key).(*mpt.MptStore); ok {
+			store.UnpinVersion(int64(height))
+		}
+	}
+	rs.snapshotPins.unpin(int64(height))
+}
+
+// pinnedHeights counts the pins of the heights being snapshotted.
+type pinnedHeights struct {
+	mtx     sync.Mutex
+	heights map[int64]int
+}
+
+func newPinnedHeights() *pinnedHeights {
+	return &pinnedHeights{heights: make(map[int64]int)}
+}
+
+func (p *pinnedHeights) pin(height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.heights[height]++
+}
+
+func (p *pinnedHeights) unpin(height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.heights[height] <= 1 {
+		delete(p.heights, height)
+	} else {
+		p.heights[height]--
+	}
+}
+
+// split splits the heights into the unpinned ones and the pinned ones.
+func (p *pinnedHeights) split(heights []int64) (unpinned, pinned []int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	unpinned = make([]int64, 0, len(heights))
+	pinned = make([]int64, 0)
+	for _, h := range heights {
+		if p.heights[h] > 0 {
+			pinned = append(pinned, h)
+		} else {
+			unpinned = append(unpinned, h)
+		}
+	}
+	return unpinned, pinned
+}
+
+// Restore implements snapshottypes.Snapshotter. It returns the first item which does not belong to
+// the multistore, i.e. the start of the extension snapshots.
+func (rs *Store) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if format != snapshottypes.CurrentFormat {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+
+	var (
+		iavlImporter *iavltree.Importer
+		mptImporter  *mpt.MptImporter
+		restored     []types.StoreKey
+		nextItem     snapshottypes.SnapshotItem
+	)
+	closeImporters := func() {
+		if iavlImporter != nil {
+			iavlImporter.Close()
+			iavlImporter = nil
+		}
+		if mptImporter != nil {
+			mptImporter.Close()
+			mptImporter = nil
+		}
+	}
+	defer closeImporters()
+	commitImporters := func() error {
+		if iavlImporter != nil {
+			if err := iavlImporter.Commit(); err != nil {
+				return sdkerrors.Wrap(err, "IAVL commit failed")
+			}
+		}
+		if mptImporter != nil {
+			if err := mptImporter.Commit(); err != nil {
+				return sdkerrors.Wrap(err, "MPT commit failed")
+			}
+		}
+		closeImporters()
+		return nil
+	}
+
+loop:
+	for {
+		nextItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&nextItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := nextItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := commitImporters(); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			key, ok := rs.keysByName[item.Store.Name]
+			if !ok {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+					"cannot import into non-mounted store %q", item.Store.Name)
+			}
+			switch store := rs.GetCommitKVStore(key).(type) {
+			case *iavl.Store:
+				iavlImporter, err = store.Import(int64(height))
+			case *mpt.MptStore:
+				mptImporter, err = store.Import(int64(height))
+			default:
+				if store.GetStoreType() != types.StoreTypeMemory {
+					err = fmt.Errorf("don't know how to restore store %q of type %T", item.Store.Name, store)
+				}
+			}
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
+			}
+			restored = append(restored, key)
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if iavlImporter == nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8)
+			}
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			if err := iavlImporter.Add(node); err != nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			}
+
+		case *snapshottypes.SnapshotItem_MPT:
+			if mptImporter == nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received MPT item before store item")
+			}
+			if err := mptImporter.Add(item.MPT.Key, item.MPT.Value); err != nil {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "MPT item import failed")
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := commitImporters(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	storeInfos := make([]storeInfo, 0, len(restored))
+	for _, key := range restored {
+		storeInfos = append(storeInfos, storeInfo{
+			Name: key.Name(),
+			Core: storeCore{
+				CommitID: rs.GetCommitKVStore(key).LastCommitID(),
+			},
+		})
+	}
+	rs.lastCommitInfo = commitInfo{
+		Version:    int64(height),
+		StoreInfos: storeInfos,
+	}
+	rs.pruneHeights = []int64{}
+	rs.versions = []int64{int64(height)}
+	flushMetadata(rs.db, int64(height), rs.lastCommitInfo, rs.pruneHeights, rs.versions)
+
+	return nextItem, nil
+}
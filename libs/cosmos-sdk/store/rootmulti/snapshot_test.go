@@ -0,0 +1,110 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	snapshottypes "github.com/okx/okbchain/libs/cosmos-sdk/snapshots/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/iavl"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/types"
+	dbm "github.com/okx/okbchain/libs/tm-db"
+	"github.com/stretchr/testify/require"
+)
+
+func newMultiStoreWithData(t *testing.T, db dbm.DB, commits int) *Store {
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	for i := 0; i < commits; i++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			kv := store.getStoreByName(name).(types.KVStore)
+			for j := 0; j < 20; j++ {
+				kv.Set([]byte(fmt.Sprintf("key-%v-%v", i, j)), []byte(fmt.Sprintf("%v-%v-%v", name, i, j)))
+			}
+			kv.Delete([]byte(fmt.Sprintf("key-%v-%v", i, 0)))
+		}
+		store.CommitterCommitMap(nil)
+	}
+	return store
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithData(t, dbm.NewMemDB(), 3)
+	version := uint64(source.LastCommitVersion())
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, source.Snapshot(version, protoio.NewDelimitedWriter(buf)))
+	extension := &snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{Name: "ext", Format: 1},
+		},
+	}
+	require.NoError(t, protoio.NewDelimitedWriter(buf).WriteMsg(extension))
+
+	targetDB := dbm.NewMemDB()
+	target := newMultiStoreWithMounts(targetDB, types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+	nextItem, err := target.Restore(version, snapshottypes.CurrentFormat,
+		protoio.NewDelimitedReader(buf, 64e6))
+	require.NoError(t, err)
+	require.Equal(t, *extension, nextItem)
+
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"store1", "store2", "store3"} {
+		require.Equal(t, source.getStoreByName(name).(types.CommitKVStore).LastCommitID(),
+			target.getStoreByName(name).(types.CommitKVStore).LastCommitID())
+	}
+
+	// the restored version must survive a reload
+	reloaded := newMultiStoreWithMounts(targetDB, types.PruneNothing)
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, source.LastCommitID(), reloaded.LastCommitID())
+	kv := reloaded.getStoreByName("store2").(types.KVStore)
+	require.Equal(t, []byte("store2-2-5"), kv.Get([]byte("key-2-5")))
+	require.Nil(t, kv.Get([]byte("key-2-0")))
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithData(t, dbm.NewMemDB(), 2)
+
+	require.Error(t, store.Snapshot(0, protoio.NewDelimitedWriter(io.Discard)))
+	require.Error(t, store.Snapshot(3, protoio.NewDelimitedWriter(io.Discard)))
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+	_, err := target.Restore(1, 9, protoio.NewDelimitedReader(new(bytes.Buffer), 64e6))
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+	_, err = target.Restore(0, snapshottypes.CurrentFormat, protoio.NewDelimitedReader(new(bytes.Buffer), 64e6))
+	require.Error(t, err)
+}
+
+func TestMultistoreSnapshotPinnedHeight(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruningOptions{KeepRecent: 1, Interval: 1, MaxRetainNum: 100})
+	require.NoError(t, store.LoadLatestVersion())
+	commit := func() {
+		kv := store.getStoreByName("store1").(types.KVStore)
+		kv.Set([]byte("key"), []byte(fmt.Sprintf("value-%d", store.LastCommitVersion())))
+		store.CommitterCommitMap(nil)
+	}
+	iavlStore := func() *iavl.Store {
+		return store.GetCommitKVStore(store.keysByName["store1"]).(*iavl.Store)
+	}
+
+	commit()
+	store.PinSnapshotHeight(1)
+	for i := 0; i < 3; i++ {
+		commit()
+	}
+	// the pinned height is kept until the snapshot is done
+	require.True(t, iavlStore().VersionExists(1))
+	require.False(t, iavlStore().VersionExists(2))
+	require.Equal(t, []int64{1}, store.pruneHeights)
+
+	store.UnpinSnapshotHeight(1)
+	commit()
+	require.False(t, iavlStore().VersionExists(1))
+	require.Empty(t, store.pruneHeights)
+}
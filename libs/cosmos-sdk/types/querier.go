@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -9,6 +11,24 @@ type QueryTraceTx struct {
 	ConfigBytes []byte      `json:"config"`
 }
 
+type QueryTraceBlock struct {
+	Height      int64  `json:"height"`
+	ConfigBytes []byte `json:"config"`
+}
+
+// TraceTxResult is the trace result of a single tx in a traced block
+type TraceTxResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+type QueryTraceCall struct {
+	TxBytes        []byte `json:"tx"`
+	OverridesBytes []byte `json:"overrides"`
+	ConfigBytes    []byte `json:"config"`
+}
+
 type SimulateData struct {
 	TxBytes        []byte `json:"tx"`
 	OverridesBytes []byte `json:"overrides"`
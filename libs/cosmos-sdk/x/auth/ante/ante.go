@@ -18,7 +18,7 @@ func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, si
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, supplyKeeper),
+		NewDeductFeeDecorator(ak, supplyKeeper, nil),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak),
 		NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
@@ -13,7 +13,8 @@ import (
 )
 
 var (
-	_ FeeTx = (*types.StdTx)(nil) // assert StdTx implements FeeTx
+	_ FeeTx        = (*types.StdTx)(nil) // assert StdTx implements FeeTx
+	_ FeeGranterTx = (*types.StdTx)(nil) // assert StdTx implements FeeGranterTx
 )
 
 // FeeTx defines the interface to be implemented by Tx to use the FeeDecorators
@@ -24,6 +25,21 @@ type FeeTx interface {
 	FeePayer(ctx sdk.Context) sdk.AccAddress
 }
 
+// FeeGranterTx defines the interface to be implemented by Tx whose fee may be
+// paid by a fee granter instead of the fee payer
+type FeeGranterTx interface {
+	FeeGranter() sdk.AccAddress
+}
+
+// GetFeeGranter returns the fee granter of tx, or an empty address if tx has no
+// fee granter
+func GetFeeGranter(tx sdk.Tx) sdk.AccAddress {
+	if granterTx, ok := tx.(FeeGranterTx); ok {
+		return granterTx.FeeGranter()
+	}
+	return nil
+}
+
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
 // as the local validator's minimum gasFee (defined in validator config).
 // If fee is too low, decorator returns error and tx is rejected from mempool.
@@ -69,19 +85,24 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-// DeductFeeDecorator deducts fees from the first signer of the tx
-// If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// DeductFeeDecorator deducts fees from the first signer of the tx, or from the
+// fee granter of the tx if it has granted the first signer an allowance
+// If the payer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak           keeper.AccountKeeper
-	supplyKeeper types.SupplyKeeper
+	ak             keeper.AccountKeeper
+	supplyKeeper   types.SupplyKeeper
+	feegrantKeeper types.FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak keeper.AccountKeeper, sk types.SupplyKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator creates a new DeductFeeDecorator. fk may be nil, in
+// which case txs with a fee granter are rejected.
+func NewDeductFeeDecorator(ak keeper.AccountKeeper, sk types.SupplyKeeper, fk types.FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:           ak,
-		supplyKeeper: sk,
+		ak:             ak,
+		supplyKeeper:   sk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -96,10 +117,28 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	feePayer := feeTx.FeePayer(ctx)
-	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
+	deductFeesFrom := feePayer
+
+	// if a fee granter is set, the fee is paid by the granter, as long as it has
+	// granted the fee payer an allowance covering the fee
+	feeGranter := GetFeeGranter(tx)
+	if !feeGranter.Empty() && !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fee granter is not supported at height %d", ctx.BlockHeight())
+	}
+	if !feeGranter.Empty() && !feeGranter.Equals(feePayer) {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		}
+		err = dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, feeTx.GetFee(), tx.GetMsgs())
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+		}
+		deductFeesFrom = feeGranter
+	}
 
+	feePayerAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
 	if feePayerAcc == nil {
-		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// Note: In order to support the parallel execution of StdTx,
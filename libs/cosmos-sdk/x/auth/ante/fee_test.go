@@ -81,7 +81,7 @@ func TestDeductFees(t *testing.T) {
 	acc.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(10))})
 	app.AccountKeeper.SetAccount(ctx, acc)
 
-	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper)
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err := antehandler(ctx, tx, false)
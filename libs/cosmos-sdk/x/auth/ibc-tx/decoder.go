@@ -266,6 +266,7 @@ func convertFee(authInfo tx.AuthInfo) (authtypes.StdFee, authtypes.IbcFee, strin
 
 	gaslimit := uint64(0)
 	var decCoins sdk.DecCoins
+	var granter sdk.AccAddress
 	var err error
 	payer := ""
 	// for verify signature
@@ -275,17 +276,26 @@ func convertFee(authInfo tx.AuthInfo) (authtypes.StdFee, authtypes.IbcFee, strin
 		if err != nil {
 			return authtypes.StdFee{}, authtypes.IbcFee{}, payer, err
 		}
+		if authInfo.Fee.Granter != "" {
+			granter, err = sdk.AccAddressFromBech32(authInfo.Fee.Granter)
+			if err != nil {
+				return authtypes.StdFee{}, authtypes.IbcFee{}, payer, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+			}
+		}
 		gaslimit = authInfo.Fee.GasLimit
 		signFee = authtypes.IbcFee{
 			authInfo.Fee.Amount,
 			authInfo.Fee.GasLimit,
+			authInfo.Fee.Payer,
+			authInfo.Fee.Granter,
 		}
 		payer = authInfo.Fee.Payer
 	}
 
 	return authtypes.StdFee{
-		Amount: decCoins,
-		Gas:    gaslimit,
+		Amount:  decCoins,
+		Gas:     gaslimit,
+		Granter: granter,
 	}, signFee, payer, nil
 }
 
@@ -12,3 +12,9 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeegrantKeeper defines the expected feegrant Keeper (noalias)
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+	RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins)
+}
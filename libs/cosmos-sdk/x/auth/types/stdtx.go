@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 	"github.com/okx/okbchain/libs/tendermint/crypto"
 	cryptoamino "github.com/okx/okbchain/libs/tendermint/crypto/encoding/amino"
 	"github.com/okx/okbchain/libs/tendermint/crypto/multisig"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/tendermint/go-amino"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -150,6 +152,10 @@ func (tx *StdTx) ValidateBasic() error {
 }
 
 func (tx *StdTx) ValidWithHeight(h int64) error {
+	// the fee granter is only supported after venus8
+	if !tx.Fee.Granter.Empty() && !tmtypes.HigherThanVenus8(h) {
+		return fmt.Errorf("fee granter not support before height:%d", tmtypes.GetVenus8Height())
+	}
 	for _, msg := range tx.Msgs {
 		if v, ok := msg.(sdk.HeightSensitive); ok {
 			if err := v.ValidWithHeight(h); nil != err {
@@ -256,6 +262,12 @@ func (tx *StdTx) FeePayer(ctx sdk.Context) sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address that grants the fee payer an allowance to
+// pay the fee, or an empty address if the fee payer pays the fee itself
+func (tx *StdTx) FeeGranter() sdk.AccAddress {
+	return tx.Fee.Granter
+}
+
 // GetGasPrice return gas price
 func (tx *StdTx) GetGasPrice() *big.Int {
 	if tx.Fee.Gas == 0 {
@@ -326,9 +338,12 @@ func (tx *StdTx) GetNonce() uint64 {
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+// If Granter is set, the fee is paid by the granter from the fee allowance it
+// has granted to the fee payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -410,6 +425,9 @@ func (fee *StdFee) UnmarshalFromAmino(cdc *amino.Codec, data []byte) error {
 				return err
 			}
 			dataLen = uint64(n)
+		case 3:
+			fee.Granter = make([]byte, len(subData))
+			copy(fee.Granter, subData)
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -496,11 +514,20 @@ func EthereumTxEncoder(_ *codec.Codec) sdk.TxEncoder {
 	}
 }
 
+// EthereumTxEncode encodes the tx with its canonical encoding if it has one, so the
+// EIP-2718 typed txs are encoded as envelopes. Otherwise the tx is RLP encoded.
 func EthereumTxEncode(tx sdk.Tx) ([]byte, error) {
+	if m, ok := tx.(encoding.BinaryMarshaler); ok {
+		return m.MarshalBinary()
+	}
 	return rlp.EncodeToBytes(tx)
 }
 
+// EthereumTxDecode decodes b with the canonical decoding of tx if it has one.
 func EthereumTxDecode(b []byte, tx interface{}) error {
+	if u, ok := tx.(encoding.BinaryUnmarshaler); ok {
+		return u.UnmarshalBinary(b)
+	}
 	return rlp.DecodeBytes(b, tx)
 }
 
@@ -38,8 +38,10 @@ type StdIBCSignDoc struct {
 }
 
 type IbcFee struct {
-	Amount sdk.CoinAdapters `json:"amount" yaml:"amount"`
-	Gas    uint64           `json:"gas" yaml:"gas"`
+	Amount  sdk.CoinAdapters `json:"amount" yaml:"amount"`
+	Gas     uint64           `json:"gas" yaml:"gas"`
+	Payer   string           `json:"payer,omitempty" yaml:"payer"`
+	Granter string           `json:"granter,omitempty" yaml:"granter"`
 }
 
 const (
@@ -11,6 +11,7 @@ import (
 	"github.com/okx/okbchain/libs/tendermint/crypto"
 	"github.com/okx/okbchain/libs/tendermint/crypto/ed25519"
 	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/stretchr/testify/require"
 	yaml "gopkg.in/yaml.v2"
 
@@ -116,6 +117,8 @@ func TestStdSignBytes(t *testing.T) {
 		memo     string
 	}
 	defaultFee := NewTestStdFee()
+	grantedFee := NewTestStdFee()
+	grantedFee.Granter = addr
 	tests := []struct {
 		args args
 		want string
@@ -124,6 +127,10 @@ func TestStdSignBytes(t *testing.T) {
 			args{"1234", 3, 6, defaultFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
 			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150.000000000000000000\",\"denom\":\"atom\"}],\"gas\":\"100000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr),
 		},
+		{
+			args{"1234", 3, 6, grantedFee, []sdk.Msg{sdk.NewTestMsg(addr)}, "memo"},
+			fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150.000000000000000000\",\"denom\":\"atom\"}],\"gas\":\"100000\",\"granter\":\"%s\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr, addr),
+		},
 	}
 	for i, tc := range tests {
 		got := string(StdSignBytes(tc.args.chainID, tc.args.accnum, tc.args.sequence, tc.args.fee, tc.args.msgs, tc.args.memo))
@@ -290,6 +297,11 @@ func TestStdFeeAmino(t *testing.T) {
 			Amount: sdk.Coins{},
 			Gas:    math.MaxUint64,
 		},
+		{
+			Amount:  sdk.Coins{sdk.NewInt64Coin("dummy", 1)},
+			Gas:     uint64(5),
+			Granter: addr,
+		},
 	}
 
 	for _, stdFee := range testCases {
@@ -307,3 +319,17 @@ func TestStdFeeAmino(t *testing.T) {
 		require.EqualValues(t, expectValue, actualValue)
 	}
 }
+
+func TestStdTxValidWithHeightFeeGranter(t *testing.T) {
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	fee := NewTestStdFee()
+	tx := NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, fee, nil, "")
+	require.NoError(t, tx.ValidWithHeight(10))
+
+	// the fee granter is only supported after venus8
+	tx.Fee.Granter = addr
+	require.Error(t, tx.ValidWithHeight(10))
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(5)
+	require.Error(t, tx.ValidWithHeight(5))
+	require.NoError(t, tx.ValidWithHeight(6))
+}
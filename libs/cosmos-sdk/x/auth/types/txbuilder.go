@@ -27,6 +27,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -70,6 +71,7 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
+	txbldr = txbldr.WithFeeGranter(viper.GetString(flags.FlagFeeAccount))
 
 	return txbldr
 }
@@ -148,6 +150,23 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+// An empty feeGranter clears the fee granter.
+func (bldr TxBuilder) WithFeeGranter(feeGranter string) TxBuilder {
+	if feeGranter == "" {
+		bldr.feeGranter = nil
+		return bldr
+	}
+
+	granter, err := sdk.AccAddressFromBech32(feeGranter)
+	if err != nil {
+		panic(err)
+	}
+
+	bldr.feeGranter = granter
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase keys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -197,13 +216,16 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.Granter = bldr.feeGranter
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           fee,
 	}, nil
 }
 
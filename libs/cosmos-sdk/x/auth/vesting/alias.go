@@ -8,6 +8,11 @@ import (
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
 )
 
+const (
+	ModuleName = types.ModuleName
+	RouterKey  = types.RouterKey
+)
+
 var (
 	// functions aliases
 	RegisterCodec                  = types.RegisterCodec
@@ -18,6 +23,14 @@ var (
 	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
 	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	NewContinuousVestingSchedule   = types.NewContinuousVestingSchedule
+	NewDelayedVestingSchedule      = types.NewDelayedVestingSchedule
+	NewPeriodicVestingSchedule     = types.NewPeriodicVestingSchedule
+
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+	NewMsgCreateClawbackVestingAccount = types.NewMsgCreateClawbackVestingAccount
+	NewMsgClawback                     = types.NewMsgClawback
 
 	// variable aliases
 	VestingCdc = types.VestingCdc
@@ -30,4 +43,11 @@ type (
 	DelayedVestingAccount    = types.DelayedVestingAccount
 	Period                   = types.Period
 	Periods                  = types.Periods
+	VestingSchedule          = types.VestingSchedule
+	VestingScheduleAccount   = types.VestingScheduleAccount
+
+	MsgCreateVestingAccount         = types.MsgCreateVestingAccount
+	MsgCreatePeriodicVestingAccount = types.MsgCreatePeriodicVestingAccount
+	MsgCreateClawbackVestingAccount = types.MsgCreateClawbackVestingAccount
+	MsgClawback                     = types.MsgClawback
 )
@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	flagDelayed   = "delayed"
+	flagStartTime = "start-time"
+	flagDest      = "dest"
+)
+
+// GetTxCmd returns the transaction commands for the vesting module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateVestingAccount(cdc),
+		GetCmdCreatePeriodicVestingAccount(cdc),
+		GetCmdCreateClawbackVestingAccount(cdc),
+		GetCmdClawback(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdCreateVestingAccount returns a CLI command handler for creating a
+// continuous or delayed vesting account
+func GetCmdCreateVestingAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account funded with an allocation of tokens sent from your account.
+The tokens vest linearly from --%s, or the block time if not set, until the end time given as a unix timestamp.
+All the tokens vest at once at the end time if --%s is set. The account must not exist yet.
+
+Example:
+$ %s tx %s create-vesting-account ex1... 100%s 1735689600 --from mykey
+$ %s tx %s create-vesting-account ex1... 100%s 1735689600 --%s --from mykey
+`,
+				flagStartTime, flagDelayed,
+				version.ClientName, types.ModuleName, sdk.DefaultBondDenom,
+				version.ClientName, types.ModuleName, sdk.DefaultBondDenom, flagDelayed,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid recipient address %w", err)
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end time %w", err)
+			}
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), to, amount,
+				viper.GetInt64(flagStartTime), endTime, viper.GetBool(flagDelayed))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(flagDelayed, false, "Create a delayed vesting account if true")
+	cmd.Flags().Int64(flagStartTime, 0, "The unix timestamp the tokens start to vest at, the block time if not set")
+	return cmd
+}
+
+// GetCmdCreatePeriodicVestingAccount returns a CLI command handler for creating
+// a periodic vesting account
+func GetCmdCreatePeriodicVestingAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account funded with an allocation of tokens vesting in periods",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account funded with the tokens of all the vesting periods sent from your account.
+The tokens of every period vest at the end of the period, the periods follow each other from the start time,
+or the block time if not set. The account must not exist yet.
+
+Where periods.json contains:
+{
+  "start_time": 1735689600,
+  "periods": [
+    {"coins": "10%s", "length_seconds": 2592000},
+    {"coins": "10%s", "length_seconds": 2592000}
+  ]
+}
+
+Example:
+$ %s tx %s create-periodic-vesting-account ex1... periods.json --from mykey
+`,
+				sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid recipient address %w", err)
+			}
+
+			startTime, periods, err := readPeriodsFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), to, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdCreateClawbackVestingAccount returns a CLI command handler for creating
+// a periodic vesting account whose unvested tokens can be clawed back
+func GetCmdCreateClawbackVestingAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account vesting in periods, whose unvested tokens can be clawed back",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account funded with the tokens of all the vesting periods sent from your account,
+as create-periodic-vesting-account does. You can claw back the tokens which are not vested yet by the clawback command.
+
+Example:
+$ %s tx %s create-clawback-vesting-account ex1... periods.json --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid recipient address %w", err)
+			}
+
+			startTime, periods, err := readPeriodsFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(cliCtx.GetFromAddress(), to, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdClawback returns a CLI command handler for clawing back the unvested
+// tokens of a vesting account
+func GetCmdClawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a vesting account you funded",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claw back the unvested tokens of a vesting account created by create-clawback-vesting-account from
+your account. The tokens are sent to --%s, or your account if not set. The unvested tokens which are delegated
+can't be clawed back, they stay locked until the end of the vesting schedule.
+
+Example:
+$ %s tx %s clawback ex1... --from mykey
+`,
+				flagDest, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid vesting account address %w", err)
+			}
+
+			var dest sdk.AccAddress
+			if destStr := viper.GetString(flagDest); destStr != "" {
+				if dest, err = sdk.AccAddressFromBech32(destStr); err != nil {
+					return fmt.Errorf("invalid destination address %w", err)
+				}
+			}
+
+			msg := types.NewMsgClawback(cliCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagDest, "", "The address receiving the clawed back tokens, your account if not set")
+	return cmd
+}
+
+// vestingPeriodsFile is the content of the periods json file
+type vestingPeriodsFile struct {
+	StartTime int64 `json:"start_time"`
+	Periods   []struct {
+		Coins  string `json:"coins"`
+		Length int64  `json:"length_seconds"`
+	} `json:"periods"`
+}
+
+func readPeriodsFile(path string) (int64, types.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var file vestingPeriodsFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return 0, nil, fmt.Errorf("invalid periods file %w", err)
+	}
+
+	periods := make(types.Periods, 0, len(file.Periods))
+	for i, p := range file.Periods {
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid coins of period #%d %w", i, err)
+		}
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+	return file.StartTime, periods, nil
+}
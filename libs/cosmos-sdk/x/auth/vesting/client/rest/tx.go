@@ -0,0 +1,172 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/rest"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/vesting/accounts/{address}", CreateVestingAccountRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/vesting/periodic_accounts/{address}", CreatePeriodicVestingAccountRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/vesting/clawback_accounts/{address}", CreateClawbackVestingAccountRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/vesting/accounts/{address}/clawback", ClawbackRequestHandlerFn(cliCtx)).Methods("POST")
+}
+
+// CreateVestingAccountReq defines the properties of a create vesting account request's body.
+type CreateVestingAccountReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount    sdk.Coins    `json:"amount" yaml:"amount"`
+	StartTime int64        `json:"start_time" yaml:"start_time"`
+	EndTime   int64        `json:"end_time" yaml:"end_time"`
+	Delayed   bool         `json:"delayed" yaml:"delayed"`
+}
+
+// CreatePeriodicVestingAccountReq defines the properties of a create periodic or clawback vesting
+// account request's body.
+type CreatePeriodicVestingAccountReq struct {
+	BaseReq        rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	StartTime      int64         `json:"start_time" yaml:"start_time"`
+	VestingPeriods types.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// ClawbackReq defines the properties of a clawback request's body.
+type ClawbackReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	DestAddress sdk.AccAddress `json:"dest_address" yaml:"dest_address"`
+}
+
+// CreateVestingAccountRequestHandlerFn - http request handler to create a continuous or delayed vesting account.
+func CreateVestingAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		fromAddr, ok := readBaseReq(w, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.StartTime, req.EndTime, req.Delayed)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreatePeriodicVestingAccountRequestHandlerFn - http request handler to create a periodic vesting account.
+func CreatePeriodicVestingAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreatePeriodicVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		fromAddr, ok := readBaseReq(w, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr, req.StartTime, req.VestingPeriods)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreateClawbackVestingAccountRequestHandlerFn - http request handler to create a periodic vesting account
+// whose unvested coins can be clawed back by the sender.
+func CreateClawbackVestingAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreatePeriodicVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		fromAddr, ok := readBaseReq(w, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(fromAddr, toAddr, req.StartTime, req.VestingPeriods)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ClawbackRequestHandlerFn - http request handler to claw back the unvested coins of a vesting account.
+func ClawbackRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req ClawbackReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		funderAddr, ok := readBaseReq(w, &req.BaseReq)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgClawback(funderAddr, addr, req.DestAddress)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// readBaseReq sanitizes and validates the base request, and returns the address of its sender
+func readBaseReq(w http.ResponseWriter, baseReq *rest.BaseReq) (sdk.AccAddress, bool) {
+	*baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return nil, false
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return fromAddr, true
+}
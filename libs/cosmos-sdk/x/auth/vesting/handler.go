@@ -0,0 +1,149 @@
+package vesting
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+)
+
+// NewHandler returns a handler for "vesting" type messages.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "vesting is not supported at height %d", ctx.BlockHeight())
+		}
+
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgCreatePeriodicVestingAccount:
+			return handleMsgCreatePeriodicVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgCreateClawbackVestingAccount:
+			return handleMsgCreateClawbackVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized vesting message type: %T", msg)
+		}
+	}
+}
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	msg types.MsgCreateVestingAccount) (*sdk.Result, error) {
+	var schedule *types.VestingSchedule
+	if msg.Delayed {
+		schedule = types.NewDelayedVestingSchedule(msg.Amount, msg.EndTime)
+	} else {
+		schedule = types.NewContinuousVestingSchedule(msg.Amount, startTimeOrNow(ctx, msg.StartTime), msg.EndTime)
+	}
+
+	return createVestingAccount(ctx, ak, bk, msg.FromAddress, msg.ToAddress, schedule)
+}
+
+// Handle MsgCreatePeriodicVestingAccount.
+func handleMsgCreatePeriodicVestingAccount(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	msg types.MsgCreatePeriodicVestingAccount) (*sdk.Result, error) {
+	schedule := types.NewPeriodicVestingSchedule(startTimeOrNow(ctx, msg.StartTime), msg.VestingPeriods)
+
+	return createVestingAccount(ctx, ak, bk, msg.FromAddress, msg.ToAddress, schedule)
+}
+
+// Handle MsgCreateClawbackVestingAccount.
+func handleMsgCreateClawbackVestingAccount(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	msg types.MsgCreateClawbackVestingAccount) (*sdk.Result, error) {
+	schedule := types.NewPeriodicVestingSchedule(startTimeOrNow(ctx, msg.StartTime), msg.VestingPeriods)
+	schedule.FunderAddress = msg.FromAddress
+
+	return createVestingAccount(ctx, ak, bk, msg.FromAddress, msg.ToAddress, schedule)
+}
+
+// Handle MsgClawback.
+func handleMsgClawback(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg types.MsgClawback) (*sdk.Result, error) {
+	acc, ok := ak.GetAccount(ctx, msg.Address).(types.VestingScheduleAccount)
+	if !ok || acc.GetVestingSchedule() == nil || !acc.GetVestingSchedule().FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrNotClawbackAccount, "%s funded by %s", msg.Address, msg.FunderAddress)
+	}
+
+	destAddr := msg.GetDestAddress()
+	if bk.BlacklistedAddr(destAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", destAddr)
+	}
+
+	// end the schedule first, so that the clawed back coins are spendable
+	clawback, remaining := acc.GetVestingSchedule().Clawback(acc.GetCoins(), ctx.BlockTime())
+	acc.SetVestingSchedule(remaining)
+	ak.SetAccount(ctx, acc)
+
+	if !clawback.IsZero() {
+		if err := bk.SendCoins(ctx, msg.Address, destAddr, clawback); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// createVestingAccount creates the vesting account by sending it the original vesting coins of the schedule
+func createVestingAccount(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	fromAddr, toAddr sdk.AccAddress, schedule *types.VestingSchedule) (*sdk.Result, error) {
+	if !bk.GetSendEnabled(ctx) {
+		return nil, types.ErrSendDisabled
+	}
+
+	if bk.BlacklistedAddr(toAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", toAddr)
+	}
+
+	if ak.GetAccount(ctx, toAddr) != nil {
+		return nil, sdkerrors.Wrap(types.ErrAccountExists, toAddr.String())
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidSchedule, err.Error())
+	}
+
+	if err := bk.SendCoins(ctx, fromAddr, toAddr, schedule.OriginalVesting); err != nil {
+		return nil, err
+	}
+
+	acc, ok := ak.GetAccount(ctx, toAddr).(types.VestingScheduleAccount)
+	if !ok {
+		return nil, types.ErrUnsupportedAccountType
+	}
+	acc.SetVestingSchedule(schedule)
+	ak.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, fromAddr.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// startTimeOrNow returns the start time, or the block time if the start time is not set
+func startTimeOrNow(ctx sdk.Context, startTime int64) int64 {
+	if startTime == 0 {
+		return ctx.BlockTime().Unix()
+	}
+	return startTime
+}
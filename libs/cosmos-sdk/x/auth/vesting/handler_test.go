@@ -0,0 +1,209 @@
+package vesting_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/okx/okbchain/app"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	ethermint "github.com/okx/okbchain/app/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+var (
+	coin10  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	coin20  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)
+	coin30  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)
+	coin40  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)
+	coin50  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	coin60  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)
+	coin100 = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.OKBChainApp
+	handler sdk.Handler
+
+	funder sdk.AccAddress
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-3", Time: time.Now().UTC()})
+	suite.handler = vesting.NewHandler(suite.app.AccountKeeper, suite.app.BankKeeper)
+
+	suite.funder = ethsecp256k1.GenerateAddress().Bytes()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, suite.funder)
+	suite.Require().NoError(acc.SetCoins(sdk.NewCoins(coin100)))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+}
+
+func (suite *HandlerTestSuite) TearDownTest() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) ethAccount(addr sdk.AccAddress) *ethermint.EthAccount {
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*ethermint.EthAccount)
+	suite.Require().True(ok)
+	return acc
+}
+
+func (suite *HandlerTestSuite) evmBalance(addr sdk.AccAddress) *big.Int {
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	return csdb.GetBalance(common.BytesToAddress(addr))
+}
+
+func (suite *HandlerTestSuite) TestBeforeVenus8() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+
+	to := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	endTime := suite.ctx.BlockTime().Add(24 * time.Hour)
+	_, err := suite.handler(suite.ctx, vesting.NewMsgCreateVestingAccount(suite.funder, to, sdk.NewCoins(coin60), 0, endTime.Unix(), false))
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, to))
+}
+
+func (suite *HandlerTestSuite) TestCreateContinuousVestingAccount() {
+	to := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	now := suite.ctx.BlockTime()
+	endTime := now.Add(24 * time.Hour)
+
+	msg := vesting.NewMsgCreateVestingAccount(suite.funder, to, sdk.NewCoins(coin60), 0, endTime.Unix(), false)
+	_, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	acc := suite.ethAccount(to)
+	suite.Require().NotNil(acc.VestingSchedule)
+	suite.Require().Equal(now.Unix(), acc.GetStartTime())
+	suite.Require().Equal(endTime.Unix(), acc.GetEndTime())
+	suite.Require().Equal(sdk.NewCoins(coin60), acc.GetCoins())
+	suite.Require().True(acc.SpendableCoins(now).IsZero())
+	suite.Require().Equal(sdk.NewCoins(coin30), acc.SpendableCoins(now.Add(12*time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(coin40), suite.ethAccount(suite.funder).GetCoins())
+
+	// the evm only sees the spendable balance from venus8 on
+	suite.Require().Equal(0, suite.evmBalance(to).Sign())
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	suite.Require().Equal(acc.GetCoins().AmountOf(sdk.DefaultBondDenom).BigInt(), suite.evmBalance(to))
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+
+	// locked coins can't be sent
+	_, err = suite.handler(suite.ctx, vesting.NewMsgCreateVestingAccount(to,
+		sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes()), sdk.NewCoins(coin10), 0, endTime.Unix(), true))
+	suite.Require().Error(err)
+
+	// the account must not exist
+	_, err = suite.handler(suite.ctx, msg)
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestCreateDelayedVestingAccount() {
+	to := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	endTime := suite.ctx.BlockTime().Add(24 * time.Hour)
+
+	_, err := suite.handler(suite.ctx, vesting.NewMsgCreateVestingAccount(suite.funder, to, sdk.NewCoins(coin60), 0, endTime.Unix(), true))
+	suite.Require().NoError(err)
+
+	acc := suite.ethAccount(to)
+	suite.Require().Equal(int64(0), acc.GetStartTime())
+	suite.Require().Nil(acc.VestingSchedule.FunderAddress)
+	suite.Require().True(acc.SpendableCoins(endTime.Add(-time.Second)).IsZero())
+	suite.Require().Equal(sdk.NewCoins(coin60), acc.SpendableCoins(endTime))
+
+	// the delayed vesting account can't be clawed back
+	_, err = suite.handler(suite.ctx, vesting.NewMsgClawback(suite.funder, to, nil))
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestCreatePeriodicVestingAccount() {
+	to := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	now := suite.ctx.BlockTime()
+	periods := vesting.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(coin20)},
+		{Length: 3600, Amount: sdk.NewCoins(coin30)},
+	}
+
+	_, err := suite.handler(suite.ctx, vesting.NewMsgCreatePeriodicVestingAccount(suite.funder, to, 0, periods))
+	suite.Require().NoError(err)
+
+	acc := suite.ethAccount(to)
+	suite.Require().Equal(sdk.NewCoins(coin50), acc.GetOriginalVesting())
+	suite.Require().Nil(acc.VestingSchedule.FunderAddress)
+	suite.Require().True(acc.SpendableCoins(now).IsZero())
+	suite.Require().Equal(sdk.NewCoins(coin20), acc.SpendableCoins(now.Add(time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(coin50), acc.SpendableCoins(now.Add(2*time.Hour)))
+
+	// the periodic vesting account can't be clawed back
+	_, err = suite.handler(suite.ctx, vesting.NewMsgClawback(suite.funder, to, nil))
+	suite.Require().Error(err)
+}
+
+func (suite *HandlerTestSuite) TestCreateClawbackVestingAccount() {
+	to := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	now := suite.ctx.BlockTime()
+	periods := vesting.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(coin20)},
+		{Length: 3600, Amount: sdk.NewCoins(coin30)},
+	}
+
+	_, err := suite.handler(suite.ctx, vesting.NewMsgCreateClawbackVestingAccount(suite.funder, to, 0, periods))
+	suite.Require().NoError(err)
+
+	acc := suite.ethAccount(to)
+	suite.Require().Equal(suite.funder, acc.VestingSchedule.FunderAddress)
+	suite.Require().Equal(sdk.NewCoins(coin50), acc.GetOriginalVesting())
+	suite.Require().True(acc.SpendableCoins(now).IsZero())
+	suite.Require().Equal(sdk.NewCoins(coin20), acc.SpendableCoins(now.Add(time.Hour)))
+}
+
+func (suite *HandlerTestSuite) TestClawback() {
+	to := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	dest := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	periods := vesting.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(coin20)},
+		{Length: 3600, Amount: sdk.NewCoins(coin30)},
+	}
+
+	_, err := suite.handler(suite.ctx, vesting.NewMsgCreateClawbackVestingAccount(suite.funder, to, 0, periods))
+	suite.Require().NoError(err)
+
+	// only the funder can claw back
+	_, err = suite.handler(suite.ctx, vesting.NewMsgClawback(dest, to, nil))
+	suite.Require().Error(err)
+
+	suite.ctx.SetBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	_, err = suite.handler(suite.ctx, vesting.NewMsgClawback(suite.funder, to, dest))
+	suite.Require().NoError(err)
+
+	acc := suite.ethAccount(to)
+	suite.Require().Nil(acc.VestingSchedule)
+	suite.Require().Equal(sdk.NewCoins(coin20), acc.GetCoins())
+	suite.Require().Equal(sdk.NewCoins(coin30), suite.app.AccountKeeper.GetAccount(suite.ctx, dest).GetCoins())
+
+	// the unvested coins go back to the funder without a destination
+	to = sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	_, err = suite.handler(suite.ctx, vesting.NewMsgCreateClawbackVestingAccount(suite.funder, to, 0, periods))
+	suite.Require().NoError(err)
+	_, err = suite.handler(suite.ctx, vesting.NewMsgClawback(suite.funder, to, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.ethAccount(to).GetCoins().IsZero())
+	suite.Require().Equal(sdk.NewCoins(coin50), suite.ethAccount(suite.funder).GetCoins())
+}
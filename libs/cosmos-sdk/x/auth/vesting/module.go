@@ -0,0 +1,110 @@
+package vesting
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/module"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/client/cli"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/client/rest"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the vesting module.
+type AppModuleBasic struct{}
+
+// Name returns the vesting module's name.
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+// RegisterCodec registers the vesting module's types for the given codec.
+// NOTE: the vesting types are registered together with the account types by
+// RegisterCodec, which every app codec calls.
+func (AppModuleBasic) RegisterCodec(_ *codec.Codec) {}
+
+// DefaultGenesis returns no default genesis state, the vesting accounts are
+// part of the auth genesis state.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage { return nil }
+
+// ValidateGenesis performs genesis state validation for the vesting module.
+func (AppModuleBasic) ValidateGenesis(_ json.RawMessage) error { return nil }
+
+// RegisterRESTRoutes registers the REST routes for the vesting module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	rest.RegisterRoutes(ctx, rtr)
+}
+
+// GetTxCmd returns the root tx command for the vesting module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
+}
+
+// GetQueryCmd returns no root query command for the vesting module, the
+// vesting accounts are queried by the auth module.
+func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the vesting module.
+type AppModule struct {
+	AppModuleBasic
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(ak types.AccountKeeper, bk types.BankKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+	}
+}
+
+// Name returns the vesting module's name.
+func (AppModule) Name() string { return types.ModuleName }
+
+// RegisterInvariants performs a no-op.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the vesting module.
+func (AppModule) Route() string { return types.RouterKey }
+
+// NewHandler returns an sdk.Handler for the vesting module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.accountKeeper, am.bankKeeper)
+}
+
+// QuerierRoute returns no querier route.
+func (AppModule) QuerierRoute() string { return "" }
+
+// NewQuerierHandler returns no sdk.Querier.
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// InitGenesis performs a no-op.
+func (am AppModule) InitGenesis(_ sdk.Context, _ json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns no genesis state.
+func (am AppModule) ExportGenesis(_ sdk.Context) json.RawMessage { return nil }
+
+// BeginBlock performs a no-op.
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock performs a no-op.
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
@@ -12,6 +12,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
 	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
+
+	cdc.RegisterConcrete(MsgCreateVestingAccount{}, "cosmos-sdk/MsgCreateVestingAccount", nil)
+	cdc.RegisterConcrete(MsgCreatePeriodicVestingAccount{}, "cosmos-sdk/MsgCreatePeriodicVestingAccount", nil)
+	cdc.RegisterConcrete(MsgCreateClawbackVestingAccount{}, "cosmos-sdk/MsgCreateClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "cosmos-sdk/MsgClawback", nil)
 }
 
 // VestingCdc module wide codec
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// x/vesting module sentinel errors
+var (
+	ErrSendDisabled           = sdkerrors.Register(ModuleName, 2, "send transactions are disabled")
+	ErrAccountExists          = sdkerrors.Register(ModuleName, 3, "account already exists")
+	ErrInvalidSchedule        = sdkerrors.Register(ModuleName, 4, "invalid vesting schedule")
+	ErrUnsupportedAccountType = sdkerrors.Register(ModuleName, 5, "account type doesn't support vesting schedules")
+	ErrNotClawbackAccount     = sdkerrors.Register(ModuleName, 6, "account has no vesting schedule clawed back by the funder")
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authexported "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/exported"
+)
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+	SetAccount(ctx sdk.Context, acc authexported.Account)
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetSendEnabled(ctx sdk.Context) bool
+	BlacklistedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
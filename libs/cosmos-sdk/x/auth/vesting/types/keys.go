@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "vesting"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// AttributeValueCategory defines the event attribute value of the module
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,248 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateVestingAccount         = "create_vesting_account"
+	TypeMsgCreatePeriodicVestingAccount = "create_periodic_vesting_account"
+	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	TypeMsgClawback                     = "clawback"
+)
+
+var (
+	_ sdk.Msg = MsgCreateVestingAccount{}
+	_ sdk.Msg = MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = MsgClawback{}
+)
+
+// MsgCreateVestingAccount defines a message that creates a continuous or delayed vesting account
+// funded with the amount sent from the sender
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	StartTime   int64          `json:"start_time" yaml:"start_time"` // the block time is used if not set
+	EndTime     int64          `json:"end_time" yaml:"end_time"`
+	Delayed     bool           `json:"delayed" yaml:"delayed"`
+}
+
+// NewMsgCreateVestingAccount returns a new MsgCreateVestingAccount
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, delayed bool) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.EndTime <= 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "end time must be positive")
+	}
+	if msg.StartTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "start time must not be negative")
+	}
+	if msg.Delayed && msg.StartTime != 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "delayed vesting has no start time")
+	}
+	if msg.StartTime != 0 && msg.StartTime >= msg.EndTime {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "start time must be before end time")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreatePeriodicVestingAccount defines a message that creates a periodic vesting account funded
+// with the coins of all the vesting periods sent from the sender
+type MsgCreatePeriodicVestingAccount struct {
+	FromAddress    sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"` // the block time is used if not set
+	VestingPeriods Periods        `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// NewMsgCreatePeriodicVestingAccount returns a new MsgCreatePeriodicVestingAccount
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods) MsgCreatePeriodicVestingAccount {
+	return MsgCreatePeriodicVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) Type() string { return TypeMsgCreatePeriodicVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+	return validatePeriods(msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreateClawbackVestingAccount defines a message that creates a periodic vesting account funded
+// by the sender, whose unvested coins can be clawed back by the sender with MsgClawback
+type MsgCreateClawbackVestingAccount struct {
+	FromAddress    sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	StartTime      int64          `json:"start_time" yaml:"start_time"` // the block time is used if not set
+	VestingPeriods Periods        `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// NewMsgCreateClawbackVestingAccount returns a new MsgCreateClawbackVestingAccount
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods Periods) MsgCreateClawbackVestingAccount {
+	return MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr,
+		ToAddress:      toAddr,
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if err := validateAddresses(msg.FromAddress, msg.ToAddress); err != nil {
+		return err
+	}
+	return validatePeriods(msg.StartTime, msg.VestingPeriods)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgClawback defines a message that claws back the unvested coins of a vesting account created by
+// MsgCreateClawbackVestingAccount, and sends them to the destination address, or to the funder if not set
+type MsgClawback struct {
+	FunderAddress sdk.AccAddress `json:"funder_address" yaml:"funder_address"`
+	Address       sdk.AccAddress `json:"address" yaml:"address"`
+	DestAddress   sdk.AccAddress `json:"dest_address,omitempty" yaml:"dest_address,omitempty"`
+}
+
+// NewMsgClawback returns a new MsgClawback
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) MsgClawback {
+	return MsgClawback{
+		FunderAddress: funderAddr,
+		Address:       addr,
+		DestAddress:   destAddr,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if msg.FunderAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing vesting account address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// GetDestAddress returns the address receiving the clawed back coins
+func (msg MsgClawback) GetDestAddress() sdk.AccAddress {
+	if msg.DestAddress.Empty() {
+		return msg.FunderAddress
+	}
+	return msg.DestAddress
+}
+
+func validateAddresses(fromAddr, toAddr sdk.AccAddress) error {
+	if fromAddr.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if toAddr.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	return nil
+}
+
+func validatePeriods(startTime int64, periods Periods) error {
+	if startTime < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "start time must not be negative")
+	}
+	if len(periods) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "vesting periods must not be empty")
+	}
+	for i, p := range periods {
+		if p.Length <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidSchedule, "period #%d has a non-positive length", i)
+		}
+		if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period #%d amount %s", i, p.Amount)
+		}
+	}
+	return nil
+}
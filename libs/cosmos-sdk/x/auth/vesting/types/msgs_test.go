@@ -0,0 +1,96 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+func TestMsgCreateVestingAccountValidateBasic(t *testing.T) {
+	_, _, from := KeyTestPubAddr()
+	_, _, to := KeyTestPubAddr()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name    string
+		msg     MsgCreateVestingAccount
+		expPass bool
+	}{
+		{"continuous", NewMsgCreateVestingAccount(from, to, amount, 100, 200, false), true},
+		{"continuous from block time", NewMsgCreateVestingAccount(from, to, amount, 0, 200, false), true},
+		{"delayed", NewMsgCreateVestingAccount(from, to, amount, 0, 200, true), true},
+		{"delayed with start time", NewMsgCreateVestingAccount(from, to, amount, 100, 200, true), false},
+		{"missing sender", NewMsgCreateVestingAccount(nil, to, amount, 100, 200, false), false},
+		{"missing recipient", NewMsgCreateVestingAccount(from, nil, amount, 100, 200, false), false},
+		{"empty amount", NewMsgCreateVestingAccount(from, to, sdk.Coins{}, 100, 200, false), false},
+		{"no end time", NewMsgCreateVestingAccount(from, to, amount, 100, 0, false), false},
+		{"negative start time", NewMsgCreateVestingAccount(from, to, amount, -1, 200, false), false},
+		{"start time after end time", NewMsgCreateVestingAccount(from, to, amount, 300, 200, false), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCreatePeriodicVestingAccountValidateBasic(t *testing.T) {
+	_, _, from := KeyTestPubAddr()
+	_, _, to := KeyTestPubAddr()
+	periods := Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"periodic", NewMsgCreatePeriodicVestingAccount(from, to, 100, periods), true},
+		{"clawback", NewMsgCreateClawbackVestingAccount(from, to, 0, periods), true},
+		{"missing recipient", NewMsgCreatePeriodicVestingAccount(from, nil, 100, periods), false},
+		{"negative start time", NewMsgCreateClawbackVestingAccount(from, to, -1, periods), false},
+		{"no periods", NewMsgCreatePeriodicVestingAccount(from, to, 100, nil), false},
+		{"zero length period", NewMsgCreatePeriodicVestingAccount(from, to, 100, Periods{
+			{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		}), false},
+		{"empty period amount", NewMsgCreateClawbackVestingAccount(from, to, 100, Periods{
+			{Length: 100, Amount: sdk.Coins{}},
+		}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgClawback(t *testing.T) {
+	_, _, funder := KeyTestPubAddr()
+	_, _, addr := KeyTestPubAddr()
+	_, _, dest := KeyTestPubAddr()
+
+	msg := NewMsgClawback(funder, addr, nil)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, funder, msg.GetDestAddress())
+	require.Equal(t, []sdk.AccAddress{funder}, msg.GetSigners())
+
+	msg = NewMsgClawback(funder, addr, dest)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, dest, msg.GetDestAddress())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.Error(t, NewMsgClawback(nil, addr, dest).ValidateBasic())
+	require.Error(t, NewMsgClawback(funder, nil, dest).ValidateBasic())
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	vestexported "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// VestingScheduleAccount defines an account type which vests coins via the vesting schedule it carries,
+// instead of being one of the vesting account types, e.g. the account type required by the evm.
+type VestingScheduleAccount interface {
+	vestexported.VestingAccount
+
+	GetVestingSchedule() *VestingSchedule
+	SetVestingSchedule(schedule *VestingSchedule)
+}
+
+// VestingSchedule defines the vesting state of a VestingScheduleAccount. The schedule vests like a
+// PeriodicVestingAccount if it has vesting periods, like a DelayedVestingAccount if it has no start time,
+// and like a ContinuousVestingAccount otherwise.
+type VestingSchedule struct {
+	OriginalVesting  sdk.Coins `json:"original_vesting" yaml:"original_vesting"`   // coins vesting upon initialization
+	DelegatedFree    sdk.Coins `json:"delegated_free" yaml:"delegated_free"`       // coins that are vested and delegated
+	DelegatedVesting sdk.Coins `json:"delegated_vesting" yaml:"delegated_vesting"` // coins that vesting and delegated
+	StartTime        int64     `json:"start_time" yaml:"start_time"`               // when the coins start to vest
+	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // when the coins become unlocked
+
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"` // the funder able to claw back the unvested coins
+}
+
+// NewContinuousVestingSchedule returns a VestingSchedule vesting the coins linearly from the start to the end time
+func NewContinuousVestingSchedule(originalVesting sdk.Coins, startTime, endTime int64) *VestingSchedule {
+	return &VestingSchedule{
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		StartTime:        startTime,
+		EndTime:          endTime,
+	}
+}
+
+// NewDelayedVestingSchedule returns a VestingSchedule vesting all the coins at the end time
+func NewDelayedVestingSchedule(originalVesting sdk.Coins, endTime int64) *VestingSchedule {
+	return NewContinuousVestingSchedule(originalVesting, 0, endTime)
+}
+
+// NewPeriodicVestingSchedule returns a VestingSchedule vesting the coins of every period at the end of the period
+func NewPeriodicVestingSchedule(startTime int64, periods Periods) *VestingSchedule {
+	endTime := startTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range periods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+
+	schedule := NewContinuousVestingSchedule(originalVesting, startTime, endTime)
+	schedule.VestingPeriods = periods
+	return schedule
+}
+
+// scheduledVestingAccount defines the vesting account types a VestingSchedule vests like
+type scheduledVestingAccount interface {
+	vestexported.VestingAccount
+	Validate() error
+}
+
+// vestingAccount returns the vesting account which vests the base account as the schedule does.
+// The base account is shared, so that the vesting account always sees its current coins.
+func (vs VestingSchedule) vestingAccount(baseAccount *authtypes.BaseAccount) scheduledVestingAccount {
+	bva := &BaseVestingAccount{
+		BaseAccount:      baseAccount,
+		OriginalVesting:  vs.OriginalVesting,
+		DelegatedFree:    vs.DelegatedFree,
+		DelegatedVesting: vs.DelegatedVesting,
+		EndTime:          vs.EndTime,
+	}
+
+	switch {
+	case len(vs.VestingPeriods) != 0:
+		return NewPeriodicVestingAccountRaw(bva, vs.StartTime, vs.VestingPeriods)
+	case vs.StartTime == 0:
+		return NewDelayedVestingAccountRaw(bva)
+	default:
+		return NewContinuousVestingAccountRaw(bva, vs.StartTime)
+	}
+}
+
+// GetVestedCoins returns the total amount of vested coins at the block time
+func (vs VestingSchedule) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return vs.vestingAccount(nil).GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total amount of vesting coins at the block time
+func (vs VestingSchedule) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return vs.vestingAccount(nil).GetVestingCoins(blockTime)
+}
+
+// SpendableCoins returns the coins of the base account which are not locked by the schedule at the block time
+func (vs VestingSchedule) SpendableCoins(baseAccount *authtypes.BaseAccount, blockTime time.Time) sdk.Coins {
+	return vs.vestingAccount(baseAccount).SpendableCoins(blockTime)
+}
+
+// TrackDelegation tracks the delegation of the base account's coins at the block time
+func (vs *VestingSchedule) TrackDelegation(baseAccount *authtypes.BaseAccount, blockTime time.Time, amount sdk.Coins) {
+	vacc := vs.vestingAccount(baseAccount)
+	vacc.TrackDelegation(blockTime, amount)
+	vs.DelegatedFree, vs.DelegatedVesting = vacc.GetDelegatedFree(), vacc.GetDelegatedVesting()
+}
+
+// TrackUndelegation tracks the undelegation of the base account's coins
+func (vs *VestingSchedule) TrackUndelegation(baseAccount *authtypes.BaseAccount, amount sdk.Coins) {
+	vacc := vs.vestingAccount(baseAccount)
+	vacc.TrackUndelegation(amount)
+	vs.DelegatedFree, vs.DelegatedVesting = vacc.GetDelegatedFree(), vacc.GetDelegatedVesting()
+}
+
+// Clawback ends the schedule at the block time, and returns the unvested coins which are clawed back from
+// the coins of the account, together with the schedule remaining. The unvested coins which are delegated
+// can't be clawed back, so they stay locked until the end time and the remaining schedule vests them all at
+// once, the remaining schedule is nil if there are none.
+func (vs VestingSchedule) Clawback(coins sdk.Coins, blockTime time.Time) (sdk.Coins, *VestingSchedule) {
+	vestingCoins := vs.GetVestingCoins(blockTime)
+
+	clawback := sdk.NewCoins()
+	for _, coin := range vestingCoins {
+		// compute min(max(V - DV, 0), BC)
+		amt := sdk.MaxDec(coin.Amount.Sub(vs.DelegatedVesting.AmountOf(coin.Denom)), sdk.ZeroDec())
+		amt = sdk.MinDec(amt, coins.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			clawback = clawback.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if vs.DelegatedVesting.IsZero() {
+		return clawback, nil
+	}
+	remaining := NewDelayedVestingSchedule(vs.DelegatedVesting, vs.EndTime)
+	remaining.DelegatedFree = vs.DelegatedFree
+	remaining.DelegatedVesting = vs.DelegatedVesting
+	remaining.FunderAddress = vs.FunderAddress
+	return clawback, remaining
+}
+
+// Validate checks for errors on the schedule fields
+func (vs VestingSchedule) Validate() error {
+	if !vs.OriginalVesting.IsValid() || !vs.OriginalVesting.IsAllPositive() {
+		return errors.New("original vesting coins must be positive")
+	}
+	if vs.EndTime <= 0 {
+		return errors.New("vesting end-time must be positive")
+	}
+	if vs.StartTime != 0 && vs.StartTime >= vs.EndTime {
+		return errors.New("vesting start-time must be before end-time")
+	}
+
+	if len(vs.VestingPeriods) != 0 {
+		for _, p := range vs.VestingPeriods {
+			if p.Length <= 0 {
+				return errors.New("vesting period length must be positive")
+			}
+			if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+				return errors.New("vesting period amount must be positive")
+			}
+		}
+	}
+	return vs.vestingAccount(&authtypes.BaseAccount{}).Validate()
+}
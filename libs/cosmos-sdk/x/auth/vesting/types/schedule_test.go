@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	tmtime "github.com/okx/okbchain/libs/tendermint/types/time"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+)
+
+func TestVestingScheduleSpendableCoins(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+
+	// continuous
+	schedule := NewContinuousVestingSchedule(origCoins, now.Unix(), endTime.Unix())
+	require.NoError(t, schedule.Validate())
+	require.True(t, schedule.SpendableCoins(&bacc, now).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50)), schedule.SpendableCoins(&bacc, now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, schedule.SpendableCoins(&bacc, endTime))
+
+	// delayed
+	schedule = NewDelayedVestingSchedule(origCoins, endTime.Unix())
+	require.NoError(t, schedule.Validate())
+	require.True(t, schedule.SpendableCoins(&bacc, now.Add(12*time.Hour)).IsZero())
+	require.Equal(t, origCoins, schedule.SpendableCoins(&bacc, endTime))
+
+	// periodic
+	schedule = NewPeriodicVestingSchedule(now.Unix(), Periods{
+		{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40))},
+		{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 60))},
+	})
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, origCoins, schedule.OriginalVesting)
+	require.Equal(t, endTime.Unix(), schedule.EndTime)
+	require.True(t, schedule.SpendableCoins(&bacc, now.Add(time.Hour)).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)), schedule.SpendableCoins(&bacc, now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, schedule.SpendableCoins(&bacc, endTime))
+}
+
+func TestVestingScheduleTrackDelegation(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+
+	schedule := NewContinuousVestingSchedule(origCoins, now.Unix(), endTime.Unix())
+	schedule.TrackDelegation(&bacc, now.Add(12*time.Hour), sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 80)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50)), schedule.DelegatedVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 30)), schedule.DelegatedFree)
+
+	// the delegated free coins are undelegated first
+	schedule.TrackUndelegation(&bacc, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 40)), schedule.DelegatedVesting)
+	require.True(t, schedule.DelegatedFree.IsZero())
+}
+
+func TestVestingScheduleClawback(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	// nothing delegated, all the vesting coins are clawed back
+	schedule := NewContinuousVestingSchedule(origCoins, now.Unix(), endTime.Unix())
+	clawback, remaining := schedule.Clawback(origCoins, now.Add(12*time.Hour))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50)), clawback)
+	require.Nil(t, remaining)
+
+	// the delegated vesting coins stay locked until the end time
+	schedule.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))
+	clawback, remaining = schedule.Clawback(sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 80)), now.Add(12*time.Hour))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 30)), clawback)
+	require.NotNil(t, remaining)
+	require.Equal(t, schedule.DelegatedVesting, remaining.OriginalVesting)
+	require.Equal(t, endTime.Unix(), remaining.EndTime)
+	require.Equal(t, schedule.DelegatedVesting, remaining.GetVestingCoins(now.Add(12*time.Hour)))
+
+	// the clawback is limited by the coins of the account
+	clawback, _ = schedule.Clawback(sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)), now.Add(12*time.Hour))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)), clawback)
+}
+
+func TestVestingScheduleValidate(t *testing.T) {
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	require.Error(t, NewContinuousVestingSchedule(sdk.Coins{}, 100, 200).Validate())
+	require.Error(t, NewContinuousVestingSchedule(origCoins, 200, 100).Validate())
+	require.Error(t, NewDelayedVestingSchedule(origCoins, 0).Validate())
+	require.Error(t, NewPeriodicVestingSchedule(100, Periods{{Length: 0, Amount: origCoins}}).Validate())
+	require.NoError(t, NewPeriodicVestingSchedule(100, Periods{{Length: 100, Amount: origCoins}}).Validate())
+}
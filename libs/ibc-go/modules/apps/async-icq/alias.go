@@ -0,0 +1,11 @@
+package icq
+
+import (
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+	ModuleCdc = types.ModuleCdc
+)
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/spf13/cobra"
+)
+
+// NewTxCmd returns the transaction commands for interchain query
+func NewTxCmd(cdc *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "interchain-query",
+		Short:                      "Interchain query transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSendQueryTxCmd(cdc, reg),
+	)
+
+	return txCmd
+}
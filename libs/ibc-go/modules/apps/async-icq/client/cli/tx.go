@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channelutils "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/client/utils"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagAbsoluteTimeouts       = "absolute-timeouts"
+)
+
+// NewSendQueryTxCmd returns the command to create a NewMsgSendQuery transaction
+func NewSendQueryTxCmd(m *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-query [src-channel] [path] [hex-data]...",
+		Short: "Query the state of a counterparty chain through IBC",
+		Long: strings.TrimSpace(`Query the state of a counterparty chain through IBC. Each query is given by the grpc
+path and the hex encoded request, multiple queries are separated by spaces. The responses are delivered to
+the sender once the packet is acknowledged. The timeout can be specified as absolute or relative using the
+"absolute-timeouts" flag.`),
+		Example: fmt.Sprintf("%s tx interchain-query send-query channel-0 /cosmos.bank.v1beta1.Query/AllBalances [hex-data]", version.ServerName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 3 || len(args)%2 != 1 {
+				return fmt.Errorf("requires a channel and pairs of path and data, received %d arg(s)", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(m.GetCdc()))
+			clientCtx := context.NewCLIContext().WithCodec(m.GetCdc()).WithInterfaceRegistry(reg)
+
+			sender := clientCtx.GetFromAddress()
+			srcChannel := args[0]
+
+			var requests []abci.RequestQuery
+			for i := 1; i < len(args); i += 2 {
+				data, err := hex.DecodeString(args[i+1])
+				if err != nil {
+					return fmt.Errorf("invalid data of query %s: %w", args[i], err)
+				}
+				requests = append(requests, abci.RequestQuery{Path: args[i], Data: data})
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			if err != nil {
+				return err
+			}
+
+			// if the timeout is not absolute, retrieve the latest block timestamp for the
+			// consensus state connected to the destination port/channel
+			if !absoluteTimeouts && timeoutTimestamp != 0 {
+				consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, types.PortID, srcChannel)
+				if err != nil {
+					return err
+				}
+
+				// use local clock time as reference time if it is later than the
+				// consensus state timestamp of the counter party chain, otherwise
+				// still use consensus state timestamp as reference
+				now := time.Now().UnixNano()
+				consensusStateTimestamp := consensusState.GetTimestamp()
+				if now <= 0 {
+					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+				}
+				if uint64(now) > consensusStateTimestamp {
+					timeoutTimestamp = uint64(now) + timeoutTimestamp
+				} else {
+					timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
+				}
+			}
+
+			msg := types.NewMsgSendQuery(srcChannel, requests, sender, clienttypes.ZeroHeight(), timeoutTimestamp)
+			return utils.GenerateOrBroadcastMsgs(clientCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
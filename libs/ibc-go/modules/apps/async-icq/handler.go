@@ -0,0 +1,25 @@
+package icq
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+)
+
+// NewHandler returns sdk.Handler for interchain query module messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgSendQuery:
+			res, err := k.SendQuery(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain query message type: %T", msg)
+		}
+	}
+}
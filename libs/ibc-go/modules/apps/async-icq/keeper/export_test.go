@@ -0,0 +1,9 @@
+package keeper
+
+import "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+
+// ReplaceCallbacks overrides the callbacks set by the app for tests delivering the query results to mocks.
+func (k *Keeper) ReplaceCallbacks(callbacks types.QueryCallbacks, gasLimit uint64) {
+	k.callbacks = callbacks
+	k.callbackGasLimit = gasLimit
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+)
+
+// InitGenesis initializes the interchain query state and binds to the host port.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.HostPort)
+	k.SetParams(ctx, state.Params)
+
+	for _, pq := range state.PendingQueries {
+		requester, err := sdk.AccAddressFromBech32(pq.Requester)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPendingQuery(ctx, pq.ChannelId, pq.Sequence, requester)
+	}
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, state.HostPort) {
+		err := k.BindPort(ctx, state.HostPort)
+		if err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis exports the interchain query module's host port, params and pending queries into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetParams(ctx), k.GetAllPendingQueries(ctx))
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/baseapp"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/prefix"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	capabilitykeeper "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	paramtypes "github.com/okx/okbchain/x/params"
+)
+
+// Keeper defines the interchain query keeper, it serves the queries of the counterparty
+// chains as the host and sends the queries of the requesters on this chain as the controller
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.CodecProxy
+	paramSpace paramtypes.Subspace
+
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	querier          *baseapp.GRPCQueryRouter
+	callbacks        types.QueryCallbacks
+	callbackGasLimit uint64
+}
+
+// NewKeeper creates a new interchain query Keeper instance
+func NewKeeper(
+	cdc *codec.CodecProxy, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper, querier *baseapp.GRPCQueryRouter,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		querier:       querier,
+	}
+}
+
+// SetCallbacks sets the callbacks which deliver the query results to their requesters, each
+// callback may consume at most gasLimit gas
+func (k *Keeper) SetCallbacks(callbacks types.QueryCallbacks, gasLimit uint64) *Keeper {
+	if k.callbacks != nil {
+		panic("cannot set callbacks twice")
+	}
+
+	k.callbacks = callbacks
+	k.callbackGasLimit = gasLimit
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// IsBound checks if the interchain query module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the interchain query module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the interchain query module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// GetPendingQuery returns the requester of the query packet sent on the channel
+func (k Keeper) GetPendingQuery(ctx sdk.Context, channelID string, sequence uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingQueryKey(channelID, sequence))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// SetPendingQuery stores the requester of the query packet sent on the channel
+func (k Keeper) SetPendingQuery(ctx sdk.Context, channelID string, sequence uint64, requester sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingQueryKey(channelID, sequence), requester)
+}
+
+// DeletePendingQuery removes the requester of the query packet once it is acknowledged or timed out
+func (k Keeper) DeletePendingQuery(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingQueryKey(channelID, sequence))
+}
+
+// GetAllPendingQueries returns all the queries which are waiting for their acknowledgements
+func (k Keeper) GetAllPendingQueries(ctx sdk.Context) []types.PendingQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingQueryKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var pendingQueries []types.PendingQuery
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// the key is composed by the channel id, a separator and the big endian sequence
+		pendingQueries = append(pendingQueries, types.PendingQuery{
+			ChannelId: string(key[:len(key)-9]),
+			Sequence:  sdk.BigEndianToUint64(key[len(key)-8:]),
+			Requester: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return pendingQueries
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability wraps the scopedKeeper's ClaimCapability function
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
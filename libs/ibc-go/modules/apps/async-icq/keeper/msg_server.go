@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// SendQuery defines a rpc handler method for MsgSendQuery.
+func (k Keeper) SendQuery(goCtx context.Context, msg *types.MsgSendQuery) (*types.MsgSendQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	sequence, err := k.SendInterchainQuery(ctx, sender, msg.ChannelId, msg.Requests, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSendQuery,
+			sdk.NewAttribute(types.AttributeKeyRequester, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgSendQueryResponse{Sequence: sequence}, nil
+}
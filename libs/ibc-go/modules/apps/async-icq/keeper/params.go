@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+)
+
+// IsHostEnabled retrieves the host enabled boolean from the paramstore.
+// True is returned if the host is enabled.
+func (k Keeper) IsHostEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyHostEnabled, &res)
+	return res
+}
+
+// GetAllowQueries retrieves the query paths allowed by the host from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetParams returns the total set of the interchain query parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowQueries(ctx))
+}
+
+// SetParams sets the total set of the interchain query parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+// SendInterchainQuery sends the queries of the requester to the host chain through the channel, the results are
+// delivered to the requester by the query callbacks once the packet is acknowledged or timed out.
+func (k Keeper) SendInterchainQuery(
+	ctx sdk.Context,
+	requester sdk.AccAddress,
+	sourceChannel string,
+	requests []abci.RequestQuery,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if err := types.ValidateRequests(requests); err != nil {
+		return 0, err
+	}
+
+	sourcePort := k.GetPort(ctx)
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	data, err := types.SerializeCosmosQuery(requests)
+	if err != nil {
+		return 0, err
+	}
+	packetData := types.NewInterchainQueryPacketData(data, "")
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	k.SetPendingQuery(ctx, sourceChannel, sequence, requester)
+
+	return sequence, nil
+}
+
+// OnRecvPacket executes the queries of the counterparty chain as the host. The responses are
+// serialized into the acknowledgement, the whole packet fails if any query fails.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	if !k.IsHostEnabled(ctx) {
+		return nil, types.ErrHostDisabled
+	}
+
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return nil, sdkerrors.Wrapf(types.ErrUnknownDataType, "cannot unmarshal ICQ packet data")
+	}
+
+	reqs, err := types.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.authenticateQuery(ctx, reqs); err != nil {
+		return nil, err
+	}
+
+	return k.executeQuery(ctx, reqs)
+}
+
+// authenticateQuery ensures the queries are valid and allowed by the host
+func (k Keeper) authenticateQuery(ctx sdk.Context, reqs []abci.RequestQuery) error {
+	if err := types.ValidateRequests(reqs); err != nil {
+		return err
+	}
+
+	allowQueries := k.GetAllowQueries(ctx)
+	for _, req := range reqs {
+		if !types.ContainsQueryPath(allowQueries, req.Path) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", req.Path)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) executeQuery(ctx sdk.Context, reqs []abci.RequestQuery) ([]byte, error) {
+	resps := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		route := k.querier.Route(req.Path)
+		if route == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no route found for: %s", req.Path)
+		}
+
+		res, err := route(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// the non-deterministic fields are left empty
+		resps[i] = abci.ResponseQuery{
+			Code:   res.Code,
+			Index:  res.Index,
+			Key:    res.Key,
+			Value:  res.Value,
+			Height: res.Height,
+		}
+	}
+
+	bz, err := types.SerializeCosmosResponse(resps)
+	if err != nil {
+		return nil, err
+	}
+
+	ack := types.InterchainQueryPacketAck{
+		Data: bz,
+	}
+	return ack.GetBytes(), nil
+}
+
+// OnAcknowledgementPacket delivers the responses or the error of the acknowledgement to the requester
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.deliverResult(ctx, packet, nil, resp.Error)
+	default:
+		var ackData types.InterchainQueryPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &ackData); err != nil {
+			return sdkerrors.Wrapf(types.ErrUnknownDataType, "cannot unmarshal ICQ packet acknowledgement: %v", err)
+		}
+		resps, err := types.DeserializeCosmosResponse(ackData.Data)
+		if err != nil {
+			return err
+		}
+		return k.deliverResult(ctx, packet, resps, "")
+	}
+}
+
+// OnTimeoutPacket notifies the requester that the query packet timed out
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.deliverResult(ctx, packet, nil, "packet timed out")
+}
+
+// deliverResult calls the query callbacks with the result of the query packet. A failed callback doesn't
+// revert the acknowledgement or timeout of the packet, the state changes of the callback are discarded and
+// the error is emitted instead.
+func (k Keeper) deliverResult(ctx sdk.Context, packet channeltypes.Packet, resps []abci.ResponseQuery, errMsg string) error {
+	channelID := packet.GetSourceChannel()
+	sequence := packet.GetSequence()
+
+	requester, found := k.GetPendingQuery(ctx, channelID, sequence)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrPendingQueryNotFound, "channel ID (%s) sequence (%d)", channelID, sequence)
+	}
+	k.DeletePendingQuery(ctx, channelID, sequence)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", errMsg == "")),
+	}
+	if errMsg != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckError, errMsg))
+	}
+
+	if k.callbacks != nil {
+		if err := k.callbackWithGasLimit(ctx, requester, channelID, sequence, resps, errMsg); err != nil {
+			k.Logger(ctx).Error("interchain query callback failed", "requester", requester.String(), "channel", channelID, "sequence", sequence, "error", err)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeQueryResult, attributes...))
+	return nil
+}
+
+// callbackWithGasLimit calls the query callback within a cache context limited to the callback gas limit,
+// the state changes are only written when the callback succeeds
+func (k Keeper) callbackWithGasLimit(ctx sdk.Context, requester sdk.AccAddress, channelID string, sequence uint64, resps []abci.ResponseQuery, errMsg string) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	limitedMeter := sdk.NewGasMeter(k.callbackGasLimit)
+	cacheCtx.SetGasMeter(limitedMeter)
+
+	// catch out of gas panic and just charge the entire gas limit
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ctx.GasMeter().ConsumeGas(k.callbackGasLimit, "interchain query callback OutOfGas panic")
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "interchain query callback hit gas limit")
+		}
+	}()
+
+	err = k.callbacks.OnInterchainQueryResult(cacheCtx, requester, channelID, sequence, resps, errMsg)
+
+	// make sure we charge the parent what was spent
+	ctx.GasMeter().ConsumeGas(limitedMeter.GasConsumed(), "interchain query callback")
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+	return nil
+}
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/okx/okbchain/app"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	icq "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+const transferParamsPath = "/ibc.applications.transfer.v1.Query/Params"
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.OKBChainApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+
+	suite.app = app.Setup(checkTx)
+	suite.ctx = suite.app.NewContext(checkTx, abci.Header{
+		Height:  1,
+		ChainID: "ethermint-3",
+		Time:    time.Now().UTC(),
+	})
+	// the interchain query port is bound by the venus8 upgrade task rather than at genesis
+	suite.Require().NoError(icq.NewAppModule(suite.app.ICQKeeper).RegisterTask().Execute(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) queryPacket(sequence uint64, reqs []abci.RequestQuery) channeltypes.Packet {
+	bz, err := types.SerializeCosmosQuery(reqs)
+	suite.Require().NoError(err)
+	data := types.NewInterchainQueryPacketData(bz, "")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, types.PortID, "channel-1", types.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	k := suite.app.ICQKeeper
+	reqs := []abci.RequestQuery{{Path: transferParamsPath}}
+
+	// the queries are rejected until the path is allowed by the host
+	_, err := k.OnRecvPacket(suite.ctx, suite.queryPacket(1, reqs))
+	suite.Require().Error(err)
+
+	k.SetParams(suite.ctx, types.NewParams(true, []string{transferParamsPath}))
+	ack, err := k.OnRecvPacket(suite.ctx, suite.queryPacket(1, reqs))
+	suite.Require().NoError(err)
+
+	var ackData types.InterchainQueryPacketAck
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(ack, &ackData))
+	resps, err := types.DeserializeCosmosResponse(ackData.Data)
+	suite.Require().NoError(err)
+	suite.Require().Len(resps, 1)
+	suite.Require().True(resps[0].IsOK())
+
+	var res transfertypes.QueryParamsResponse
+	suite.Require().NoError(res.Unmarshal(resps[0].Value))
+	suite.Require().Equal(suite.app.TransferKeeper.GetParams(suite.ctx), *res.Params)
+
+	// queries with proofs or historical heights are not served
+	_, err = k.OnRecvPacket(suite.ctx, suite.queryPacket(2, []abci.RequestQuery{{Path: transferParamsPath, Prove: true}}))
+	suite.Require().Error(err)
+	_, err = k.OnRecvPacket(suite.ctx, suite.queryPacket(3, []abci.RequestQuery{{Path: transferParamsPath, Height: 1}}))
+	suite.Require().Error(err)
+
+	k.SetParams(suite.ctx, types.NewParams(false, []string{transferParamsPath}))
+	_, err = k.OnRecvPacket(suite.ctx, suite.queryPacket(4, reqs))
+	suite.Require().ErrorIs(err, types.ErrHostDisabled)
+}
+
+func (suite *KeeperTestSuite) TestDeliverResult() {
+	k := suite.app.ICQKeeper
+	requester := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	packet := channeltypes.NewPacket(nil, 1, types.PortID, "channel-0", types.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+
+	// results of unknown queries are rejected
+	suite.Require().ErrorIs(k.OnTimeoutPacket(suite.ctx, packet), types.ErrPendingQueryNotFound)
+
+	k.SetPendingQuery(suite.ctx, "channel-0", 1, requester)
+	got, found := k.GetPendingQuery(suite.ctx, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(requester, got)
+
+	bz, err := types.SerializeCosmosResponse([]abci.ResponseQuery{{Value: []byte("value")}})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement(types.InterchainQueryPacketAck{Data: bz}.GetBytes())
+	suite.Require().NoError(k.OnAcknowledgementPacket(suite.ctx, packet, ack))
+	_, found = k.GetPendingQuery(suite.ctx, "channel-0", 1)
+	suite.Require().False(found)
+
+	// the result is delivered only once
+	suite.Require().ErrorIs(k.OnAcknowledgementPacket(suite.ctx, packet, ack), types.ErrPendingQueryNotFound)
+
+	k.SetPendingQuery(suite.ctx, "channel-0", 2, requester)
+	packet.Sequence = 2
+	suite.Require().NoError(k.OnAcknowledgementPacket(suite.ctx, packet, channeltypes.NewErrorAcknowledgement("failed")))
+	suite.Require().Empty(k.GetAllPendingQueries(suite.ctx))
+}
+
+// mockCallbacks writes the delivered result to the store and consumes the given gas
+type mockCallbacks struct {
+	key sdk.StoreKey
+	gas uint64
+}
+
+func (m mockCallbacks) OnInterchainQueryResult(ctx sdk.Context, requester sdk.AccAddress, channelID string, sequence uint64,
+	responses []abci.ResponseQuery, errMsg string) error {
+	ctx.KVStore(m.key).Set([]byte("result"), requester)
+	ctx.GasMeter().ConsumeGas(m.gas, "mock callback")
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestDeliverResultGasLimit() {
+	const gasLimit = 100000
+	requester := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	packet := channeltypes.NewPacket(nil, 1, types.PortID, "channel-0", types.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+
+	testCases := []struct {
+		msg     string
+		gas     uint64
+		success bool
+	}{
+		{"callback within the gas limit", gasLimit / 2, true},
+		{"callback out of gas", gasLimit * 2, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			key := suite.app.GetKey(types.StoreKey)
+			k := suite.app.ICQKeeper
+			k.ReplaceCallbacks(mockCallbacks{key: key, gas: tc.gas}, gasLimit)
+			k.SetPendingQuery(suite.ctx, "channel-0", 1, requester)
+
+			ctx := suite.ctx
+			ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+			ctx.SetEventManager(sdk.NewEventManager())
+			// the packet is timed out whether the callback succeeds or not
+			suite.Require().NoError(k.OnTimeoutPacket(ctx, packet))
+			suite.Require().Empty(k.GetAllPendingQueries(ctx))
+
+			var callbackErr bool
+			for _, event := range ctx.EventManager().Events() {
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyCallbackError {
+						callbackErr = true
+					}
+				}
+			}
+			suite.Require().Equal(!tc.success, callbackErr)
+
+			stored := ctx.KVStore(key).Get([]byte("result"))
+			if tc.success {
+				suite.Require().Equal([]byte(requester), stored)
+			} else {
+				// the writes of the callback are discarded and the whole gas limit is charged
+				suite.Require().Nil(stored)
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(gasLimit))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	k := suite.app.ICQKeeper
+	requester := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+
+	k.SetParams(suite.ctx, types.NewParams(true, []string{transferParamsPath}))
+	k.SetPendingQuery(suite.ctx, "channel-0", 5, requester)
+	k.SetPendingQuery(suite.ctx, "channel-10", 1, requester)
+
+	genesis := k.ExportGenesis(suite.ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Equal(types.PortID, genesis.HostPort)
+	suite.Require().Equal([]string{transferParamsPath}, genesis.Params.AllowQueries)
+	suite.Require().Equal([]types.PendingQuery{
+		{ChannelId: "channel-0", Sequence: 5, Requester: requester.String()},
+		{ChannelId: "channel-10", Sequence: 1, Requester: requester.String()},
+	}, genesis.PendingQueries)
+}
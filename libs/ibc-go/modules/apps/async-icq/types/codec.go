@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	codectypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types"
+	txmsg "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary interchain query interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgSendQuery{}, "cosmos-sdk/MsgSendInterchainQuery", nil)
+}
+
+// RegisterInterfaces register the interchain query module interfaces to protobuf
+// Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*txmsg.Msg)(nil),
+		&MsgSendQuery{},
+	)
+	registry.RegisterImplementations(
+		(*types.Msg)(nil),
+		&MsgSendQuery{},
+	)
+	registry.RegisterImplementations((*types.MsgProtoAdapter)(nil), &MsgSendQuery{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino = codec.New()
+
+	// ModuleCdc references the global interchain query module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(Amino)
+	Amino.Seal()
+}
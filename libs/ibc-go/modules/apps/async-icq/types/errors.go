@@ -0,0 +1,14 @@
+package types
+
+import sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+
+// interchain query sentinel errors
+var (
+	ErrUnknownDataType      = sdkerrors.Register(ModuleName, 2, "unknown data type")
+	ErrInvalidChannelFlow   = sdkerrors.Register(ModuleName, 3, "invalid message sent to channel end")
+	ErrInvalidHostPort      = sdkerrors.Register(ModuleName, 4, "invalid host port")
+	ErrHostDisabled         = sdkerrors.Register(ModuleName, 5, "host is disabled")
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 6, "invalid version")
+	ErrInvalidQuery         = sdkerrors.Register(ModuleName, 7, "invalid query")
+	ErrPendingQueryNotFound = sdkerrors.Register(ModuleName, 8, "pending query not found")
+)
@@ -0,0 +1,17 @@
+package types
+
+// interchain query events
+const (
+	EventTypePacket       = "icq_packet"
+	EventTypeSendQuery    = "send_interchain_query"
+	EventTypeQueryResult  = "interchain_query_result"
+	EventTypeTimeout      = "timeout"
+	EventTypeChannelClose = "channel_closed"
+
+	AttributeKeyRequester     = "requester"
+	AttributeKeyChannelID     = "channel_id"
+	AttributeKeySequence      = "sequence"
+	AttributeKeyAckSuccess    = "success"
+	AttributeKeyAckError      = "error"
+	AttributeKeyCallbackError = "callback_error"
+)
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	ibcexported "github.com/okx/okbchain/libs/ibc-go/modules/core/exported"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+// QueryCallbacks defines the callbacks which deliver the results of the interchain queries
+// sent by the controller to their requesters
+type QueryCallbacks interface {
+	// OnInterchainQueryResult is called when the query packet is acknowledged or timed out, the
+	// responses are nil if the query failed on the host chain or the packet timed out
+	OnInterchainQueryResult(
+		ctx sdk.Context, requester sdk.AccAddress, channelID string, sequence uint64,
+		responses []abci.ResponseQuery, errMsg string,
+	) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+)
+
+// NewGenesisState creates a new interchain query GenesisState instance.
+func NewGenesisState(hostPort string, params Params, pendingQueries []PendingQuery) *GenesisState {
+	return &GenesisState{
+		HostPort:       hostPort,
+		Params:         params,
+		PendingQueries: pendingQueries,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with "icqhost" as the default host port.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.HostPort); err != nil {
+		return err
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, pq := range gs.PendingQueries {
+		if err := host.ChannelIdentifierValidator(pq.ChannelId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(pq.Requester); err != nil {
+			return err
+		}
+		key := string(PendingQueryKey(pq.ChannelId, pq.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicated pending query %s/%d", pq.ChannelId, pq.Sequence)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const requester = "0xbbE4733d85bc2b90682147779DA49caB38C0aA1F"
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default genesis", DefaultGenesisState(), true},
+		{"valid genesis", NewGenesisState(PortID, NewParams(true, []string{queryPath}), []PendingQuery{
+			{ChannelId: "channel-0", Sequence: 1, Requester: requester},
+			{ChannelId: "channel-0", Sequence: 2, Requester: requester},
+		}), true},
+		{"invalid host port", NewGenesisState("", DefaultParams(), nil), false},
+		{"blank allowed query", NewGenesisState(PortID, NewParams(true, []string{" "}), nil), false},
+		{"invalid channel", NewGenesisState(PortID, DefaultParams(), []PendingQuery{
+			{ChannelId: "ch", Sequence: 1, Requester: requester},
+		}), false},
+		{"invalid requester", NewGenesisState(PortID, DefaultParams(), []PendingQuery{
+			{ChannelId: "channel-0", Sequence: 1, Requester: "requester"},
+		}), false},
+		{"duplicated pending query", NewGenesisState(PortID, DefaultParams(), []PendingQuery{
+			{ChannelId: "channel-0", Sequence: 1, Requester: requester},
+			{ChannelId: "channel-0", Sequence: 1, Requester: requester},
+		}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
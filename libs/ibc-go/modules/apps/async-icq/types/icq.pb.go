@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_query/v1/icq.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of on-chain interchain query parameters.
+// The following parameters may be used to disable the host.
+type Params struct {
+	// host_enabled enables or disables the host submodule.
+	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
+	// allow_queries defines a list of query paths allowed to be queried on a host chain.
+	AllowQueries []string `protobuf:"bytes,2,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_433d978b14186dc3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_query.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_query/v1/icq.proto", fileDescriptor_433d978b14186dc3)
+}
+
+var fileDescriptor_433d978b14186dc3 = []byte{
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0x02, 0x41,
+	0x14, 0x45, 0x19, 0x4d, 0x88, 0xae, 0xd8, 0x20, 0x89, 0xc4, 0x62, 0x20, 0x1b, 0x0b, 0x1a, 0xe6,
+	0x85, 0xd8, 0x91, 0x98, 0x18, 0x12, 0x7b, 0xa5, 0xa4, 0x21, 0x33, 0xc3, 0x64, 0x79, 0x61, 0x76,
+	0xdf, 0xb2, 0x33, 0xa0, 0x5b, 0xfb, 0x03, 0x7e, 0x96, 0x25, 0xa5, 0x15, 0x31, 0xec, 0x1f, 0xf0,
+	0x05, 0x66, 0x77, 0x1b, 0xb4, 0x9b, 0x93, 0x7b, 0x4f, 0x26, 0xf7, 0x05, 0x02, 0x95, 0x06, 0x99,
+	0xa6, 0x16, 0xb5, 0xf4, 0x48, 0x89, 0x03, 0x4c, 0xbc, 0xc9, 0xf4, 0x52, 0x62, 0x32, 0x5f, 0x6f,
+	0x4c, 0x96, 0xc3, 0x76, 0x04, 0xa8, 0xd7, 0x22, 0xcd, 0xc8, 0x53, 0xfb, 0x1e, 0x95, 0x16, 0xa7,
+	0x7d, 0xf1, 0xbf, 0x2f, 0xb6, 0xa3, 0xbb, 0x4e, 0x44, 0x11, 0x55, 0x02, 0x94, 0xaf, 0xda, 0x0d,
+	0x3f, 0x58, 0xd0, 0x7c, 0x91, 0x99, 0x8c, 0x5d, 0x7b, 0x1c, 0xb4, 0x96, 0xe4, 0xfc, 0xdc, 0x24,
+	0x52, 0x59, 0xb3, 0xe8, 0xb2, 0x3e, 0x1b, 0x5c, 0x4c, 0x6e, 0x8f, 0xfb, 0xde, 0x4d, 0x2e, 0x63,
+	0x3b, 0x0e, 0x4f, 0xd3, 0x70, 0x7a, 0x55, 0xe2, 0x73, 0x4d, 0xed, 0xc7, 0xe0, 0x5a, 0x5a, 0x4b,
+	0x6f, 0xd5, 0x77, 0x68, 0x5c, 0xf7, 0xac, 0x7f, 0x3e, 0xb8, 0x9c, 0x74, 0x8f, 0xfb, 0x5e, 0xa7,
+	0x96, 0xff, 0xc4, 0xe1, 0xb4, 0x55, 0xf1, 0x6b, 0x8d, 0x93, 0xd9, 0xd7, 0x81, 0xb3, 0xdd, 0x81,
+	0xb3, 0x9f, 0x03, 0x67, 0x9f, 0x05, 0x6f, 0xec, 0x0a, 0xde, 0xf8, 0x2e, 0x78, 0x63, 0xf6, 0x14,
+	0xa1, 0x5f, 0x6e, 0x94, 0xd0, 0x14, 0x03, 0xad, 0xde, 0x81, 0x56, 0xaa, 0xda, 0x05, 0x16, 0x95,
+	0x03, 0x54, 0x7a, 0x18, 0x11, 0xc4, 0xb4, 0xd8, 0x58, 0xe3, 0xca, 0x9b, 0x39, 0x90, 0x2e, 0x4f,
+	0xf4, 0x10, 0xf5, 0x1a, 0x7c, 0x9e, 0x1a, 0xa7, 0x9a, 0xd5, 0xd0, 0x87, 0xdf, 0x01, 0x00, 0x0b,
+	0xa7, 0xe2, 0x78, 0x56, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintIcq(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcq(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcq(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovIcq(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIcq(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcq(x uint64) (n int) {
+	return sovIcq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcq(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcq
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcq
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcq
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcq
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcq
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcq        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcq          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcq = fmt.Errorf("proto: unexpected end of group")
+)
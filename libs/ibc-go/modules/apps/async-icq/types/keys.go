@@ -0,0 +1,36 @@
+package types
+
+import sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the interchain query module name, routes can only contain alphanumeric characters
+	ModuleName = "interchainquery"
+
+	// Version defines the current version the interchain query module supports
+	Version = "icq-1"
+
+	// PortID is the default port id that the interchain query module binds to
+	PortID = "icqhost"
+
+	// StoreKey is the store key string for the interchain query module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the interchain query module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the interchain query module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// PendingQueryKeyPrefix defines the key prefix to store the requesters of the queries sent by the controller
+	PendingQueryKeyPrefix = []byte{0x02}
+)
+
+// PendingQueryKey returns the store key of the requester of the query packet sent on the channel
+func PendingQueryKey(channelID string, sequence uint64) []byte {
+	key := append(PendingQueryKeyPrefix, []byte(channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/common"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+var _ sdk.Msg = &MsgSendQuery{}
+
+// NewMsgSendQuery creates a new MsgSendQuery instance
+//
+//nolint:interfacer
+func NewMsgSendQuery(
+	channelID string, requests []abci.RequestQuery, sender sdk.AccAddress,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgSendQuery {
+	return &MsgSendQuery{
+		ChannelId:        channelID,
+		Requests:         requests,
+		Sender:           sender.String(),
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSendQuery) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgSendQuery) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// ValidateBasic performs a basic check of the MsgSendQuery fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgSendQuery) ValidateBasic() error {
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if err := ValidateRequests(msg.Requests); err != nil {
+		return err
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgSendQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendQuery) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSendQuery) ValidWithHeight(h int64) error {
+	return common.MsgNotSupportBeforeVenus8Height(&msg, h)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+var (
+	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+	// relative to the current block timestamp. The default is currently set to a 10 minute timeout.
+	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+)
+
+// NewInterchainQueryPacketData constructs a new InterchainQueryPacketData instance
+func NewInterchainQueryPacketData(data []byte, memo string) InterchainQueryPacketData {
+	return InterchainQueryPacketData{
+		Data: data,
+		Memo: memo,
+	}
+}
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+func (iqpd InterchainQueryPacketData) ValidateBasic() error {
+	if len(iqpd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuery, "packet data cannot be empty")
+	}
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (iqpd InterchainQueryPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&iqpd)
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (iqpa InterchainQueryPacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&iqpa)
+}
+
+// ValidateRequests performs basic validation of the queries sent to the host chain. The host only
+// serves the queries of the latest state without proofs.
+func ValidateRequests(reqs []abci.RequestQuery) error {
+	if len(reqs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuery, "requests cannot be empty")
+	}
+	for i, req := range reqs {
+		if req.Path == "" {
+			return sdkerrors.Wrapf(ErrInvalidQuery, "the path of request %d cannot be empty", i)
+		}
+		if req.Height != 0 {
+			return sdkerrors.Wrapf(ErrInvalidQuery, "the height of request %d must be 0", i)
+		}
+		if req.Prove {
+			return sdkerrors.Wrapf(ErrInvalidQuery, "the proof of request %d is not supported", i)
+		}
+	}
+	return nil
+}
+
+// SerializeCosmosQuery serializes the requests into the data of the interchain query packet
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	q := &CosmosQuery{
+		Requests: reqs,
+	}
+	return ModuleCdc.Marshal(q)
+}
+
+// DeserializeCosmosQuery deserializes the requests from the data of the interchain query packet
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var q CosmosQuery
+	if err := ModuleCdc.Unmarshal(bz, &q); err != nil {
+		return nil, sdkerrors.Wrap(ErrUnknownDataType, err.Error())
+	}
+	return q.Requests, nil
+}
+
+// SerializeCosmosResponse serializes the responses into the data of the interchain query acknowledgement
+func SerializeCosmosResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	r := &CosmosResponse{
+		Responses: resps,
+	}
+	return ModuleCdc.Marshal(r)
+}
+
+// DeserializeCosmosResponse deserializes the responses from the data of the interchain query acknowledgement
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var r CosmosResponse
+	if err := ModuleCdc.Unmarshal(bz, &r); err != nil {
+		return nil, sdkerrors.Wrap(ErrUnknownDataType, err.Error())
+	}
+	return r.Responses, nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+const queryPath = "/ibc.applications.transfer.v1.Query/Params"
+
+func TestValidateRequests(t *testing.T) {
+	testCases := []struct {
+		name    string
+		reqs    []abci.RequestQuery
+		expPass bool
+	}{
+		{"valid requests", []abci.RequestQuery{{Path: queryPath}, {Path: queryPath, Data: []byte{1}}}, true},
+		{"empty requests", nil, false},
+		{"missing path", []abci.RequestQuery{{Path: queryPath}, {Data: []byte{1}}}, false},
+		{"historical height", []abci.RequestQuery{{Path: queryPath, Height: 10}}, false},
+		{"proof requested", []abci.RequestQuery{{Path: queryPath, Prove: true}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateRequests(tc.reqs)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSerializeCosmosQuery(t *testing.T) {
+	reqs := []abci.RequestQuery{{Path: queryPath, Data: []byte("data")}, {Path: queryPath}}
+	bz, err := SerializeCosmosQuery(reqs)
+	require.NoError(t, err)
+	require.NoError(t, NewInterchainQueryPacketData(bz, "").ValidateBasic())
+
+	got, err := DeserializeCosmosQuery(bz)
+	require.NoError(t, err)
+	require.Equal(t, len(reqs), len(got))
+	for i := range reqs {
+		require.Equal(t, reqs[i].Path, got[i].Path)
+		require.Equal(t, reqs[i].Data, got[i].Data)
+	}
+
+	_, err = DeserializeCosmosQuery([]byte("invalid"))
+	require.Error(t, err)
+	require.Error(t, NewInterchainQueryPacketData(nil, "memo").ValidateBasic())
+}
+
+func TestSerializeCosmosResponse(t *testing.T) {
+	resps := []abci.ResponseQuery{{Value: []byte("value"), Height: 10}, {Code: 1}}
+	bz, err := SerializeCosmosResponse(resps)
+	require.NoError(t, err)
+
+	got, err := DeserializeCosmosResponse(bz)
+	require.NoError(t, err)
+	require.Equal(t, len(resps), len(got))
+	for i := range resps {
+		require.Equal(t, resps[i].Code, got[i].Code)
+		require.Equal(t, resps[i].Value, got[i].Value)
+		require.Equal(t, resps[i].Height, got[i].Height)
+	}
+}
@@ -0,0 +1,759 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_query/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	types "github.com/okx/okbchain/libs/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSendQuery defines a msg to query the state of a counterparty chain, the
+// responses are delivered to the sender when the packet is acknowledged.
+type MsgSendQuery struct {
+	// the channel by which the packet will be sent
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the abci queries executed by the host chain
+	Requests []types.RequestQuery `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests"`
+	// the sender address
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Timeout height relative to the current block height.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types1.Height `protobuf:"bytes,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *MsgSendQuery) Reset()         { *m = MsgSendQuery{} }
+func (m *MsgSendQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgSendQuery) ProtoMessage()    {}
+func (*MsgSendQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb74eaf9f4f1ab9e, []int{0}
+}
+func (m *MsgSendQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendQuery.Merge(m, src)
+}
+func (m *MsgSendQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendQuery proto.InternalMessageInfo
+
+// MsgSendQueryResponse defines the Msg/SendQuery response type.
+type MsgSendQueryResponse struct {
+	// sequence number of the query packet sent
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgSendQueryResponse) Reset()         { *m = MsgSendQueryResponse{} }
+func (m *MsgSendQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendQueryResponse) ProtoMessage()    {}
+func (*MsgSendQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb74eaf9f4f1ab9e, []int{1}
+}
+func (m *MsgSendQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendQueryResponse.Merge(m, src)
+}
+func (m *MsgSendQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendQueryResponse proto.InternalMessageInfo
+
+func (m *MsgSendQueryResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgSendQuery)(nil), "ibc.applications.interchain_query.v1.MsgSendQuery")
+	proto.RegisterType((*MsgSendQueryResponse)(nil), "ibc.applications.interchain_query.v1.MsgSendQueryResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_query/v1/tx.proto", fileDescriptor_fb74eaf9f4f1ab9e)
+}
+
+var fileDescriptor_fb74eaf9f4f1ab9e = []byte{
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0xdb, 0x50, 0x25, 0x57, 0x40, 0xd4, 0x6a, 0x91, 0x65, 0xc0, 0x8e, 0x0c, 0x43, 0x96,
+	0xdc, 0x29, 0x86, 0x29, 0x13, 0xb2, 0x84, 0x44, 0x87, 0x0e, 0x18, 0xa6, 0x2e, 0xc1, 0x3e, 0x9f,
+	0xec, 0x53, 0xed, 0x3b, 0xc7, 0x77, 0x8e, 0x1a, 0xb1, 0x31, 0x31, 0xf2, 0x27, 0xf4, 0xcf, 0xe9,
+	0xd8, 0x11, 0x31, 0x44, 0x28, 0x59, 0x98, 0xf3, 0x17, 0xa0, 0xb3, 0x9d, 0x1f, 0x65, 0x82, 0xc9,
+	0xf7, 0xde, 0x7d, 0xdf, 0xfb, 0xbe, 0xf7, 0xfc, 0x0e, 0x0c, 0x69, 0x84, 0x51, 0x58, 0x14, 0x19,
+	0xc5, 0xa1, 0xa4, 0x9c, 0x09, 0x44, 0x99, 0x24, 0x25, 0x4e, 0x43, 0xca, 0x26, 0xd3, 0x8a, 0x94,
+	0x73, 0x34, 0x1b, 0x21, 0x79, 0x0d, 0x8b, 0x92, 0x4b, 0x6e, 0xbc, 0xa2, 0x11, 0x86, 0xfb, 0x70,
+	0xf8, 0x37, 0x1c, 0xce, 0x46, 0xd6, 0x69, 0xc2, 0x13, 0x5e, 0x13, 0x90, 0x3a, 0x35, 0x5c, 0xeb,
+	0x99, 0x24, 0x2c, 0x26, 0x65, 0x4e, 0x99, 0x44, 0x61, 0x84, 0x29, 0x92, 0xf3, 0x82, 0x88, 0xf6,
+	0xd2, 0x51, 0x3e, 0x30, 0x2f, 0x09, 0xc2, 0x19, 0x25, 0x4c, 0x2a, 0xd5, 0xe6, 0xd4, 0x00, 0xdc,
+	0x9f, 0x07, 0xe0, 0xe1, 0x85, 0x48, 0x3e, 0x12, 0x16, 0x7f, 0x50, 0x3a, 0xc6, 0x1b, 0x00, 0x70,
+	0x1a, 0x32, 0x46, 0xb2, 0x09, 0x8d, 0x4d, 0xbd, 0xaf, 0x0f, 0x7a, 0xfe, 0xd9, 0x7a, 0xe1, 0x9c,
+	0xcc, 0xc3, 0x3c, 0x1b, 0xbb, 0xbb, 0x3b, 0x37, 0xe8, 0xb5, 0xc1, 0x79, 0x6c, 0xbc, 0x03, 0xdd,
+	0x92, 0x4c, 0x2b, 0x22, 0xa4, 0x30, 0x0f, 0xfa, 0x87, 0x83, 0x63, 0xef, 0x25, 0xdc, 0xf9, 0x82,
+	0xca, 0x17, 0x6c, 0x7c, 0x05, 0x0d, 0xac, 0x16, 0xf3, 0x3b, 0xb7, 0x0b, 0x47, 0x0b, 0xb6, 0x54,
+	0xe3, 0x29, 0x38, 0x12, 0x35, 0xcb, 0x3c, 0x54, 0xc2, 0x41, 0x1b, 0x19, 0x9f, 0xc1, 0x63, 0x49,
+	0x73, 0xc2, 0x2b, 0x39, 0x49, 0x09, 0x4d, 0x52, 0x69, 0x76, 0xfa, 0xfa, 0xe0, 0xd8, 0xb3, 0xa0,
+	0x1a, 0x9c, 0xea, 0x0f, 0xb6, 0x5d, 0xcd, 0x46, 0xf0, 0x7d, 0x8d, 0xf0, 0x5f, 0xa8, 0xda, 0xeb,
+	0x85, 0x73, 0xd6, 0x18, 0xbf, 0xcf, 0x77, 0x83, 0x47, 0x6d, 0xa2, 0x41, 0x1b, 0xe7, 0xe0, 0x64,
+	0x83, 0x50, 0x5f, 0x21, 0xc3, 0xbc, 0x30, 0x1f, 0xf4, 0xf5, 0x41, 0xc7, 0x7f, 0xbe, 0x5e, 0x38,
+	0xe6, 0xfd, 0x22, 0x5b, 0x88, 0x1b, 0x3c, 0x69, 0x73, 0x9f, 0x36, 0xa9, 0x71, 0xf7, 0xdb, 0x8d,
+	0xa3, 0xfd, 0xbe, 0x71, 0x34, 0xd7, 0x03, 0xa7, 0xfb, 0xb3, 0x0d, 0x88, 0x28, 0x38, 0x13, 0xc4,
+	0xb0, 0x40, 0x57, 0xa8, 0x96, 0x19, 0x26, 0xf5, 0x84, 0x3b, 0xc1, 0x36, 0xf6, 0xbe, 0xea, 0xe0,
+	0xf0, 0x42, 0x24, 0xc6, 0x17, 0xd0, 0xdb, 0xfd, 0x14, 0x0f, 0xfe, 0xcb, 0x82, 0xc0, 0x7d, 0x31,
+	0x6b, 0xfc, 0xff, 0x9c, 0x8d, 0x41, 0xff, 0xf2, 0x76, 0x69, 0xeb, 0x77, 0x4b, 0x5b, 0xff, 0xb5,
+	0xb4, 0xf5, 0xef, 0x2b, 0x5b, 0xbb, 0x5b, 0xd9, 0xda, 0x8f, 0x95, 0xad, 0x5d, 0xbe, 0x4d, 0xa8,
+	0x4c, 0xab, 0x08, 0x62, 0x9e, 0x23, 0x7e, 0x75, 0x8d, 0xf8, 0x55, 0x54, 0x17, 0x44, 0x19, 0x8d,
+	0x04, 0xa2, 0x11, 0x1e, 0x26, 0x1c, 0xe5, 0x3c, 0xae, 0x32, 0x22, 0xd4, 0x03, 0x10, 0x28, 0x14,
+	0x73, 0x86, 0x87, 0x14, 0x4f, 0x9b, 0xc5, 0x8c, 0x8e, 0xea, 0xc5, 0x7b, 0xfd, 0x67, 0x00, 0xe9,
+	0x50, 0x82, 0xfb, 0x23, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SendQuery defines a rpc handler method for MsgSendQuery.
+	SendQuery(ctx context.Context, in *MsgSendQuery, opts ...grpc.CallOption) (*MsgSendQueryResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SendQuery(ctx context.Context, in *MsgSendQuery, opts ...grpc.CallOption) (*MsgSendQueryResponse, error) {
+	out := new(MsgSendQueryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_query.v1.Msg/SendQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SendQuery defines a rpc handler method for MsgSendQuery.
+	SendQuery(context.Context, *MsgSendQuery) (*MsgSendQueryResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SendQuery(ctx context.Context, req *MsgSendQuery) (*MsgSendQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendQuery not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SendQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_query.v1.Msg/SendQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendQuery(ctx, req.(*MsgSendQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_query.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendQuery",
+			Handler:    _Msg_SendQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_query/v1/tx.proto",
+}
+
+func (m *MsgSendQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgSendQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, types.RequestQuery{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
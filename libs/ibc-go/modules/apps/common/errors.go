@@ -22,3 +22,10 @@ func MsgNotSupportBeforeHeight(msg proto.Message, h int64) error {
 	}
 	return errors.New(fmt.Sprintf("msg:%s not support before height:%d", sdk.MsgTypeURL(msg), types.GetVenus4Height()))
 }
+
+func MsgNotSupportBeforeVenus8Height(msg proto.Message, h int64) error {
+	if types.HigherThanVenus8(h) {
+		return nil
+	}
+	return errors.New(fmt.Sprintf("msg:%s not support before height:%d", sdk.MsgTypeURL(msg), types.GetVenus8Height()))
+}
@@ -10,6 +10,7 @@ import (
 
 var (
 	_ upgrade.UpgradeModule = (*Venus3BaseUpgradeModule)(nil)
+	_ upgrade.UpgradeModule = (*Venus8BaseUpgradeModule)(nil)
 
 	ibcV4Map = map[string]struct{}{
 		"feeibc":             {},
@@ -30,6 +31,24 @@ var (
 			}
 		}
 	}
+
+	ibcV8Map = map[string]struct{}{
+		"packetfwd":       {},
+		"nfttransfer":     {},
+		"erc721":          {},
+		"interchainquery": {},
+	}
+
+	venus8IBCVersionFilter cosmost.VersionFilter = func(h int64) func(callback cosmost.VersionCallback) {
+		if h < 0 {
+			return func(callback cosmost.VersionCallback) {}
+		}
+		return func(callback cosmost.VersionCallback) {
+			for name := range ibcV8Map {
+				callback(name, tmtypes.GetVenus8Height())
+			}
+		}
+	}
 )
 
 type Venus3BaseUpgradeModule struct {
@@ -99,3 +118,71 @@ func (v *Venus3BaseUpgradeModule) VersionFilter() *cosmost.VersionFilter {
 func (v *Venus3BaseUpgradeModule) UpgradeHeight() int64 {
 	return tmtypes.GetVenus4Height()
 }
+
+type Venus8BaseUpgradeModule struct {
+	*base.BaseIBCUpgradeModule
+}
+
+func NewVenus8BaseUpgradeModule(m module.AppModuleBasic) *Venus8BaseUpgradeModule {
+	ret := &Venus8BaseUpgradeModule{}
+	ret.BaseIBCUpgradeModule = base.NewBaseIBCUpgradeModule(m)
+
+	return ret
+}
+
+func (v *Venus8BaseUpgradeModule) CommitFilter() *cosmost.StoreFilter {
+	var filter cosmost.StoreFilter
+	filter = func(module string, h int64, s cosmost.CommitKVStore) bool {
+		_, exist := ibcV8Map[module]
+		if !exist {
+			return false
+		}
+
+		if v.UpgradeHeight() == 0 {
+			return true
+		}
+
+		if h == tmtypes.GetVenus8Height() {
+			if s != nil {
+				s.SetUpgradeVersion(h)
+			}
+			return false
+		}
+
+		if tmtypes.HigherThanVenus8(h) {
+			return false
+		}
+
+		return true
+	}
+	return &filter
+}
+
+func (v *Venus8BaseUpgradeModule) PruneFilter() *cosmost.StoreFilter {
+	var filter cosmost.StoreFilter
+	filter = func(module string, h int64, s cosmost.CommitKVStore) bool {
+		_, exist := ibcV8Map[module]
+		if !exist {
+			return false
+		}
+
+		if v.UpgradeHeight() == 0 {
+			return true
+		}
+		// ibc module && >=venus8
+		if tmtypes.HigherThanVenus8(h) {
+			return false
+		}
+
+		return true
+	}
+	return &filter
+}
+
+func (v *Venus8BaseUpgradeModule) VersionFilter() *cosmost.VersionFilter {
+	return &venus8IBCVersionFilter
+}
+
+func (v *Venus8BaseUpgradeModule) UpgradeHeight() int64 {
+	return tmtypes.GetVenus8Height()
+}
@@ -0,0 +1,11 @@
+package nfttransfer
+
+import (
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+	ModuleCdc = types.ModuleCdc
+)
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/spf13/cobra"
+)
+
+// NewTxCmd returns the transaction commands for IBC non fungible token transfer
+func NewTxCmd(cdc *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "nft-transfer",
+		Short:                      "IBC non fungible token transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTransferTxCmd(cdc, reg),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,25 @@
+package nfttransfer
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+)
+
+// NewHandler returns sdk.Handler for IBC non fungible token transfer module messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgTransfer:
+			res, err := k.Transfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-721 nft-transfer message type: %T", msg)
+		}
+	}
+}
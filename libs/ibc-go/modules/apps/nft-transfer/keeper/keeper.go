@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/prefix"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	capabilitykeeper "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	tmbytes "github.com/okx/okbchain/libs/tendermint/libs/bytes"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+)
+
+// Keeper defines the IBC non fungible transfer keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.CodecProxy
+
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	nftKeeper     types.NFTKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+}
+
+// NewKeeper creates a new IBC nft-transfer Keeper instance
+func NewKeeper(
+	cdc *codec.CodecProxy, key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	nftKeeper types.NFTKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		nftKeeper:     nftKeeper,
+		scopedKeeper:  scopedKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// IsBound checks if the nft-transfer module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the nft-transfer module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the nft-transfer module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// GetClassTrace retrieves the full identifiers trace and base classID from the store.
+func (k Keeper) GetClassTrace(ctx sdk.Context, classTraceHash tmbytes.HexBytes) (types.ClassTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	bz := store.Get(classTraceHash)
+	if bz == nil {
+		return types.ClassTrace{}, false
+	}
+
+	var classTrace types.ClassTrace
+	k.cdc.GetProtocMarshal().MustUnmarshal(bz, &classTrace)
+	return classTrace, true
+}
+
+// HasClassTrace checks if a the key with the given class trace hash exists on the store.
+func (k Keeper) HasClassTrace(ctx sdk.Context, classTraceHash tmbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	return store.Has(classTraceHash)
+}
+
+// SetClassTrace sets a new {trace hash -> class trace} pair to the store.
+func (k Keeper) SetClassTrace(ctx sdk.Context, classTrace types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassTraceKey)
+	store.Set(classTrace.Hash(), k.cdc.GetProtocMarshal().MustMarshal(&classTrace))
+}
+
+// GetAllClassTraces returns the trace information for all the classes.
+func (k Keeper) GetAllClassTraces(ctx sdk.Context) types.Traces {
+	traces := types.Traces{}
+	k.IterateClassTraces(ctx, func(classTrace types.ClassTrace) bool {
+		traces = append(traces, classTrace)
+		return false
+	})
+
+	return traces.Sort()
+}
+
+// IterateClassTraces iterates over the class traces in the store
+// and performs a callback function.
+func (k Keeper) IterateClassTraces(ctx sdk.Context, cb func(classTrace types.ClassTrace) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClassTraceKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var classTrace types.ClassTrace
+		k.cdc.GetProtocMarshal().MustUnmarshal(iterator.Value(), &classTrace)
+		if cb(classTrace) {
+			break
+		}
+	}
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the nft-transfer module that can claim a capability that IBC module
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
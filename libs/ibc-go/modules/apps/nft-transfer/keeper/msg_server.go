@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// See createOutgoingPacket in spec:https://github.com/cosmos/ibc/tree/main/spec/app/ics-721-nft-transfer#packet-relay
+
+// Transfer defines a rpc handler method for MsgTransfer.
+func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	sequence, err := k.SendTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassId, msg.TokenIds,
+		sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC non fungible token transfer", "class", msg.ClassId, "tokens", strings.Join(msg.TokenIds, ","), "sender", msg.Sender, "receiver", msg.Receiver)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(msg.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
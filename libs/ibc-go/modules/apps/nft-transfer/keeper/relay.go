@@ -0,0 +1,291 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+)
+
+// SendTransfer handles nft-transfer sending logic. There are 2 possible cases:
+//
+// 1. Sender chain is acting as the source zone. The tokens are transferred
+// to an escrow address (i.e locked) on the sender chain and then transferred
+// to the receiving chain through IBC TAO logic. It is expected that the
+// receiving chain will mint vouchers to the receiving address.
+//
+// 2. Sender chain is acting as the sink zone. The tokens (vouchers) are burned
+// on the sender chain and then transferred to the receiving chain though IBC
+// TAO logic. It is expected that the receiving chain, which had previously
+// sent the original class, will unescrow the non fungible tokens and send
+// them to the receiving address.
+//
+// Like ICS-20, each send to any chain other than the one the class was previously
+// received from is a movement forwards in the class's timeline, which adds the
+// trace to the class's history, and the prefix is removed when the tokens are
+// sent backwards.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	// NOTE: class id correctness checked during msg.ValidateBasic
+	fullClassPath := classID
+
+	var err error
+	// deconstruct the class id into the class trace info
+	// to determine if the sender is the source chain
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		fullClassPath, err = k.ClassPathFromHash(ctx, classID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidClassID, "class %s not found", classID)
+	}
+
+	isSource := types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath)
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	tokenURIs := make([]string, len(tokenIDs))
+	tokenData := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		nft, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidTokenID, "token %s of class %s not found", tokenID, classID)
+		}
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !sender.Equals(owner) {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "token %s of class %s is not owned by %s", tokenID, classID, sender)
+		}
+		tokenURIs[i] = nft.GetURI()
+		tokenData[i] = nft.GetData()
+
+		if isSource {
+			// escrow source tokens
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, "", escrowAddress); err != nil {
+				return 0, err
+			}
+		} else {
+			// burn the vouchers which are going back to the source chain
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	// NOTE: SendTransfer simply sends the class id as it exists on its own
+	// chain inside the packet data. The receiving chain will perform class
+	// prefixing as necessary.
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.GetURI(), class.GetData(),
+		tokenIDs, tokenURIs, tokenData,
+		sender.String(), receiver, memo,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnRecvPacket processes a cross chain non fungible token transfer. If the
+// sender chain is the source of the class then vouchers will be minted
+// and sent to the receiving address. Otherwise if the sender chain is sending
+// back tokens this chain originally transferred to it, the tokens are
+// unescrowed and sent to the receiving address.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+	// chain would have prefixed with DestPort and DestChannel when originally
+	// receiving this class as seen in the "sender chain is the source" condition.
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// sender chain is not the source, unescrow tokens
+
+		// remove prefix added by sender chain
+		voucherPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedClassID := data.ClassId[len(voucherPrefix):]
+
+		// the class id used to send the tokens is either the native class id or the hash
+		// of the path if the class is not native
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for i, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !escrowAddress.Equals(owner) {
+				// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+				// counterparty module, which sends back the tokens which are not escrowed.
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "token %s of class %s is not escrowed", tokenID, classID)
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, data.TokenDataAt(i), receiver); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// sender chain is the source, mint vouchers
+
+	// since SendPacket did not prefix the class id, we must prefix class id here
+	sourcePrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	// NOTE: sourcePrefix contains the trailing "/"
+	prefixedClassID := sourcePrefix + data.ClassId
+
+	// construct the class trace from the full raw class id
+	classTrace := types.ParseClassTrace(prefixedClassID)
+	traceHash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, traceHash) {
+		k.SetClassTrace(ctx, classTrace)
+	}
+
+	voucherClassID := classTrace.IBCClassID()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, voucherClassID),
+		),
+	)
+
+	if err := k.nftKeeper.CreateOrUpdateClass(ctx, voucherClassID, data.ClassUri, data.ClassData); err != nil {
+		return err
+	}
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, voucherClassID, tokenID, data.TokenURIAt(i), data.TokenDataAt(i), receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement
+// was a success then nothing occurs. If the acknowledgement failed, then
+// the sender is refunded their tokens using the refundPacketToken function.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketToken(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was
+// never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketToken(ctx, packet, data)
+}
+
+// refundPacketToken will unescrow and send back the tokens back to sender
+// if the sending chain was the source chain. Otherwise, the sent tokens
+// were burnt in the original send so new tokens are minted and sent to
+// the sending address.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// NOTE: packet data type already checked in handler.go
+
+	// parse the class id from the full class path
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// unescrow tokens back to sender
+		for i, tokenID := range data.TokenIds {
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, data.TokenDataAt(i), sender); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// mint vouchers back to sender
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, classID, tokenID, data.TokenURIAt(i), data.TokenDataAt(i), sender); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClassPathFromHash returns the full class path prefix from an ibc class id with a hash
+// component.
+func (k Keeper) ClassPathFromHash(ctx sdk.Context, classID string) (string, error) {
+	// trim the class prefix, by default "ibc/"
+	hexHash := classID[len(types.ClassPrefix+"/"):]
+
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidClassID, err.Error())
+	}
+
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+
+	return classTrace.GetFullClassPath(), nil
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/okx/okbchain/app"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	nfttransfer "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.OKBChainApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+
+	suite.app = app.Setup(checkTx)
+	suite.ctx = suite.app.NewContext(checkTx, abci.Header{
+		Height:  1,
+		ChainID: "ethermint-3",
+		Time:    time.Now().UTC(),
+	})
+	// the nft-transfer port is bound by the venus8 upgrade task rather than at genesis
+	suite.Require().NoError(nfttransfer.NewAppModule(suite.app.NFTTransferKeeper).RegisterTask().Execute(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketAndRefund() {
+	k := suite.app.NFTTransferKeeper
+	nftKeeper := suite.app.Erc721Keeper
+	sender := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	receiver := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+
+	// the counterparty chain is the source of the class, vouchers are minted to the receiver
+	data := types.NewNonFungibleTokenPacketData("cryptopunks", "class-uri", "", []string{"1", "kitty"},
+		[]string{"uri1", "uri2"}, nil, sender.String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, types.PortID, "channel-1", types.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(k.OnRecvPacket(suite.ctx, packet, data))
+
+	trace := types.ParseClassTrace("nft-transfer/channel-0/cryptopunks")
+	voucherClassID := trace.IBCClassID()
+	suite.Require().True(k.HasClassTrace(suite.ctx, trace.Hash()))
+	fullPath, err := k.ClassPathFromHash(suite.ctx, voucherClassID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(trace.GetFullClassPath(), fullPath)
+
+	class, found := nftKeeper.GetClass(suite.ctx, voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal("class-uri", class.GetURI())
+	suite.Require().Equal(receiver, nftKeeper.GetOwner(suite.ctx, voucherClassID, "1"))
+	suite.Require().Equal(receiver, nftKeeper.GetOwner(suite.ctx, voucherClassID, "kitty"))
+	nft, found := nftKeeper.GetNFT(suite.ctx, voucherClassID, "kitty")
+	suite.Require().True(found)
+	suite.Require().Equal("uri2", nft.GetURI())
+
+	// tokens which are not escrowed can't be received back
+	back := types.NewNonFungibleTokenPacketData("nft-transfer/channel-1/cryptopunks", "", "", []string{"1"},
+		nil, nil, sender.String(), receiver.String(), "")
+	backPacket := channeltypes.NewPacket(back.GetBytes(), 2, types.PortID, "channel-1", types.PortID, "channel-0",
+		clienttypes.NewHeight(0, 100), 0)
+	suite.Require().Error(k.OnRecvPacket(suite.ctx, backPacket, back))
+
+	// the vouchers burned when sent back to the source chain are minted again on timeout
+	suite.Require().NoError(nftKeeper.Burn(suite.ctx, voucherClassID, "1"))
+	suite.Require().Nil(nftKeeper.GetOwner(suite.ctx, voucherClassID, "1"))
+	refund := types.NewNonFungibleTokenPacketData(trace.GetFullClassPath(), "", "", []string{"1"},
+		[]string{"uri1"}, nil, receiver.String(), sender.String(), "")
+	refundPacket := channeltypes.NewPacket(refund.GetBytes(), 1, types.PortID, "channel-0", types.PortID, "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(k.OnTimeoutPacket(suite.ctx, refundPacket, refund))
+	suite.Require().Equal(receiver, nftKeeper.GetOwner(suite.ctx, voucherClassID, "1"))
+
+	// a successful acknowledgement doesn't refund anything
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(k.OnAcknowledgementPacket(suite.ctx, refundPacket, refund, ack))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	k := suite.app.NFTTransferKeeper
+	trace := types.ParseClassTrace("nft-transfer/channel-0/cryptopunks")
+	k.SetClassTrace(suite.ctx, trace)
+
+	genesis := k.ExportGenesis(suite.ctx)
+	suite.Require().Equal(types.PortID, genesis.PortId)
+	suite.Require().Equal(types.Traces{trace}, genesis.Traces)
+	suite.Require().True(k.IsBound(suite.ctx, types.PortID))
+
+	suite.SetupTest()
+	suite.app.NFTTransferKeeper.InitGenesis(suite.ctx, *genesis)
+	suite.Require().Equal(genesis, suite.app.NFTTransferKeeper.ExportGenesis(suite.ctx))
+}
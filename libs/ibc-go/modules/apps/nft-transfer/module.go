@@ -0,0 +1,165 @@
+package nfttransfer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	clientCtx "github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	codectypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/module"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/upgrade"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/common"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/client/cli"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	_ module.AppModuleAdapter      = AppModule{}
+	_ module.AppModuleBasicAdapter = AppModuleBasic{}
+	_ upgrade.UpgradeModule        = AppModule{}
+)
+
+// AppModuleBasic is the IBC NFT Transfer AppModuleBasic
+type AppModuleBasic struct{}
+
+// Name implements AppModuleBasic interface
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers module concrete types into protobuf Any.
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the ibc
+// nft-transfer module.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the ibc nft-transfer module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var gs types.GenesisState
+	if err := ModuleCdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
+// RegisterRESTRoutes implements AppModuleBasic interface
+func (AppModuleBasic) RegisterRESTRoutes(clientCtx.CLIContext, *mux.Router) {}
+
+// RegisterGRPCGatewayRoutes implements AppModuleBasic interface
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx.CLIContext, *runtime.ServeMux) {}
+
+// GetTxCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetTxCmd(*codec.Codec) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) GetTxCmdV2(cdc *codec.CodecProxy, reg codectypes.InterfaceRegistry) *cobra.Command {
+	return cli.NewTxCmd(cdc, reg)
+}
+
+// GetQueryCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetQueryCmd(*codec.Codec) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) GetQueryCmdV2(*codec.CodecProxy, codectypes.InterfaceRegistry) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) RegisterRouterForGRPC(clientCtx.CLIContext, *mux.Router) {}
+
+// AppModule represents the AppModule for this module
+type AppModule struct {
+	*common.Venus8BaseUpgradeModule
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new ICS-721 nft-transfer module
+func NewAppModule(k keeper.Keeper) AppModule {
+	ret := AppModule{
+		keeper: k,
+	}
+	ret.Venus8BaseUpgradeModule = common.NewVenus8BaseUpgradeModule(ret)
+	return ret
+}
+
+// RegisterInvariants implements the AppModule interface
+func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {}
+
+// Route implements the AppModule interface
+func (AppModule) Route() string {
+	return types.RouterKey
+}
+
+// QuerierRoute implements the AppModule interface
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+func (AppModule) NewQuerierHandler() sdk.Querier {
+	return nil
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+}
+
+// InitGenesis is a no-op, the nft-transfer store is initialized by the venus8 upgrade task.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	return nil
+}
+
+// ExportGenesis is a no-op, the nft-transfer store only exists from the venus8 upgrade on.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return nil
+}
+
+// RegisterTask binds the nft-transfer port and initializes the default genesis at the venus8 height.
+func (am AppModule) RegisterTask() upgrade.HeightTask {
+	return upgrade.NewHeightTask(7, func(ctx sdk.Context) error {
+		data := ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+		am.initGenesis(ctx, data)
+		return nil
+	})
+}
+
+func (am AppModule) initGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	am.keeper.InitGenesis(ctx, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// BeginBlock implements the AppModule interface
+func (AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
+
+// EndBlock implements the AppModule interface
+func (AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	codectypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types"
+	txmsg "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/ibc nft-transfer interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgTransfer{}, "cosmos-sdk/MsgNFTTransfer", nil)
+}
+
+// RegisterInterfaces register the ibc nft-transfer module interfaces to protobuf
+// Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*txmsg.Msg)(nil),
+		&MsgTransfer{},
+	)
+	registry.RegisterImplementations(
+		(*types.Msg)(nil),
+		&MsgTransfer{},
+	)
+	registry.RegisterImplementations((*types.MsgProtoAdapter)(nil), &MsgTransfer{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino = codec.New()
+
+	// ModuleCdc references the global x/ibc nft-transfer module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(Amino)
+	Amino.Seal()
+}
@@ -0,0 +1,13 @@
+package types
+
+import sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+
+// IBC nft-transfer sentinel errors
+var (
+	ErrInvalidClassID      = sdkerrors.Register(ModuleName, 2, "invalid class id")
+	ErrInvalidTokenID      = sdkerrors.Register(ModuleName, 3, "invalid token id")
+	ErrInvalidPacket       = sdkerrors.Register(ModuleName, 4, "invalid non-fungible token packet")
+	ErrTraceNotFound       = sdkerrors.Register(ModuleName, 5, "class trace not found")
+	ErrInvalidVersion      = sdkerrors.Register(ModuleName, 6, "invalid ICS721 version")
+	ErrMaxTransferChannels = sdkerrors.Register(ModuleName, 7, "max nft-transfer channels")
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	ibcexported "github.com/okx/okbchain/libs/ibc-go/modules/core/exported"
+)
+
+// Class defines the class of non-fungible tokens which can be transferred by ICS-721
+type Class interface {
+	GetID() string
+	GetURI() string
+	GetData() string
+}
+
+// NFT defines the non-fungible token which can be transferred by ICS-721
+type NFT interface {
+	GetClassID() string
+	GetID() string
+	GetURI() string
+	GetData() string
+}
+
+// NFTKeeper defines the expected keeper which manages the non-fungible tokens on this chain,
+// i.e. the classes and tokens which are escrowed, unescrowed, minted or burned by ICS-721
+type NFTKeeper interface {
+	// CreateOrUpdateClass creates the voucher class of the received tokens if it doesn't exist,
+	// or updates its uri and data otherwise
+	CreateOrUpdateClass(ctx sdk.Context, classID, classURI, classData string) error
+	// Mint mints a voucher token of the class to the receiver
+	Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error
+	// Transfer moves the token from its current owner to the receiver, the token data is
+	// updated if it is not empty
+	Transfer(ctx sdk.Context, classID, tokenID, tokenData string, receiver sdk.AccAddress) error
+	// Burn burns the voucher token of the class
+	Burn(ctx sdk.Context, classID, tokenID string) error
+
+	// GetOwner returns the owner of the token, it returns nil if the token doesn't exist
+	GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress
+	HasClass(ctx sdk.Context, classID string) bool
+	GetClass(ctx sdk.Context, classID string) (Class, bool)
+	GetNFT(ctx sdk.Context, classID, tokenID string) (NFT, bool)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
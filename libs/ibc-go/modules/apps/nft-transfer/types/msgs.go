@@ -0,0 +1,105 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/common"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgTransfer = "transfer"
+)
+
+var _ sdk.Msg = &MsgTransfer{}
+
+// NewMsgTransfer creates a new MsgTransfer instance
+//
+//nolint:interfacer
+func NewMsgTransfer(
+	sourcePort, sourceChannel string,
+	classID string, tokenIDs []string, sender sdk.AccAddress, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassId:          classID,
+		TokenIds:         tokenIDs,
+		Sender:           sender.String(),
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgTransfer) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// ValidateBasic performs a basic check of the MsgTransfer fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+// NOTE: The recipient addresses format is not validated as the format defined by
+// the chain is not known to IBC.
+func (msg MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if err := ValidateIBCClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if len(msg.TokenIds) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "token ids cannot be blank")
+	}
+	seenTokens := make(map[string]bool)
+	for _, tokenID := range msg.TokenIds {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if seenTokens[tokenID] {
+			return sdkerrors.Wrapf(ErrInvalidTokenID, "duplicated token id %s", tokenID)
+		}
+		seenTokens[tokenID] = true
+	}
+	// NOTE: sender format must be validated as it is required by the GetSigners function.
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgTransfer) ValidWithHeight(h int64) error {
+	return common.MsgNotSupportBeforeVenus8Height(&msg, h)
+}
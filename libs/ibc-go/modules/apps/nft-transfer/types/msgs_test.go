@@ -0,0 +1,94 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	"github.com/okx/okbchain/libs/tendermint/crypto/secp256k1"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+)
+
+// define constants used for testing
+const (
+	validPort      = "testportid"
+	invalidPort    = "(invalidport1)"
+	validChannel   = "testchannel"
+	invalidChannel = "(invalidchannel1)"
+
+	ibcClassID = "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+)
+
+var (
+	addr1     = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2     = sdk.AccAddress("testaddr2").String()
+	emptyAddr = sdk.AccAddress([]byte{})
+
+	timeoutHeight = clienttypes.NewHeight(0, 10)
+)
+
+// TestMsgTransferRoute tests Route for MsgTransfer
+func TestMsgTransferRoute(t *testing.T) {
+	msg := NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1"}, addr1, addr2, timeoutHeight, 0, "")
+
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+// TestMsgTransferType tests Type for MsgTransfer
+func TestMsgTransferType(t *testing.T) {
+	msg := NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1"}, addr1, addr2, timeoutHeight, 0, "")
+
+	require.Equal(t, "/ibc.applications.nft_transfer.v1.MsgTransfer", msg.Type())
+}
+
+// TestMsgTransferValidation tests ValidateBasic for MsgTransfer
+func TestMsgTransferValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgTransfer
+		expPass bool
+	}{
+		{"valid msg with base class id", NewMsgTransfer(validPort, validChannel, "0x5A7f5d9F7b6e2f9F0f3C1e7b0e6a37C3b9E1d5C2", []string{"1", "2"}, addr1, addr2, timeoutHeight, 0, ""), true},
+		{"valid msg with ibc class id", NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"kitty"}, addr1, addr2, timeoutHeight, 0, "memo"), true},
+		{"invalid port id", NewMsgTransfer(invalidPort, validChannel, ibcClassID, []string{"1"}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"invalid channel id", NewMsgTransfer(validPort, invalidChannel, ibcClassID, []string{"1"}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"invalid class id", NewMsgTransfer(validPort, validChannel, "notibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", []string{"1"}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"missing token ids", NewMsgTransfer(validPort, validChannel, ibcClassID, nil, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"blank token id", NewMsgTransfer(validPort, validChannel, ibcClassID, []string{""}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"duplicated token ids", NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1", "1"}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"missing sender address", NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1"}, emptyAddr, addr2, timeoutHeight, 0, ""), false},
+		{"missing recipient address", NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1"}, addr1, "", timeoutHeight, 0, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgTransferGetSigners tests GetSigners for MsgTransfer
+func TestMsgTransferGetSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1"}, addr, addr2, timeoutHeight, 0, "")
+	res := msg.GetSigners()
+
+	require.Equal(t, []sdk.AccAddress{addr}, res)
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+// TestMsgTransferValidWithHeight tests that MsgTransfer is only accepted from venus8 on
+func TestMsgTransferValidWithHeight(t *testing.T) {
+	msg := NewMsgTransfer(validPort, validChannel, ibcClassID, []string{"1"}, addr1, addr2, timeoutHeight, 0, "")
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(10)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	require.Error(t, msg.ValidWithHeight(10))
+	require.NoError(t, msg.ValidWithHeight(11))
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+var (
+	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
+	// to the current block height of the counterparty chain provided by the client state. The
+	// timeout is disabled when set to 0.
+	DefaultRelativePacketTimeoutHeight = "0-1000"
+
+	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+	// relative to the current block timestamp of the counterparty chain provided by the client
+	// state. The timeout is disabled when set to 0. The default is currently set to a 10 minute
+	// timeout.
+	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+)
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic is used for validating the non-fungible token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(nftpd.ClassId) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+	if len(nftpd.TokenIds) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "token ids cannot be blank")
+	}
+	seenTokens := make(map[string]bool)
+	for _, tokenID := range nftpd.TokenIds {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if seenTokens[tokenID] {
+			return sdkerrors.Wrapf(ErrInvalidTokenID, "duplicated token id %s", tokenID)
+		}
+		seenTokens[tokenID] = true
+	}
+	// the uris and data of the tokens are optional, but they must match the token ids if provided
+	if len(nftpd.TokenUris) != 0 && len(nftpd.TokenUris) != len(nftpd.TokenIds) {
+		return sdkerrors.Wrap(ErrInvalidPacket, "the length of token uris must be 0 or equal to the length of token ids")
+	}
+	if len(nftpd.TokenData) != 0 && len(nftpd.TokenData) != len(nftpd.TokenIds) {
+		return sdkerrors.Wrap(ErrInvalidPacket, "the length of token data must be 0 or equal to the length of token ids")
+	}
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return ValidatePrefixedClassID(nftpd.ClassId)
+}
+
+// TokenURIAt returns the uri of the i-th token, or an empty string if the uris are not provided
+func (nftpd NonFungibleTokenPacketData) TokenURIAt(i int) string {
+	if len(nftpd.TokenUris) == 0 {
+		return ""
+	}
+	return nftpd.TokenUris[i]
+}
+
+// TokenDataAt returns the data of the i-th token, or an empty string if the data are not provided
+func (nftpd NonFungibleTokenPacketData) TokenDataAt(i int) string {
+	if len(nftpd.TokenData) == 0 {
+		return ""
+	}
+	return nftpd.TokenData[i]
+}
+
+// GetBytes is a helper for serialising. The packet data is encoded to the camel-cased JSON
+// defined by the ICS-721 specification.
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	buf := new(bytes.Buffer)
+	jm := &jsonpb.Marshaler{OrigName: false, EmitDefaults: false}
+	if err := jm.Marshal(buf, &nftpd); err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// DecodePacketData decodes the packet data, both the camel-cased and the snake-cased fields are accepted
+func DecodePacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return NonFungibleTokenPacketData{}, sdkerrors.Wrap(ErrInvalidPacket, err.Error())
+	}
+	return data, nil
+}
+
+// ValidatePrefixedClassID checks that the classID for an IBC non-fungible token packet is correctly prefixed.
+// The function will return no error if the given string follows one of the two formats:
+//
+//   - Prefixed classID: '{portIDN}/{channelIDN}/.../{portID0}/{channelID0}/baseClassID'
+//   - Unprefixed classID: 'baseClassID'
+func ValidatePrefixedClassID(classID string) error {
+	return ParseClassTrace(classID).Validate()
+}
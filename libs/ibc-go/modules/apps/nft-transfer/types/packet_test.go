@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	classID  = "nft-transfer/channel-0/cryptopunks"
+	sender   = "ex1jv65s3grqf6v6jl3dp4t6c9t9rk99cd86gvcyf"
+	receiver = "cosmos1w3jhxarpv3j8yvg4ufs4x"
+)
+
+// TestNonFungibleTokenPacketDataValidateBasic tests ValidateBasic for NonFungibleTokenPacketData
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData NonFungibleTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewNonFungibleTokenPacketData(classID, "uri", "data", []string{"1", "2"}, []string{"uri1", "uri2"}, []string{"", "data2"}, sender, receiver, ""), true},
+		{"valid packet without uris and data", NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, receiver, "memo"), true},
+		{"invalid class id", NewNonFungibleTokenPacketData("", "", "", []string{"1"}, nil, nil, sender, receiver, ""), false},
+		{"invalid class trace", NewNonFungibleTokenPacketData("nft-transfer/channel-0/", "", "", []string{"1"}, nil, nil, sender, receiver, ""), false},
+		{"missing token ids", NewNonFungibleTokenPacketData(classID, "", "", nil, nil, nil, sender, receiver, ""), false},
+		{"blank token id", NewNonFungibleTokenPacketData(classID, "", "", []string{" "}, nil, nil, sender, receiver, ""), false},
+		{"duplicated token ids", NewNonFungibleTokenPacketData(classID, "", "", []string{"1", "1"}, nil, nil, sender, receiver, ""), false},
+		{"mismatched token uris", NewNonFungibleTokenPacketData(classID, "", "", []string{"1", "2"}, []string{"uri1"}, nil, sender, receiver, ""), false},
+		{"mismatched token data", NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, []string{"a", "b"}, sender, receiver, ""), false},
+		{"missing sender address", NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, "", receiver, ""), false},
+		{"missing receiver address", NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, "", ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestNonFungibleTokenPacketDataBytes(t *testing.T) {
+	data := NewNonFungibleTokenPacketData(classID, "uri", "", []string{"1", "2"}, []string{"uri1", "uri2"}, nil, sender, receiver, "memo")
+
+	bz := data.GetBytes()
+	require.Equal(t,
+		`{"classId":"nft-transfer/channel-0/cryptopunks","classUri":"uri","memo":"memo","receiver":"cosmos1w3jhxarpv3j8yvg4ufs4x","sender":"ex1jv65s3grqf6v6jl3dp4t6c9t9rk99cd86gvcyf","tokenIds":["1","2"],"tokenUris":["uri1","uri2"]}`,
+		string(bz),
+	)
+
+	decoded, err := DecodePacketData(bz)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+	require.Equal(t, "uri2", decoded.TokenURIAt(1))
+	require.Equal(t, "", decoded.TokenDataAt(1))
+
+	_, err = DecodePacketData([]byte("invalid"))
+	require.Error(t, err)
+}
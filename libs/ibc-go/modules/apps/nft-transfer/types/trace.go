@@ -0,0 +1,224 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	tmbytes "github.com/okx/okbchain/libs/tendermint/libs/bytes"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+)
+
+// ParseClassTrace parses a string with the ibc prefix (class trace) and the base classID
+// into a ClassTrace type. The base classID may contain slashes, only the leading pairs of
+// port and channel identifiers are regarded as the trace path.
+//
+// Examples:
+//
+//   - "portidone/channel-0/class" => ClassTrace{Path: "portidone/channel-0", BaseClassId: "class"}
+//   - "portidone/channel-0/wasm/class" => ClassTrace{Path: "portidone/channel-0", BaseClassId: "wasm/class"}
+//   - "class" => ClassTrace{Path: "", BaseClassId: "class"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	classSplit := strings.Split(rawClassID, "/")
+
+	if classSplit[0] == rawClassID {
+		return ClassTrace{
+			Path:        "",
+			BaseClassId: rawClassID,
+		}
+	}
+
+	path, baseClassID := extractPathAndBaseFromFullClassID(classSplit)
+	return ClassTrace{
+		Path:        path,
+		BaseClassId: baseClassID,
+	}
+}
+
+// extractPathAndBaseFromFullClassID returns the trace path and the base classID from
+// the elements that constitute the complete classID.
+func extractPathAndBaseFromFullClassID(fullClassIDItems []string) (string, string) {
+	var pathSlice, baseClassIDSlice []string
+
+	length := len(fullClassIDItems)
+	for i := 0; i < length; i += 2 {
+		// the base classID is kept if it looks like a port and channel pair
+		if i < length-2 && channeltypes.IsValidChannelID(fullClassIDItems[i+1]) {
+			pathSlice = append(pathSlice, fullClassIDItems[i], fullClassIDItems[i+1])
+		} else {
+			baseClassIDSlice = fullClassIDItems[i:]
+			break
+		}
+	}
+
+	return strings.Join(pathSlice, "/"), strings.Join(baseClassIDSlice, "/")
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassId)
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving classID prefix composed by the trace info and a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID a classID for an ICS721 non-fungible token in the format
+// 'ibc/{hash(tracePath + "/" + baseClassId)}'. If the trace is empty, it will return the base classID.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full classID according to the ICS721 specification:
+// tracePath + "/" + baseClassId
+// If there exists no trace then the base classID is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+func validateTraceIdentifiers(identifiers []string) error {
+	if len(identifiers) == 0 || len(identifiers)%2 != 0 {
+		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+
+	// validate correctness of port and channel identifiers
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	// empty trace is accepted when token lives on the original chain
+	switch {
+	case ct.Path == "" && ct.BaseClassId != "":
+		return nil
+	case strings.TrimSpace(ct.BaseClassId) == "":
+		return fmt.Errorf("base class id cannot be blank")
+	}
+
+	// NOTE: no base class id validation
+
+	identifiers := strings.Split(ct.Path, "/")
+	return validateTraceIdentifiers(identifiers)
+}
+
+// Traces defines a wrapper type for a slice of ClassTrace.
+type Traces []ClassTrace
+
+// Validate performs a basic validation of each class trace info.
+func (t Traces) Validate() error {
+	seenTraces := make(map[string]bool)
+	for i, trace := range t {
+		hash := trace.Hash().String()
+		if seenTraces[hash] {
+			return fmt.Errorf("duplicated class trace with hash %s", trace.Hash())
+		}
+
+		if err := trace.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed class trace %d validation", i)
+		}
+		seenTraces[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = Traces{}
+
+// Len implements sort.Interface for Traces
+func (t Traces) Len() int { return len(t) }
+
+// Less implements sort.Interface for Traces
+func (t Traces) Less(i, j int) bool { return t[i].GetFullClassPath() < t[j].GetFullClassPath() }
+
+// Swap implements sort.Interface for Traces
+func (t Traces) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// Sort is a helper function to sort the set of class traces in-place
+func (t Traces) Sort() Traces {
+	sort.Sort(t)
+	return t
+}
+
+// ValidateIBCClassID validates that the given classID is either:
+//
+//   - A valid base classID (eg: '0x35f7E0bA6B4C7a3A4C5b1a9B5cD2d0F4e1C7B4A2')
+//   - A valid non-fungible token representation (i.e 'ibc/{hash}')
+func ValidateIBCClassID(classID string) error {
+	classSplit := strings.SplitN(classID, "/", 2)
+
+	switch {
+	case strings.TrimSpace(classID) == "",
+		len(classSplit) == 1 && classSplit[0] == ClassPrefix,
+		len(classSplit) == 2 && (classSplit[0] != ClassPrefix || strings.TrimSpace(classSplit[1]) == ""):
+		return sdkerrors.Wrapf(ErrInvalidClassID, "class id should be prefixed with the format 'ibc/{hash(trace + \"/\" + %s)}'", classID)
+
+	case classSplit[0] == classID && strings.TrimSpace(classID) != "":
+		return nil
+	}
+
+	if _, err := ParseHexHash(classSplit[1]); err != nil {
+		return sdkerrors.Wrapf(err, "invalid class trace hash %s", classSplit[1])
+	}
+
+	return nil
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its correctness.
+func ParseHexHash(hexHash string) (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmtypes.ValidateHash(hash); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
+
+// SenderChainIsSource returns false if the class originally came
+// from the receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// This is the prefix that would have been prefixed to the classID
+	// on sender chain IF and only if the token originally came from the
+	// receiving chain.
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the class originally came
+// from the receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// The prefix passed in should contain the SourcePort and SourceChannel.
+	// If the receiver chain originally sent the token to the sender chain
+	// the classID will have the sender's SourcePort and SourceChannel as the
+	// prefix.
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}
+
+// GetClassPrefix returns the receiving classID prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
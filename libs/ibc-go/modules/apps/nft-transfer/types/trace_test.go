@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expTrace ClassTrace
+	}{
+		{"empty class id", "", ClassTrace{}},
+		{"base class id", "cryptopunks", ClassTrace{BaseClassId: "cryptopunks"}},
+		{"contract address", "0x5A7f5d9F7b6e2f9F0f3C1e7b0e6a37C3b9E1d5C2", ClassTrace{BaseClassId: "0x5A7f5d9F7b6e2f9F0f3C1e7b0e6a37C3b9E1d5C2"}},
+		{"trace info", "nft-transfer/channel-0/cryptopunks", ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "cryptopunks"}},
+		{"multiple trace info", "nft-transfer/channel-1/nft-transfer/channel-0/cryptopunks", ClassTrace{Path: "nft-transfer/channel-1/nft-transfer/channel-0", BaseClassId: "cryptopunks"}},
+		{"base class id with slash", "nft-transfer/channel-0/wasm/cryptopunks", ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "wasm/cryptopunks"}},
+		{"no channel", "nft-transfer/cryptopunks", ClassTrace{BaseClassId: "nft-transfer/cryptopunks"}},
+		{"trailing slash", "nft-transfer/channel-0/", ClassTrace{Path: "nft-transfer/channel-0"}},
+	}
+
+	for _, tc := range testCases {
+		trace := ParseClassTrace(tc.classID)
+		require.Equal(t, tc.expTrace, trace, tc.name)
+	}
+}
+
+func TestClassTrace_IBCClassID(t *testing.T) {
+	trace := ClassTrace{BaseClassId: "cryptopunks"}
+	require.Equal(t, "cryptopunks", trace.IBCClassID())
+
+	trace = ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "cryptopunks"}
+	classID := trace.IBCClassID()
+	require.Equal(t, ClassPrefix+"/"+trace.Hash().String(), classID)
+	require.NoError(t, ValidateIBCClassID(classID))
+	require.Equal(t, trace, ParseClassTrace(trace.GetFullClassPath()))
+}
+
+func TestClassTrace_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trace    ClassTrace
+		expError bool
+	}{
+		{"base class id only", ClassTrace{BaseClassId: "cryptopunks"}, false},
+		{"empty ClassTrace", ClassTrace{}, true},
+		{"valid single trace info", ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "cryptopunks"}, false},
+		{"valid multiple trace info", ClassTrace{Path: "nft-transfer/channel-0/nft-transfer/channel-1", BaseClassId: "cryptopunks"}, false},
+		{"single trace identifier", ClassTrace{Path: "nft-transfer", BaseClassId: "cryptopunks"}, true},
+		{"invalid port ID", ClassTrace{Path: "(nft-transfer)/channel-0", BaseClassId: "cryptopunks"}, true},
+		{"invalid channel ID", ClassTrace{Path: "nft-transfer/(channel-0)", BaseClassId: "cryptopunks"}, true},
+		{"empty base class id with trace", ClassTrace{Path: "nft-transfer/channel-0"}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.trace.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestValidateIBCClassID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expError bool
+	}{
+		{"class id with trace hash", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", false},
+		{"base class id", "cryptopunks", false},
+		{"empty class id", "", true},
+		{"class id 'ibc'", "ibc", true},
+		{"class id 'ibc/'", "ibc/", true},
+		{"invalid prefix", "notibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", true},
+		{"invalid hash", "ibc/!@#$!@#", true},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateIBCClassID(tc.classID)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestChainIsSource(t *testing.T) {
+	require.True(t, SenderChainIsSource(PortID, "channel-0", "cryptopunks"))
+	require.True(t, SenderChainIsSource(PortID, "channel-0", "nft-transfer/channel-1/cryptopunks"))
+	require.False(t, SenderChainIsSource(PortID, "channel-0", "nft-transfer/channel-0/cryptopunks"))
+
+	require.True(t, ReceiverChainIsSource(PortID, "channel-0", "nft-transfer/channel-0/cryptopunks"))
+	require.False(t, ReceiverChainIsSource(PortID, "channel-0", "cryptopunks"))
+	require.Equal(t, "nft-transfer/channel-0/", GetClassPrefix(PortID, "channel-0"))
+}
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/nft_transfer/v1/transfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClassTrace contains the base classID for ICS721 non fungible tokens and the
+// source tracing information path.
+type ClassTrace struct {
+	// path defines the chain of port/channel identifiers used for tracing the
+	// source of the non fungible token.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// base classID of the relayed non fungible token.
+	BaseClassId string `protobuf:"bytes,2,opt,name=base_class_id,json=baseClassId,proto3" json:"base_class_id,omitempty"`
+}
+
+func (m *ClassTrace) Reset()         { *m = ClassTrace{} }
+func (m *ClassTrace) String() string { return proto.CompactTextString(m) }
+func (*ClassTrace) ProtoMessage()    {}
+func (*ClassTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbbec0a5a50746a6, []int{0}
+}
+func (m *ClassTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassTrace.Merge(m, src)
+}
+func (m *ClassTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassTrace proto.InternalMessageInfo
+
+func (m *ClassTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ClassTrace) GetBaseClassId() string {
+	if m != nil {
+		return m.BaseClassId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ClassTrace)(nil), "ibc.applications.nft_transfer.v1.ClassTrace")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/nft_transfer/v1/transfer.proto", fileDescriptor_fbbec0a5a50746a6)
+}
+
+var fileDescriptor_fbbec0a5a50746a6 = []byte{
+	// 223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0xb1, 0x4a, 0x03, 0x41,
+	0x10, 0xc6, 0xf1, 0x5b, 0x11, 0xc1, 0x15, 0x9b, 0xab, 0x52, 0x2d, 0x21, 0x95, 0x4d, 0x76, 0x08,
+	0xbe, 0x81, 0xb1, 0xb1, 0x15, 0x2b, 0x41, 0x8e, 0xd9, 0xbd, 0x4d, 0x6e, 0xc8, 0xe5, 0x66, 0xb9,
+	0xd9, 0x04, 0x7d, 0x0b, 0x1f, 0xcb, 0x32, 0xa5, 0xa5, 0xdc, 0xbd, 0x88, 0xdc, 0x82, 0x92, 0xee,
+	0x63, 0x77, 0xfe, 0xc5, 0x4f, 0x03, 0x39, 0x0f, 0x18, 0x63, 0x4b, 0x1e, 0x13, 0x71, 0x27, 0xd0,
+	0x6d, 0x52, 0x95, 0x7a, 0xec, 0x64, 0x13, 0x7a, 0x38, 0xae, 0xe0, 0x6f, 0xdb, 0xd8, 0x73, 0xe2,
+	0x72, 0x4e, 0xce, 0xdb, 0xf3, 0xc0, 0x9e, 0x07, 0xf6, 0xb8, 0x5a, 0x3c, 0x6a, 0xbd, 0x6e, 0x51,
+	0xe4, 0xa5, 0x47, 0x1f, 0xca, 0x52, 0x5f, 0x46, 0x4c, 0xcd, 0x4c, 0xcd, 0xd5, 0xdd, 0xf5, 0x73,
+	0xde, 0xe5, 0x42, 0xdf, 0x3a, 0x94, 0x50, 0xf9, 0xe9, 0xac, 0xa2, 0x7a, 0x76, 0x91, 0x3f, 0x6f,
+	0xa6, 0xc7, 0x9c, 0x3e, 0xd5, 0x0f, 0x6f, 0x5f, 0x83, 0x51, 0xa7, 0xc1, 0xa8, 0x9f, 0xc1, 0xa8,
+	0xcf, 0xd1, 0x14, 0xa7, 0xd1, 0x14, 0xdf, 0xa3, 0x29, 0x5e, 0xd7, 0x5b, 0x4a, 0xcd, 0xc1, 0x59,
+	0xcf, 0x7b, 0xe0, 0xdd, 0x3b, 0xf0, 0xce, 0xf9, 0x06, 0xa9, 0x83, 0x96, 0x9c, 0x4c, 0x9e, 0xe5,
+	0x96, 0x61, 0xcf, 0xf5, 0xa1, 0x0d, 0x32, 0xd1, 0x32, 0x69, 0xf9, 0x4f, 0x4a, 0x1f, 0x31, 0x88,
+	0xbb, 0xca, 0x9a, 0xfb, 0xdf, 0x01, 0x00, 0x39, 0xeb, 0xd1, 0xc1, 0x00, 0x01, 0x00, 0x00,
+}
+
+func (m *ClassTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseClassId) > 0 {
+		i -= len(m.BaseClassId)
+		copy(dAtA[i:], m.BaseClassId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClassTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseClassId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClassTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,7 @@
+package packetforward
+
+import "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+
+var (
+	ModuleCdc = types.ModuleCdc
+)
@@ -0,0 +1,312 @@
+package packetforward
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/05-port/types"
+
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/core/exported"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+const (
+	// DefaultForwardTransferPacketTimeout is the timeout of the forwarded packet if it is not
+	// specified by the forwarding instructions
+	DefaultForwardTransferPacketTimeout = 5 * time.Minute
+
+	// DefaultRetriesOnTimeout is the number of retries of the forwarded packet after a timeout
+	// if it is not specified by the forwarding instructions
+	DefaultRetriesOnTimeout uint8 = 0
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward middleware given the
+// packet forward keeper and the underlying transfer application.
+type IBCMiddleware struct {
+	app    porttypes.Middleware
+	keeper keeper.Keeper
+
+	retriesOnTimeout uint8
+	forwardTimeout   time.Duration
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the underlying transfer application
+func NewIBCMiddleware(app porttypes.Middleware, k keeper.Keeper, retriesOnTimeout uint8, forwardTimeout time.Duration) IBCMiddleware {
+	return IBCMiddleware{
+		app:              app,
+		keeper:           k,
+		retriesOnTimeout: retriesOnTimeout,
+		forwardTimeout:   forwardTimeout,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface.
+// If the memo of the ICS-20 packet carries forwarding instructions, the funds are received by
+// an intermediate receiver and sent to the next hop. The acknowledgement of the packet is then
+// written asynchronously once the forwarded packet is acknowledged or timed out.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, ok, err := types.ParseForwardMetadata(data.Memo)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgementV4(err)
+	}
+
+	// override the receiver so that the funds are held by the intermediate receiver until forwarded
+	receiver := types.IntermediateReceiver(packet.DestinationChannel, data.Sender)
+	originalSender := data.Sender
+	data.Receiver = receiver.String()
+	data.Memo = ""
+	recvPacket := packet
+	recvPacket.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(types.WithForwarding(ctx), recvPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgementV4(
+			sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount),
+		)
+	}
+	token := sdk.CoinAdapter{Denom: getDenomForThisChain(packet, data.Denom), Amount: amount}
+
+	timeout := time.Duration(metadata.Timeout)
+	if timeout <= 0 {
+		timeout = im.forwardTimeout
+	}
+	retries := im.retriesOnTimeout
+	if metadata.Retries != nil {
+		retries = *metadata.Retries
+	}
+
+	if err := im.keeper.ForwardTransferPacket(ctx, nil, packet, originalSender, receiver, *metadata, token, retries, timeout); err != nil {
+		return channeltypes.NewErrorAcknowledgementV4(err)
+	}
+
+	// NOTE: acknowledgement will be written asynchronously once the forwarded packet is
+	// acknowledged or timed out.
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface.
+// If the packet is a forwarded one, the acknowledgement is relayed to the original packet.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.Marshal.GetProtocMarshal().UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if ack.Success() {
+		return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, inFlightPacket, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	}
+
+	if err := im.keeper.RefundForwardedPacket(ctx, packet, data, inFlightPacket); err != nil {
+		return err
+	}
+	im.emitRefundEvent(ctx, inFlightPacket)
+	return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface.
+// If the packet is a forwarded one, it is retried until no retries remain, after which an error
+// acknowledgement is written for the original packet.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	im.keeper.DeleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, fmt.Sprintf("%d", inFlightPacket.RetriesRemaining)),
+		),
+	)
+
+	if inFlightPacket.RetriesRemaining > 0 {
+		// give the funds back to the intermediate receiver, then send them again
+		if err := im.app.OnTimeoutPacket(types.WithForwarding(ctx), packet, relayer); err != nil {
+			return err
+		}
+		return im.keeper.RetryTimeout(ctx, packet, data, inFlightPacket)
+	}
+
+	if err := im.keeper.RefundForwardedPacket(ctx, packet, data, inFlightPacket); err != nil {
+		return err
+	}
+	im.emitRefundEvent(ctx, inFlightPacket)
+	ack := channeltypes.NewErrorAcknowledgementV4(
+		sdkerrors.Wrapf(types.ErrForwardTransfer, "forwarded packet timed out on channel %s", packet.SourceChannel),
+	)
+	return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, inFlightPacket, ack)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+) error {
+	return im.keeper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.keeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.keeper.GetAppVersion(ctx, portID, channelID)
+}
+
+func (im IBCMiddleware) NegotiateAppVersion(ctx sdk.Context, order channeltypes.Order, connectionID string, portID string, counterparty channeltypes.Counterparty, proposedVersion string) (version string, err error) {
+	return im.app.NegotiateAppVersion(ctx, order, connectionID, portID, counterparty, proposedVersion)
+}
+
+func (im IBCMiddleware) emitRefundEvent(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRefund,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, inFlightPacket.OriginalSenderAddress),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, fmt.Sprintf("%d", inFlightPacket.RefundSequence)),
+		),
+	)
+}
+
+// getDenomForThisChain returns the denomination on this chain of the funds received by the packet,
+// following the same rules as the transfer application.
+func getDenomForThisChain(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the funds come back to this chain, remove the prefix added by the sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// the funds are vouchers on this chain
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,402 @@
+package packetforward_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	ibctesting "github.com/okx/okbchain/libs/ibc-go/testing"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+)
+
+var timeoutHeight = clienttypes.NewHeight(0, 1000)
+
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// the funds sent by chainA are forwarded by chainB to chainC
+	chainA ibctesting.TestChainI
+	chainB ibctesting.TestChainI
+	chainC ibctesting.TestChainI
+
+	pathAToB *ibctesting.Path
+	pathBToC *ibctesting.Path
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+
+	tmtypes.UnittestOnlySetMilestoneVenus4Height(-1)
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.pathAToB = newTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.pathAToB)
+	suite.pathBToC = newTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathBToC)
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func newTransferPath(chainA, chainB ibctesting.TestChainI) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	return path
+}
+
+// forwardMemo returns the memo forwarding the funds received by chainB to the receiver on chainC
+func (suite *PacketForwardTestSuite) forwardMemo(receiver string, extra string) string {
+	return fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"%s}}`,
+		receiver, suite.pathBToC.EndpointA.ChannelConfig.PortID, suite.pathBToC.EndpointA.ChannelID, extra)
+}
+
+// sendTransfer sends the funds from the sender of the endpoint's chain and returns the sent packet
+func (suite *PacketForwardTestSuite) sendTransfer(endpoint *ibctesting.Endpoint, token sdk.CoinAdapter, receiver, memo string) channeltypes.Packet {
+	chain := endpoint.Chain
+	ctx := chain.GetContext()
+	err := chain.GetSimApp().TransferKeeper.SendTransferWithMemo(
+		ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID, token,
+		chain.SenderAccount().GetAddress(), receiver, timeoutHeight, 0, memo,
+	)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(chain)
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+	return packet
+}
+
+// relayTransfer relays the packet sent by the endpoint and its acknowledgement, which must be written synchronously
+func (suite *PacketForwardTestSuite) relayTransfer(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) {
+	res, err := endpoint.Counterparty.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(endpoint.AcknowledgePacket(packet, ack))
+}
+
+// acknowledgePacket is the same as Endpoint.AcknowledgePacket, except that the result is returned
+func (suite *PacketForwardTestSuite) acknowledgePacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) *sdk.Result {
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount().GetAddress().String())
+	res, err := endpoint.Chain.SendMsgs(ackMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+	return res
+}
+
+// timeoutPacket is the same as Endpoint.TimeoutPacket on an unordered channel, except that the result is returned
+func (suite *PacketForwardTestSuite) timeoutPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	// the packet times out once the counterparty chain passes the timeout timestamp
+	suite.coordinator.IncrementTimeBy(time.Duration(packet.TimeoutTimestamp) - time.Duration(endpoint.Counterparty.Chain.CurrentHeader().Time.UnixNano()))
+	suite.coordinator.CommitBlock(endpoint.Counterparty.Chain)
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App().GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	suite.Require().True(found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount().GetAddress().String())
+	res, err := endpoint.Chain.SendMsgs(timeoutMsg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+	return res
+}
+
+// recvForwardedPacket receives the packet on chainB, checks that its acknowledgement is
+// not written synchronously and returns the packet forwarded to chainC
+func (suite *PacketForwardTestSuite) recvForwardedPacket(packet channeltypes.Packet) channeltypes.Packet {
+	res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written once the forwarded packet is acknowledged or timed out
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+	_, found := suite.chainB.App().GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	forwarded, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.pathBToC.EndpointA.ChannelID, forwarded.GetSourceChannel())
+	suite.requireInFlight(forwarded, true)
+	suite.Require().NoError(suite.pathBToC.EndpointB.UpdateClient())
+	return forwarded
+}
+
+func (suite *PacketForwardTestSuite) requireInFlight(forwarded channeltypes.Packet, expFound bool) types.InFlightPacket {
+	inFlightPacket, found := suite.chainB.GetSimApp().PacketForwardKeeper.GetInFlightPacket(
+		suite.chainB.GetContext(), forwarded.GetSourceChannel(), forwarded.GetSourcePort(), forwarded.GetSequence())
+	suite.Require().Equal(expFound, found)
+	return inFlightPacket
+}
+
+// requireAckOfOriginal checks the acknowledgement written by chainB for the original packet and relays it to chainA
+func (suite *PacketForwardTestSuite) requireAckOfOriginal(res *sdk.Result, packet channeltypes.Packet, expSuccess bool) {
+	ackBz, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	suite.Require().Equal(expSuccess, ack.Success())
+
+	commitment, found := suite.chainB.App().GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ackBz), commitment)
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ackBz))
+}
+
+func (suite *PacketForwardTestSuite) balance(chain ibctesting.TestChainI, addr sdk.AccAddress, denom string) sdk.Dec {
+	return chain.GetSimApp().BankKeeper.GetCoins(chain.GetContext(), addr).AmountOf(denom)
+}
+
+func (suite *PacketForwardTestSuite) escrowBalance(chain ibctesting.TestChainI, endpoint *ibctesting.Endpoint, denom string) sdk.Dec {
+	return suite.balance(chain, transfertypes.GetEscrowAddress(endpoint.ChannelConfig.PortID, endpoint.ChannelID), denom)
+}
+
+func voucherDenom(endpoints ...*ibctesting.Endpoint) string {
+	path := sdk.DefaultIbcWei
+	for _, endpoint := range endpoints {
+		path = transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, path)
+	}
+	return transfertypes.ParseDenomTrace(path).IBCDenom()
+}
+
+func (suite *PacketForwardTestSuite) TestForward() {
+	amount := sdk.NewInt(100)
+	amountDec := sdk.NewDecFromIntWithPrec(amount, sdk.Precision)
+	receiver := suite.chainC.SenderAccount().GetAddress()
+	senderBalance := suite.balance(suite.chainA, suite.chainA.SenderAccount().GetAddress(), sdk.DefaultBondDenom)
+
+	packet := suite.sendTransfer(suite.pathAToB.EndpointA, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: amount},
+		"unused", suite.forwardMemo(receiver.String(), ""))
+	forwarded := suite.recvForwardedPacket(packet)
+
+	// the vouchers minted on chainB are escrowed by the next hop, the intermediate receiver holds nothing
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB)
+	intermediate := types.IntermediateReceiver(packet.DestinationChannel, suite.chainA.SenderAccount().GetAddress().String())
+	suite.Require().True(suite.balance(suite.chainB, intermediate, denomOnB).IsZero())
+	suite.Require().Equal(amountDec, suite.escrowBalance(suite.chainB, suite.pathBToC.EndpointA, denomOnB))
+
+	// the original packet is acknowledged once the forwarded packet is
+	res, err := suite.pathBToC.EndpointB.RecvPacketWithResult(forwarded)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	res = suite.acknowledgePacket(suite.pathBToC.EndpointA, forwarded, ack)
+	suite.requireInFlight(forwarded, false)
+	suite.requireAckOfOriginal(res, packet, true)
+
+	denomOnC := voucherDenom(suite.pathAToB.EndpointB, suite.pathBToC.EndpointB)
+	suite.Require().Equal(amountDec, suite.balance(suite.chainC, receiver, denomOnC))
+	suite.Require().Equal(senderBalance.Sub(amountDec), suite.balance(suite.chainA, suite.chainA.SenderAccount().GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *PacketForwardTestSuite) TestNoForwardBeforeVenus8() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(1 << 40)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+
+	amount := sdk.NewInt(100)
+	receiver := suite.chainB.SenderAccount().GetAddress()
+	packet := suite.sendTransfer(suite.pathAToB.EndpointA, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: amount},
+		receiver.String(), suite.forwardMemo(suite.chainC.SenderAccount().GetAddress().String(), ""))
+
+	// the memo is ignored, the receiver on chainB gets the vouchers and the acknowledgement is written synchronously
+	res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	_, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDecFromIntWithPrec(amount, sdk.Precision),
+		suite.balance(suite.chainB, receiver, voucherDenom(suite.pathAToB.EndpointB)))
+}
+
+func (suite *PacketForwardTestSuite) TestForwardInvalidMetadata() {
+	packet := suite.sendTransfer(suite.pathAToB.EndpointA, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: sdk.NewInt(100)},
+		"unused", `{"forward":{"receiver":"","port":"transfer","channel":"channel-0"}}`)
+
+	// the error acknowledgement is written synchronously
+	res, err := suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBz, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	suite.Require().False(ack.Success())
+	_, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().Error(err)
+}
+
+func (suite *PacketForwardTestSuite) TestRefundOnErrorAck() {
+	amount := sdk.NewInt(100)
+	amountDec := sdk.NewDecFromIntWithPrec(amount, sdk.Precision)
+	sender := func(chain ibctesting.TestChainI) sdk.AccAddress { return chain.SenderAccount().GetAddress() }
+
+	testCases := []struct {
+		msg string
+		// malleate moves the funds around before chainA forwards them through chainB and
+		// returns the denomination on chainA of the funds to forward
+		malleate func() string
+		// postcheck checks the escrows of chainB once the forwarded funds are refunded
+		postcheck func()
+	}{
+		{
+			"burn the vouchers minted by chainB",
+			func() string { return sdk.DefaultIbcWei },
+			func() {
+				denomOnB := voucherDenom(suite.pathAToB.EndpointB)
+				suite.Require().True(suite.escrowBalance(suite.chainB, suite.pathBToC.EndpointA, denomOnB).IsZero())
+				suite.Require().True(suite.chainB.GetSimApp().SupplyKeeper.GetSupply(suite.chainB.GetContext()).GetTotal().AmountOf(denomOnB).IsZero())
+			},
+		},
+		{
+			"escrow again the natives of chainB",
+			func() string {
+				// chainB sends its natives to chainA first
+				packet := suite.sendTransfer(suite.pathAToB.EndpointB, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: amount},
+					sender(suite.chainA).String(), "")
+				suite.relayTransfer(suite.pathAToB.EndpointB, packet)
+				return voucherDenom(suite.pathAToB.EndpointA)
+			},
+			func() {
+				suite.Require().True(suite.escrowBalance(suite.chainB, suite.pathBToC.EndpointA, sdk.DefaultBondDenom).IsZero())
+				suite.Require().Equal(amountDec, suite.escrowBalance(suite.chainB, suite.pathAToB.EndpointB, sdk.DefaultBondDenom))
+			},
+		},
+		{
+			"mint the vouchers burned by chainB and escrow them again",
+			func() string {
+				// chainC sends its natives to chainA through chainB first
+				packet := suite.sendTransfer(suite.pathBToC.EndpointB, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: amount},
+					sender(suite.chainB).String(), "")
+				suite.relayTransfer(suite.pathBToC.EndpointB, packet)
+				packet = suite.sendTransfer(suite.pathAToB.EndpointB, sdk.CoinAdapter{Denom: voucherDenom(suite.pathBToC.EndpointA), Amount: amount},
+					sender(suite.chainA).String(), "")
+				suite.relayTransfer(suite.pathAToB.EndpointB, packet)
+				return voucherDenom(suite.pathBToC.EndpointA, suite.pathAToB.EndpointA)
+			},
+			func() {
+				denomOnB := voucherDenom(suite.pathBToC.EndpointA)
+				suite.Require().Equal(amountDec, suite.escrowBalance(suite.chainB, suite.pathAToB.EndpointB, denomOnB))
+				suite.Require().Equal(amountDec, suite.chainB.GetSimApp().SupplyKeeper.GetSupply(suite.chainB.GetContext()).GetTotal().AmountOf(denomOnB))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			denom := tc.malleate()
+			senderBalance := suite.balance(suite.chainA, sender(suite.chainA), denom)
+			if denom == sdk.DefaultIbcWei {
+				senderBalance = suite.balance(suite.chainA, sender(suite.chainA), sdk.DefaultBondDenom)
+			}
+
+			// the receiver on chainC is invalid, so the forwarded packet is acknowledged with an error
+			packet := suite.sendTransfer(suite.pathAToB.EndpointA, sdk.CoinAdapter{Denom: denom, Amount: amount},
+				"unused", suite.forwardMemo("invalid", ""))
+			forwarded := suite.recvForwardedPacket(packet)
+
+			res, err := suite.pathBToC.EndpointB.RecvPacketWithResult(forwarded)
+			suite.Require().NoError(err)
+			ack, err := ibctesting.ParseAckFromEvents(res.Events)
+			suite.Require().NoError(err)
+			res = suite.acknowledgePacket(suite.pathBToC.EndpointA, forwarded, ack)
+			suite.requireInFlight(forwarded, false)
+			suite.requireAckOfOriginal(res, packet, false)
+
+			// nothing is left to the intermediate receiver, the original sender is refunded
+			intermediate := types.IntermediateReceiver(packet.DestinationChannel, sender(suite.chainA).String())
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetCoins(suite.chainB.GetContext(), intermediate).IsZero())
+			tc.postcheck()
+			if denom == sdk.DefaultIbcWei {
+				suite.Require().Equal(senderBalance, suite.balance(suite.chainA, sender(suite.chainA), sdk.DefaultBondDenom))
+			} else {
+				suite.Require().Equal(senderBalance, suite.balance(suite.chainA, sender(suite.chainA), denom))
+			}
+		})
+	}
+}
+
+func (suite *PacketForwardTestSuite) TestRetryOnTimeout() {
+	amount := sdk.NewInt(100)
+	amountDec := sdk.NewDecFromIntWithPrec(amount, sdk.Precision)
+	receiver := suite.chainC.SenderAccount().GetAddress()
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB)
+
+	packet := suite.sendTransfer(suite.pathAToB.EndpointA, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: amount},
+		"unused", suite.forwardMemo(receiver.String(), `,"retries":1,"timeout":"1m","next":"memo of chainC"`))
+	forwarded := suite.recvForwardedPacket(packet)
+	suite.Require().Equal(int32(1), suite.requireInFlight(forwarded, true).RetriesRemaining)
+
+	// the timed out packet is sent again with the same memo, the original packet is not acknowledged yet
+	res := suite.timeoutPacket(suite.pathBToC.EndpointA, forwarded)
+	suite.requireInFlight(forwarded, false)
+	_, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+	retried, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(forwarded.GetSequence()+1, retried.GetSequence())
+	suite.Require().Equal(int32(0), suite.requireInFlight(retried, true).RetriesRemaining)
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(retried.GetData(), &data))
+	suite.Require().Equal("memo of chainC", data.Memo)
+	suite.Require().Equal(amountDec, suite.escrowBalance(suite.chainB, suite.pathBToC.EndpointA, denomOnB))
+
+	// the original packet is acknowledged once the retried packet is
+	res, err = suite.pathBToC.EndpointB.RecvPacketWithResult(retried)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	res = suite.acknowledgePacket(suite.pathBToC.EndpointA, retried, ack)
+	suite.requireInFlight(retried, false)
+	suite.requireAckOfOriginal(res, packet, true)
+	suite.Require().Equal(amountDec, suite.balance(suite.chainC, receiver, voucherDenom(suite.pathAToB.EndpointB, suite.pathBToC.EndpointB)))
+}
+
+func (suite *PacketForwardTestSuite) TestRefundOnTimeout() {
+	amount := sdk.NewInt(100)
+	sender := suite.chainA.SenderAccount().GetAddress()
+	senderBalance := suite.balance(suite.chainA, sender, sdk.DefaultBondDenom)
+	denomOnB := voucherDenom(suite.pathAToB.EndpointB)
+
+	packet := suite.sendTransfer(suite.pathAToB.EndpointA, sdk.CoinAdapter{Denom: sdk.DefaultIbcWei, Amount: amount},
+		"unused", suite.forwardMemo(suite.chainC.SenderAccount().GetAddress().String(), `,"timeout":"1m"`))
+	forwarded := suite.recvForwardedPacket(packet)
+
+	// no retries remain, so the forwarded funds are refunded and the original packet is acknowledged with an error
+	res := suite.timeoutPacket(suite.pathBToC.EndpointA, forwarded)
+	suite.requireInFlight(forwarded, false)
+	_, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().Error(err)
+	suite.requireAckOfOriginal(res, packet, false)
+
+	intermediate := types.IntermediateReceiver(packet.DestinationChannel, sender.String())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetCoins(suite.chainB.GetContext(), intermediate).IsZero())
+	suite.Require().True(suite.escrowBalance(suite.chainB, suite.pathBToC.EndpointA, denomOnB).IsZero())
+	suite.Require().Equal(senderBalance, suite.balance(suite.chainA, sender, sdk.DefaultBondDenom))
+}
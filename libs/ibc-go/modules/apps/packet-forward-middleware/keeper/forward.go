@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+)
+
+// ForwardTransferPacket sends the funds received by the intermediate receiver to the next hop.
+// The original packet is stored as an in-flight packet, so that its acknowledgement can be
+// written once the forwarded packet is acknowledged or timed out. A nil in-flight packet means
+// that it is the first attempt, otherwise the forwarded packet is retried after a timeout.
+func (k Keeper) ForwardTransferPacket(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	srcPacket channeltypes.Packet,
+	srcPacketSender string,
+	receiver sdk.AccAddress,
+	metadata types.ForwardMetadata,
+	token sdk.CoinAdapter,
+	maxRetries uint8,
+	timeout time.Duration,
+) error {
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	// the sequence of the forwarded packet is the key of the in-flight packet
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", metadata.Port, metadata.Channel,
+		)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(timeout.Nanoseconds())
+	if err := k.transferKeeper.SendTransferWithMemo(
+		ctx, metadata.Port, metadata.Channel, token, receiver, metadata.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	); err != nil {
+		k.Logger(ctx).Error("failed to forward the transfer",
+			"port", metadata.Port, "channel", metadata.Channel, "receiver", metadata.Receiver, "error", err)
+		return sdkerrors.Wrap(types.ErrForwardTransfer, err.Error())
+	}
+
+	if inFlightPacket == nil {
+		inFlightPacket = &types.InFlightPacket{
+			OriginalSenderAddress:  srcPacketSender,
+			RefundChannelId:        srcPacket.DestinationChannel,
+			RefundPortId:           srcPacket.DestinationPort,
+			PacketSrcChannelId:     srcPacket.SourceChannel,
+			PacketSrcPortId:        srcPacket.SourcePort,
+			PacketTimeoutTimestamp: srcPacket.TimeoutTimestamp,
+			PacketTimeoutHeight:    srcPacket.TimeoutHeight.String(),
+			PacketData:             srcPacket.Data,
+			RefundSequence:         srcPacket.Sequence,
+			RetriesRemaining:       int32(maxRetries),
+			Timeout:                uint64(timeout.Nanoseconds()),
+		}
+	} else {
+		inFlightPacket.RetriesRemaining--
+	}
+	k.SetInFlightPacket(ctx, metadata.Channel, metadata.Port, sequence, *inFlightPacket)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyForwardReceiver, metadata.Receiver),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, fmt.Sprintf("%d", inFlightPacket.RetriesRemaining)),
+		),
+	)
+	return nil
+}
+
+// RetryTimeout resends the funds of the timed out forwarded packet to the same hop. The funds
+// have already been refunded to the intermediate receiver by the transfer application.
+func (k Keeper) RetryTimeout(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, inFlightPacket types.InFlightPacket) error {
+	receiver, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	var metadata types.ForwardMetadata
+	metadata.Receiver = data.Receiver
+	metadata.Port = packet.SourcePort
+	metadata.Channel = packet.SourceChannel
+	if data.Memo != "" {
+		// the memo of the forwarded packet is resent as is, as a JSON string
+		next, err := json.Marshal(data.Memo)
+		if err != nil {
+			return err
+		}
+		metadata.Next = next
+	}
+
+	// the denomination on this chain of the forwarded funds
+	token := sdk.CoinAdapter{Denom: transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), Amount: amount}
+	return k.ForwardTransferPacket(ctx, &inFlightPacket, channeltypes.Packet{}, "", receiver, metadata, token, 0, time.Duration(inFlightPacket.Timeout))
+}
+
+// RefundForwardedPacket reverts the funds movements of both the original packet and the forwarded
+// packet on this chain, so that the original sender can be refunded by the error acknowledgement
+// of the original packet. The funds are never given back to the intermediate receiver.
+func (k Keeper) RefundForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, inFlightPacket types.InFlightPacket) error {
+	var srcData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &srcData); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInFlightPacket, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+	token := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), sdk.NewDecFromIntWithPrec(amount, sdk.Precision))
+	if token.Denom == sdk.DefaultIbcWei {
+		token.Denom = sdk.DefaultBondDenom
+	}
+	coins := sdk.NewCoins(token)
+
+	// the forwarded funds were either escrowed or burned when sending to the next hop
+	escrowed := transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, data.Denom)
+	// the received funds were either unescrowed or minted when receiving the original packet
+	unescrowed := transfertypes.ReceiverChainIsSource(inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId, srcData.Denom)
+
+	outEscrow := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+	inEscrow := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+
+	var err error
+	switch {
+	case escrowed && unescrowed:
+		// move the funds back from the escrow of the next hop to the escrow of the original packet
+		err = k.bankKeeper.SendCoins(ctx, outEscrow, inEscrow, coins)
+	case escrowed:
+		// burn the vouchers which were minted when receiving the original packet
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, outEscrow, transfertypes.ModuleName, coins); err == nil {
+			err = k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins)
+		}
+	case unescrowed:
+		// mint the vouchers which were burned when forwarding and escrow them again
+		if err = k.bankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins); err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, inEscrow, coins)
+		}
+	default:
+		// the vouchers minted when receiving were burned when forwarding, nothing to revert
+	}
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrRefundForward, err.Error())
+	}
+	return nil
+}
+
+// WriteAcknowledgementForForwardedPacket writes the acknowledgement of the original packet once
+// the forwarded packet is acknowledged or timed out.
+func (k Keeper) WriteAcknowledgementForForwardedPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	timeoutHeight, err := clienttypes.ParseHeight(inFlightPacket.PacketTimeoutHeight)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInFlightPacket, err.Error())
+	}
+
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	srcPacket := channeltypes.NewPacket(
+		inFlightPacket.PacketData,
+		inFlightPacket.RefundSequence,
+		inFlightPacket.PacketSrcPortId,
+		inFlightPacket.PacketSrcChannelId,
+		inFlightPacket.RefundPortId,
+		inFlightPacket.RefundChannelId,
+		timeoutHeight,
+		inFlightPacket.PacketTimeoutTimestamp,
+	)
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, srcPacket, ack)
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+)
+
+// InitGenesis initializes the packet forward middleware state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	store := ctx.KVStore(k.storeKey)
+	for key, packet := range state.InFlightPackets {
+		packet := packet
+		store.Set([]byte(types.InFlightPacketPrefix+"/"+key), k.cdc.GetProtocMarshal().MustMarshal(&packet))
+	}
+}
+
+// ExportGenesis returns the packet forward middleware exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllInFlightPackets(ctx))
+}
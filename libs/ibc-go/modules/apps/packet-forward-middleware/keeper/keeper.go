@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	"github.com/okx/okbchain/libs/cosmos-sdk/store/prefix"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+	ibcexported "github.com/okx/okbchain/libs/ibc-go/modules/core/exported"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+)
+
+// Keeper defines the packet forward middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.CodecProxy
+
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+	ics4Wrapper    types.ICS4Wrapper
+}
+
+// NewKeeper creates a new packet forward middleware Keeper instance
+func NewKeeper(
+	cdc *codec.CodecProxy, key sdk.StoreKey,
+	transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper, ics4Wrapper types.ICS4Wrapper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+		ics4Wrapper:    ics4Wrapper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// SetInFlightPacket stores the original packet of the forwarded packet which is sent on the
+// given channel with the given sequence
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64, packet types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyInFlightPacket(channelID, portID, sequence), k.cdc.GetProtocMarshal().MustMarshal(&packet))
+}
+
+// GetInFlightPacket returns the original packet of the forwarded packet which is sent on the
+// given channel with the given sequence
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyInFlightPacket(channelID, portID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.GetProtocMarshal().MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeleteInFlightPacket removes the in-flight packet once the forwarded packet is acknowledged or timed out
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyInFlightPacket(channelID, portID, sequence))
+}
+
+// GetAllInFlightPackets returns all the in-flight packets keyed by the identifier of the forwarded packet
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) map[string]types.InFlightPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.InFlightPacketPrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	packets := make(map[string]types.InFlightPacket)
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.GetProtocMarshal().MustUnmarshal(iterator.Value(), &packet)
+		packets[string(iterator.Key())] = packet
+	}
+	return packets
+}
+
+// SendPacket wraps the ICS4Wrapper SendPacket function
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps the ICS4Wrapper WriteAcknowledgement function
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion wraps the ICS4Wrapper GetAppVersion function
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,139 @@
+package packetforward
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	cliCtx "github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	anytypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/module"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/upgrade"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/common"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/keeper"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+var (
+	_ module.AppModuleAdapter      = AppModule{}
+	_ module.AppModuleBasicAdapter = AppModuleBasic{}
+	_ upgrade.UpgradeModule        = AppModule{}
+)
+
+// AppModuleBasic is the packet forward middleware AppModuleBasic
+type AppModuleBasic struct{}
+
+// Name implements AppModuleBasic interface
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+func (AppModuleBasic) RegisterCodec(*codec.Codec) {}
+
+// DefaultGenesis returns the default genesis state of the packet forward middleware
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the packet forward middleware
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	if len(bz) > 0 {
+		var genesisState types.GenesisState
+		if err := ModuleCdc.UnmarshalJSON(bz, &genesisState); err != nil {
+			return err
+		}
+
+		return genesisState.Validate()
+	}
+	return nil
+}
+
+func (AppModuleBasic) RegisterRESTRoutes(cliCtx.CLIContext, *mux.Router) {}
+
+func (AppModuleBasic) GetTxCmd(*codec.Codec) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) GetQueryCmd(*codec.Codec) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) RegisterInterfaces(anytypes.InterfaceRegistry) {}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(cliCtx.CLIContext, *runtime.ServeMux) {}
+
+func (AppModuleBasic) GetTxCmdV2(*codec.CodecProxy, anytypes.InterfaceRegistry) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) GetQueryCmdV2(*codec.CodecProxy, anytypes.InterfaceRegistry) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) RegisterRouterForGRPC(cliCtx.CLIContext, *mux.Router) {}
+
+// AppModule is the packet forward middleware AppModule, it only manages the in-flight packets
+// as the middleware itself is wired into the transfer stack of the IBC router
+type AppModule struct {
+	*common.Venus8BaseUpgradeModule
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new packet forward middleware AppModule
+func NewAppModule(k keeper.Keeper) AppModule {
+	ret := AppModule{
+		keeper: k,
+	}
+	ret.Venus8BaseUpgradeModule = common.NewVenus8BaseUpgradeModule(ret)
+	return ret
+}
+
+// RegisterTask returns no upgrade task, the packet forward store starts without in-flight packets.
+func (am AppModule) RegisterTask() upgrade.HeightTask {
+	return nil
+}
+
+// InitGenesis performs the packet forward middleware genesis initialization
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	am.keeper.InitGenesis(ctx, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the packet forward middleware exported genesis state as raw JSON bytes
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return ModuleCdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
+}
+
+func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {}
+
+func (AppModule) Route() string {
+	return ""
+}
+
+func (AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+func (AppModule) QuerierRoute() string {
+	return ""
+}
+
+func (AppModule) NewQuerierHandler() sdk.Querier {
+	return nil
+}
+
+func (AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
+
+func (AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+func (AppModule) RegisterServices(module.Configurator) {}
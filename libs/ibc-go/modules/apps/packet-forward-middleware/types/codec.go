@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	codectypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+)
+
+var (
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
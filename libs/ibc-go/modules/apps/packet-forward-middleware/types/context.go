@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+type contextKey int
+
+const (
+	contextKeyForwarding contextKey = iota
+)
+
+// WithForwarding marks the context as receiving a packet which is going to be forwarded,
+// so that the funds received by the intermediate account are kept untouched, e.g. they
+// are not auto-converted to ERC-20 tokens.
+func WithForwarding(ctx sdk.Context) sdk.Context {
+	ctx.SetContext(context.WithValue(ctx.Context(), contextKeyForwarding, true))
+	return ctx
+}
+
+// IsForwarding returns true if the context is receiving a packet which is going to be forwarded
+func IsForwarding(ctx sdk.Context) bool {
+	if ctx.Context() == nil {
+		return false
+	}
+	forwarding, ok := ctx.Context().Value(contextKeyForwarding).(bool)
+	return ok && forwarding
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrInvalidInFlightPacket  = sdkerrors.Register(ModuleName, 3, "invalid in-flight packet")
+	ErrForwardTransfer        = sdkerrors.Register(ModuleName, 4, "failed to forward the transfer to the next hop")
+	ErrRefundForward          = sdkerrors.Register(ModuleName, 5, "failed to refund the forwarded transfer")
+)
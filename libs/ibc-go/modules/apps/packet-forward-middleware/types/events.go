@@ -0,0 +1,16 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeForward        = "packet_forward"
+	EventTypeForwardRefund  = "packet_forward_refund"
+	EventTypeForwardTimeout = "packet_forward_timeout"
+
+	AttributeKeyForwardPort      = "forward_port"
+	AttributeKeyForwardChannel   = "forward_channel"
+	AttributeKeyForwardSequence  = "forward_sequence"
+	AttributeKeyForwardReceiver  = "forward_receiver"
+	AttributeKeyRetriesRemaining = "retries_remaining"
+	AttributeKeyRefundChannel    = "refund_channel"
+	AttributeKeyRefundSequence   = "refund_sequence"
+)
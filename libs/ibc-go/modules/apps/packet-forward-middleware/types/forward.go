@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	host "github.com/okx/okbchain/libs/ibc-go/modules/core/24-host"
+)
+
+// PacketMetadata wraps the forwarding instructions carried by the memo of an ICS-20 packet,
+// e.g. {"forward":{"receiver":"...","port":"transfer","channel":"channel-0"}}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines the next hop of a forwarded transfer
+type ForwardMetadata struct {
+	Receiver string   `json:"receiver,omitempty"`
+	Port     string   `json:"port,omitempty"`
+	Channel  string   `json:"channel,omitempty"`
+	Timeout  Duration `json:"timeout,omitempty"`
+	Retries  *uint8   `json:"retries,omitempty"`
+
+	// Next is the memo of the next hop. It can be either a JSON object, e.g. the forwarding
+	// instructions of the following hop, or a plain string.
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Duration is a time.Duration which can be unmarshalled from either a number of nanoseconds
+// or a duration string, e.g. "10m"
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return err
+	}
+	switch value := v.(type) {
+	case float64:
+		*d = Duration(time.Duration(value))
+	case string:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	default:
+		return errors.New("invalid duration")
+	}
+	return nil
+}
+
+// ParseForwardMetadata returns the forwarding instructions carried by the given memo.
+// The memo is not considered as a forwarding one if it is not a JSON object with a
+// forward field, in which case the packet is handled by the underlying application.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, false, nil
+	}
+	if _, ok := fields[ForwardMetadataKey]; !ok {
+		return nil, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, true, sdkerrors.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+	if metadata.Forward == nil {
+		return nil, true, sdkerrors.Wrap(ErrInvalidForwardMetadata, "forward field is empty")
+	}
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, true, err
+	}
+	return metadata.Forward, true, nil
+}
+
+// Validate performs a basic validation of the forwarding instructions
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+	if m.Timeout < 0 {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "timeout cannot be negative")
+	}
+	return nil
+}
+
+// NextMemo returns the memo of the packet sent to the next hop
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+	if next[0] == '"' {
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", sdkerrors.Wrap(ErrInvalidForwardMetadata, err.Error())
+		}
+		return memo, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, next); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+	return buf.String(), nil
+}
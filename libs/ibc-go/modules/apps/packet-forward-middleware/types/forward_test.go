@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		memo       string
+		forwarding bool
+		expPass    bool
+	}{
+		{"empty memo", "", false, true},
+		{"plain text memo", "hello", false, true},
+		{"json memo without forward", `{"wasm":{"contract":"ex1"}}`, false, true},
+		{"valid forward", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0"}}`, true, true},
+		{"valid forward with timeout and retries", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":"10m","retries":2}}`, true, true},
+		{"empty forward", `{"forward":null}`, true, false},
+		{"malformed forward", `{"forward":"transfer/channel-0"}`, true, false},
+		{"empty receiver", `{"forward":{"port":"transfer","channel":"channel-0"}}`, true, false},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"c"}}`, true, false},
+		{"invalid timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":"ten"}}`, true, false},
+	}
+
+	for _, tc := range testCases {
+		metadata, forwarding, err := types.ParseForwardMetadata(tc.memo)
+		require.Equal(t, tc.forwarding, forwarding, tc.name)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		if forwarding {
+			require.NotNil(t, metadata, tc.name)
+		}
+	}
+}
+
+func TestForwardMetadataTimeoutAndRetries(t *testing.T) {
+	metadata, _, err := types.ParseForwardMetadata(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":"10m","retries":2}}`)
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Minute, time.Duration(metadata.Timeout))
+	require.Equal(t, uint8(2), *metadata.Retries)
+
+	metadata, _, err = types.ParseForwardMetadata(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":60000000000}}`)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, time.Duration(metadata.Timeout))
+	require.Nil(t, metadata.Retries)
+}
+
+func TestNextMemo(t *testing.T) {
+	testCases := []struct {
+		name    string
+		memo    string
+		expMemo string
+	}{
+		{"no next", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0"}}`, ""},
+		{"next as string", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","next":"hello"}}`, "hello"},
+		{
+			"next as object",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","next":{ "forward": {"receiver":"osmo1","port":"transfer","channel":"channel-1"} }}}`,
+			`{"forward":{"receiver":"osmo1","port":"transfer","channel":"channel-1"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		metadata, _, err := types.ParseForwardMetadata(tc.memo)
+		require.NoError(t, err, tc.name)
+		next, err := metadata.NextMemo()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMemo, next, tc.name)
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a packet forward middleware GenesisState instance.
+func NewGenesisState(inFlightPackets map[string]InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the packet forward middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: make(map[string]InFlightPacket),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for key, packet := range gs.InFlightPackets {
+		if key == "" {
+			return sdkerrors.Wrap(ErrInvalidInFlightPacket, "key cannot be empty")
+		}
+		if packet.OriginalSenderAddress == "" {
+			return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "original sender of %s cannot be empty", key)
+		}
+		if len(packet.PacketData) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "packet data of %s cannot be empty", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	ibctesting "github.com/okx/okbchain/libs/ibc-go/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	key := types.RefundPacketKey(ibctesting.FirstChannelID, ibctesting.TransferPort, 1)
+	validPacket := types.InFlightPacket{
+		OriginalSenderAddress: "cosmos1sender",
+		RefundChannelId:       ibctesting.FirstChannelID,
+		RefundPortId:          ibctesting.TransferPort,
+		PacketData:            []byte("data"),
+	}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default genesis", types.DefaultGenesisState(), true},
+		{"valid genesis", types.NewGenesisState(map[string]types.InFlightPacket{key: validPacket}), true},
+		{"empty key", types.NewGenesisState(map[string]types.InFlightPacket{"": validPacket}), false},
+		{"empty original sender", types.NewGenesisState(map[string]types.InFlightPacket{key: {PacketData: []byte("data")}}), false},
+		{"empty packet data", types.NewGenesisState(map[string]types.InFlightPacket{key: {OriginalSenderAddress: "cosmos1sender"}}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
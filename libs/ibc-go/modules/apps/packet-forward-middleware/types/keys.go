@@ -0,0 +1,50 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the packet forward middleware name
+	ModuleName = "packetfwd"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// ForwardMetadataKey is the key of the forwarding instructions in the ICS-20 memo
+	ForwardMetadataKey = "forward"
+
+	// InFlightPacketPrefix is the key prefix for the forwarded packets which are waiting
+	// for an acknowledgement or a timeout
+	InFlightPacketPrefix = "inFlightPacket"
+)
+
+// KeyInFlightPacket returns the key of the in-flight packet which is sent on the given
+// channel with the given sequence, i.e. the packet forwarded to the next hop.
+func KeyInFlightPacket(channelID, portID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s", InFlightPacketPrefix, RefundPacketKey(channelID, portID, sequence)))
+}
+
+// RefundPacketKey returns the identifier of the forwarded packet which is used as the key
+// of the in-flight packets in the genesis state.
+func RefundPacketKey(channelID, portID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%d", channelID, portID, sequence)
+}
+
+// IntermediateReceiver returns the account which receives the funds to be forwarded on this
+// chain. It is derived from the receiving channel and the original sender, so that nobody
+// holds its private key and the original sender cannot be impersonated on this chain.
+func IntermediateReceiver(channelID, originalSender string) sdk.AccAddress {
+	// a slash is used to create domain separation between channel identifiers and senders
+	contents := fmt.Sprintf("%s/%s", channelID, originalSender)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(ModuleName)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	ibctesting "github.com/okx/okbchain/libs/ibc-go/testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyInFlightPacket(t *testing.T) {
+	key := types.KeyInFlightPacket(ibctesting.FirstChannelID, ibctesting.TransferPort, 1)
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%d", types.InFlightPacketPrefix, ibctesting.FirstChannelID, ibctesting.TransferPort, 1))
+}
+
+func TestIntermediateReceiver(t *testing.T) {
+	receiver := types.IntermediateReceiver(ibctesting.FirstChannelID, "cosmos1sender")
+	require.Len(t, receiver, 20)
+	require.Equal(t, receiver, types.IntermediateReceiver(ibctesting.FirstChannelID, "cosmos1sender"))
+	require.NotEqual(t, receiver, types.IntermediateReceiver("channel-1", "cosmos1sender"))
+	require.NotEqual(t, receiver, types.IntermediateReceiver(ibctesting.FirstChannelID, "cosmos1other"))
+}
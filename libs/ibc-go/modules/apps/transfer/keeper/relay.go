@@ -53,6 +53,22 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+) error {
+	return k.SendTransferWithMemo(ctx, sourcePort, sourceChannel, adapterToken, sender, receiver, timeoutHeight, timeoutTimestamp, "")
+}
+
+// SendTransferWithMemo is the same as SendTransfer, except that the given memo is
+// carried by the packet data, e.g. the forwarding instructions for the next hop.
+func (k Keeper) SendTransferWithMemo(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	adapterToken sdk.CoinAdapter,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
 ) error {
 	if !k.GetSendEnabled(ctx) {
 		return types.ErrSendDisabled
@@ -139,6 +155,7 @@ func (k Keeper) SendTransfer(
 	packetData := types.NewFungibleTokenPacketData(
 		fullDenomPath, adapterToken.Amount.String(), sender.String(), receiver,
 	)
+	packetData.Memo = memo
 
 	packet := channeltypes.NewPacket(
 		packetData.GetBytes(),
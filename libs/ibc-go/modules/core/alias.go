@@ -22,6 +22,7 @@ var (
 )
 
 const (
+	IBCV8 common.SelectVersion = 8.0
 	IBCV4 common.SelectVersion = 4.0
 	IBCV2 common.SelectVersion = 2.0
 )
@@ -493,7 +493,7 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 				1,
 				suite.chainB.SenderAccountPV(),
 			)
-			antehandler := appante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(), app.WasmHandler, k, app.StakingKeeper, app.ParamsKeeper)
+			antehandler := appante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, nil, validateMsgHook(), app.WasmHandler, k, app.StakingKeeper, app.ParamsKeeper)
 			antehandler(deliverCtx, ibcTx, false)
 			//_, err = decorator.AnteHandle(deliverCtx, ibcTx, false, next)
 			suite.Require().NoError(err, "antedecorator should not error on DeliverTx")
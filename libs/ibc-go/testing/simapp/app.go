@@ -56,6 +56,9 @@ import (
 	ibcfee "github.com/okx/okbchain/libs/ibc-go/modules/apps/29-fee"
 	ibcfeekeeper "github.com/okx/okbchain/libs/ibc-go/modules/apps/29-fee/keeper"
 	ibcfeetypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/29-fee/types"
+	packetforward "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware"
+	packetforwardkeeper "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/keeper"
+	packetforwardtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
 	ibctransfer "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer"
 	ibctransferkeeper "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
@@ -182,6 +185,7 @@ var (
 		ica2.TestICAModuleBaisc{},
 		fee.TestFeeAppModuleBaisc{},
 		icamauth.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -274,6 +278,7 @@ type SimApp struct {
 	WasmHandler  wasmkeeper.HandlerOption
 
 	IBCFeeKeeper        ibcfeekeeper.Keeper
+	PacketForwardKeeper packetforwardkeeper.Keeper
 	ICAMauthKeeper      icamauthkeeper.Keeper
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
@@ -320,6 +325,7 @@ func NewSimApp(
 		wasm.StoreKey,
 		icacontrollertypes.StoreKey, icahosttypes.StoreKey, ibcfeetypes.StoreKey,
 		icamauthtypes.StoreKey,
+		packetforwardtypes.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -468,7 +474,7 @@ func NewSimApp(
 	)
 
 	app.Erc20Keeper = erc20.NewKeeper(app.marshal.GetCdc(), app.keys[erc20.ModuleName], app.subspaces[erc20.ModuleName],
-		app.AccountKeeper, app.SupplyKeeper, app.BankKeeper, app.EvmKeeper, app.TransferKeeper)
+		app.AccountKeeper, app.SupplyKeeper, app.BankKeeper, app.EvmKeeper, app.TransferKeeper, v2keeper.ChannelKeeper)
 
 	// register the proposal types
 	// 3.register the proposal types
@@ -508,9 +514,19 @@ func NewSimApp(
 	//middle := transfer2.NewIBCModule(app.TransferKeeper)
 	transferModule := transfer.TNewTransferModule(app.TransferKeeper, codecProxy)
 	middle := ibctransfer.NewIBCModule(app.TransferKeeper, transferModule.AppModule)
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(codecProxy, keys[packetforwardtypes.StoreKey],
+		app.TransferKeeper, v2keeper.ChannelKeeper, app.SupplyKeeper,
+		app.IBCFeeKeeper, // the acknowledgements of the forwarded packets are written through the fee middleware
+	)
 	right := ibcfee.NewIBCMiddleware(middle, app.IBCFeeKeeper)
+	forwardRight := ibcfee.NewIBCMiddleware(
+		packetforward.NewIBCMiddleware(middle, app.PacketForwardKeeper,
+			packetforward.DefaultRetriesOnTimeout, packetforward.DefaultForwardTransferPacketTimeout),
+		app.IBCFeeKeeper,
+	)
 	transferStack := ibcporttypes.NewFacadedMiddleware(middle,
 		ibccommon.DefaultFactory(tmtypes.HigherThanVenus4, ibc.IBCV4, right),
+		ibccommon.DefaultFactory(tmtypes.HigherThanVenus8, ibc.IBCV8, forwardRight),
 	)
 
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
@@ -566,6 +582,8 @@ func NewSimApp(
 		app.subspaces[wasm.ModuleName],
 		&app.AccountKeeper,
 		bank.NewBankKeeperAdapter(app.BankKeeper),
+		stakingKeeper,
+		app.DistrKeeper,
 		v2keeper.ChannelKeeper,
 		&v2keeper.PortKeeper,
 		nil,
@@ -606,6 +624,7 @@ func NewSimApp(
 		fee.NewTestFeeAppModule(app.IBCFeeKeeper),
 		ica2.NewTestICAModule(codecProxy, &app.ICAControllerKeeper, &app.ICAHostKeeper),
 		icamauth.NewAppModule(codecProxy, app.ICAMauthKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -649,6 +668,7 @@ func NewSimApp(
 		mock.ModuleName,
 		wasm.ModuleName,
 		icatypes.ModuleName, ibcfeetypes.ModuleName,
+		packetforwardtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -689,9 +709,9 @@ func NewSimApp(
 		WasmConfig:        &wasmConfig,
 		TXCounterStoreKey: keys[wasm.StoreKey],
 	}
-	app.SetAnteHandler(appante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(), app.WasmHandler, app.IBCKeeper, app.StakingKeeper, app.ParamsKeeper))
+	app.SetAnteHandler(appante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, nil, validateMsgHook(), app.WasmHandler, app.IBCKeeper, app.StakingKeeper, app.ParamsKeeper))
 	app.SetEndBlocker(app.EndBlocker)
-	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper))
+	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper, nil))
 	app.SetAccNonceHandler(NewAccHandler(app.AccountKeeper))
 	app.SetUpdateWasmTxCount(fixCosmosTxCountInWasmForParallelTx(app.WasmHandler.TXCounterStoreKey))
 	app.SetUpdateFeeCollectorAccHandler(updateFeeCollectorHandler(app.BankKeeper, app.SupplyKeeper.Keeper))
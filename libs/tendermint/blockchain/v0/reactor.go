@@ -135,6 +135,17 @@ func (bcR *BlockchainReactor) OnStart() error {
 	return nil
 }
 
+// SwitchToFastSync is called by the state sync reactor when switching to fast sync.
+func (bcR *BlockchainReactor) SwitchToFastSync(state sm.State) error {
+	bcR.mtx.Lock()
+	bcR.fastSync = true
+	bcR.curState = state
+	bcR.mtx.Unlock()
+
+	go bcR.poolRoutine()
+	return nil
+}
+
 // OnStop implements service.Service.
 func (bcR *BlockchainReactor) OnStop() {
 	bcR.pool.Stop()
@@ -195,6 +195,21 @@ func AddNodeFlags(cmd *cobra.Command) {
 		config.Mempool.PendingRemoveEvent,
 		"Push event when remove a pending tx",
 	)
+	cmd.Flags().Bool(
+		"mempool.enable_journal",
+		config.Mempool.EnableJournal,
+		"Persist accepted txs to disk and re-check them when the node restarts",
+	)
+	cmd.Flags().String(
+		"mempool.journal_file",
+		config.Mempool.JournalPath,
+		"Path of the mempool journal, relative to the home directory",
+	)
+	cmd.Flags().Duration(
+		"mempool.journal_rejournal",
+		config.Mempool.JournalRejournal,
+		"Time interval to regenerate the mempool journal",
+	)
 
 	cmd.Flags().String(
 		"mempool.node_key_whitelist",
@@ -228,7 +243,7 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().String(
 		"db_backend",
 		types.DBBackend,
-		"Database backend: goleveldb | cleveldb | boltdb | rocksdb")
+		"Database backend: goleveldb | cleveldb | boltdb | rocksdb | pebbledb")
 	cmd.Flags().String(
 		"db_dir",
 		config.DBPath,
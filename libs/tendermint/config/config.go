@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/okx/okbchain/libs/system"
 	"net/http"
@@ -72,6 +73,7 @@ type Config struct {
 	RPC             *RPCConfig             `mapstructure:"rpc"`
 	P2P             *P2PConfig             `mapstructure:"p2p"`
 	Mempool         *MempoolConfig         `mapstructure:"mempool"`
+	StateSync       *StateSyncConfig       `mapstructure:"statesync"`
 	FastSync        *FastSyncConfig        `mapstructure:"fastsync"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
@@ -86,6 +88,7 @@ func DefaultConfig() *Config {
 		RPC:             DefaultRPCConfig(),
 		P2P:             DefaultP2PConfig(),
 		Mempool:         DefaultMempoolConfig(),
+		StateSync:       DefaultStateSyncConfig(),
 		FastSync:        DefaultFastSyncConfig(),
 		Consensus:       DefaultConsensusConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
@@ -101,6 +104,7 @@ func TestConfig() *Config {
 		RPC:             TestRPCConfig(),
 		P2P:             TestP2PConfig(),
 		Mempool:         TestMempoolConfig(),
+		StateSync:       TestStateSyncConfig(),
 		FastSync:        TestFastSyncConfig(),
 		Consensus:       TestConsensusConfig(),
 		TxIndex:         TestTxIndexConfig(),
@@ -138,6 +142,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Mempool.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [mempool] section")
 	}
+	if err := cfg.StateSync.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [statesync] section")
+	}
 	if err := cfg.FastSync.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [fastsync] section")
 	}
@@ -178,7 +185,7 @@ type BaseConfig struct { //nolint: maligned
 	// when it is many blocks behind the tip of the chain.
 	AutoFastSync bool `mapstructure:"auto_fast_sync"`
 
-	// Database backend: goleveldb | cleveldb | boltdb | rocksdb
+	// Database backend: goleveldb | cleveldb | boltdb | rocksdb | pebbledb
 	// * goleveldb (github.com/syndtr/goleveldb - most popular implementation)
 	//   - pure go
 	//   - stable
@@ -194,6 +201,9 @@ type BaseConfig struct { //nolint: maligned
 	//   - EXPERIMENTAL
 	//   - requires gcc
 	//   - use rocksdb build tag (go build -tags rocksdb)
+	// * pebbledb (github.com/cockroachdb/pebble)
+	//   - EXPERIMENTAL
+	//   - pure go
 	DBBackend string `mapstructure:"db_backend"`
 
 	// Database directory
@@ -690,6 +700,12 @@ type MempoolConfig struct {
 	PendingPoolMaxTxPerAddress int      `mapstructure:"pending_pool_max_tx_per_address"`
 	NodeKeyWhitelist           []string `mapstructure:"node_key_whitelist"`
 	PendingRemoveEvent         bool     `mapstructure:"pending_remove_event"`
+
+	// EnableJournal persists accepted txs to JournalPath so they survive a
+	// node restart. The journal is rewritten every JournalRejournal.
+	EnableJournal    bool          `mapstructure:"enable_journal"`
+	JournalPath      string        `mapstructure:"journal_file"`
+	JournalRejournal time.Duration `mapstructure:"journal_rejournal"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool
@@ -716,6 +732,9 @@ func DefaultMempoolConfig() *MempoolConfig {
 		PendingPoolMaxTxPerAddress: 100,
 		NodeKeyWhitelist:           []string{},
 		PendingRemoveEvent:         false,
+		EnableJournal:              false,
+		JournalPath:                filepath.Join(defaultDataDir, "mempool.journal"),
+		JournalRejournal:           time.Hour,
 	}
 }
 
@@ -736,6 +755,11 @@ func (cfg *MempoolConfig) GetNodeKeyWhitelist() []string {
 	return cfg.NodeKeyWhitelist
 }
 
+// JournalFile returns the full path to the mempool journal file
+func (cfg *MempoolConfig) JournalFile() string {
+	return rootify(cfg.JournalPath, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
@@ -757,6 +781,89 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.ForceRecheckGap <= 0 {
 		return errors.New("force_recheck_gap can't be negative or zero")
 	}
+	if cfg.EnableJournal && cfg.JournalRejournal <= 0 {
+		return errors.New("journal_rejournal can't be negative or zero")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// StateSyncConfig
+
+// StateSyncConfig defines the configuration for the Tendermint state sync service
+type StateSyncConfig struct {
+	Enable              bool          `mapstructure:"enable"`
+	TempDir             string        `mapstructure:"temp_dir"`
+	RPCServers          []string      `mapstructure:"rpc_servers"`
+	TrustPeriod         time.Duration `mapstructure:"trust_period"`
+	TrustHeight         int64         `mapstructure:"trust_height"`
+	TrustHash           string        `mapstructure:"trust_hash"`
+	DiscoveryTime       time.Duration `mapstructure:"discovery_time"`
+	ChunkRequestTimeout time.Duration `mapstructure:"chunk_request_timeout"`
+	ChunkFetchers       int32         `mapstructure:"chunk_fetchers"`
+}
+
+func (cfg *StateSyncConfig) TrustHashBytes() []byte {
+	// validated in ValidateBasic, so we can safely panic here
+	bytes, err := hex.DecodeString(cfg.TrustHash)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// DefaultStateSyncConfig returns a default configuration for the state sync service
+func DefaultStateSyncConfig() *StateSyncConfig {
+	return &StateSyncConfig{
+		TrustPeriod:         168 * time.Hour,
+		DiscoveryTime:       15 * time.Second,
+		ChunkRequestTimeout: 10 * time.Second,
+		ChunkFetchers:       4,
+	}
+}
+
+// TestStateSyncConfig returns a default configuration for the state sync service
+func TestStateSyncConfig() *StateSyncConfig {
+	return DefaultStateSyncConfig()
+}
+
+// ValidateBasic performs basic validation.
+func (cfg *StateSyncConfig) ValidateBasic() error {
+	if !cfg.Enable {
+		return nil
+	}
+	if len(cfg.RPCServers) == 0 {
+		return errors.New("rpc_servers is required")
+	}
+	if len(cfg.RPCServers) < 2 {
+		return errors.New("at least two rpc_servers entries is required")
+	}
+	for _, server := range cfg.RPCServers {
+		if len(server) == 0 {
+			return errors.New("found empty rpc_servers entry")
+		}
+	}
+	if cfg.DiscoveryTime != 0 && cfg.DiscoveryTime < 5*time.Second {
+		return errors.New("discovery time must be 0s or greater than five seconds")
+	}
+	if cfg.TrustPeriod <= 0 {
+		return errors.New("trusted_period is required")
+	}
+	if cfg.TrustHeight <= 0 {
+		return errors.New("trusted_height is required")
+	}
+	if len(cfg.TrustHash) == 0 {
+		return errors.New("trusted_hash is required")
+	}
+	if _, err := hex.DecodeString(cfg.TrustHash); err != nil {
+		return fmt.Errorf("invalid trusted_hash: %w", err)
+	}
+	if cfg.ChunkRequestTimeout < 5*time.Second {
+		return errors.New("chunk_request_timeout must be at least 5 seconds")
+	}
+	if cfg.ChunkFetchers <= 0 {
+		return errors.New("chunk_fetchers is required")
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"text/template"
 
 	tmos "github.com/okx/okbchain/libs/tendermint/libs/os"
@@ -17,7 +18,10 @@ var configTemplate *template.Template
 
 func init() {
 	var err error
-	if configTemplate, err = template.New("configFileTemplate").Parse(defaultConfigTemplate); err != nil {
+	tmpl := template.New("configFileTemplate").Funcs(template.FuncMap{
+		"StringsJoin": strings.Join,
+	})
+	if configTemplate, err = tmpl.Parse(defaultConfigTemplate); err != nil {
 		panic(err)
 	}
 }
@@ -90,7 +94,7 @@ fast_sync = {{ .BaseConfig.FastSyncMode }}
 # when it is many blocks behind the tip of the chain.
 auto_fast_sync = {{ .BaseConfig.AutoFastSync }}
 
-# Database backend: goleveldb | cleveldb | boltdb | rocksdb
+# Database backend: goleveldb | cleveldb | boltdb | rocksdb | pebbledb
 # * goleveldb (github.com/syndtr/goleveldb - most popular implementation)
 #   - pure go
 #   - stable
@@ -106,6 +110,9 @@ auto_fast_sync = {{ .BaseConfig.AutoFastSync }}
 #   - EXPERIMENTAL
 #   - requires gcc
 #   - use rocksdb build tag (go build -tags rocksdb)
+# * pebbledb (github.com/cockroachdb/pebble)
+#   - EXPERIMENTAL
+#   - pure go
 db_backend = "{{ .BaseConfig.DBBackend }}"
 
 # Database directory
@@ -331,6 +338,47 @@ tx_price_bump = {{ .Mempool.TxPriceBump }}
 # Node key whitelist used in mempool to reduce CPU and Memory tradeoff 
 node_key_whitelist = [{{ range .Mempool.NodeKeyWhitelist }}{{ printf "%q, " . }}{{end}}]
 
+# Persist accepted txs to disk and re-check them when the node restarts
+enable_journal = {{ .Mempool.EnableJournal }}
+journal_file = "{{ js .Mempool.JournalPath }}"
+
+# Time interval to regenerate the journal from the current mempool content
+journal_rejournal = "{{ .Mempool.JournalRejournal }}"
+
+##### state sync configuration options #####
+[statesync]
+# State sync rapidly bootstraps a new node by discovering, fetching, and restoring a state machine
+# snapshot from peers instead of fetching and replaying historical blocks. Requires some peers in
+# the network to take and serve state machine snapshots. State sync is not attempted if the node
+# has any local state (LastBlockHeight > 0). The node will have a truncated block history,
+# starting from the height of the snapshot.
+enable = {{ .StateSync.Enable }}
+
+# RPC servers (comma-separated) for light client verification of the synced state machine and
+# retrieval of state data for node bootstrapping. Also needs a trusted height and corresponding
+# header hash obtained from a trusted source, and a period during which validators can be trusted.
+#
+# For Cosmos SDK-based chains, trust_period should usually be about 2/3 of the unbonding time (~2
+# weeks) during which they can be financially punished (slashed) for misbehavior.
+rpc_servers = "{{ StringsJoin .StateSync.RPCServers "," }}"
+trust_height = {{ .StateSync.TrustHeight }}
+trust_hash = "{{ .StateSync.TrustHash }}"
+trust_period = "{{ .StateSync.TrustPeriod }}"
+
+# Time to spend discovering snapshots before initiating a restore.
+discovery_time = "{{ .StateSync.DiscoveryTime }}"
+
+# Temporary directory for state sync snapshot chunks, defaults to the OS tempdir (typically /tmp).
+# Will create a new, randomly named directory within, and remove it when done.
+temp_dir = "{{ .StateSync.TempDir }}"
+
+# The timeout duration before re-requesting a chunk, possibly from a different
+# peer (default: 10 seconds).
+chunk_request_timeout = "{{ .StateSync.ChunkRequestTimeout }}"
+
+# The number of concurrent chunk fetchers to run (default: 4).
+chunk_fetchers = {{ .StateSync.ChunkFetchers }}
+
 ##### fast sync configuration options #####
 [fastsync]
 
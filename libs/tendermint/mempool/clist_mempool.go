@@ -108,6 +108,10 @@ type CListMempool struct {
 	gpo *Oracle
 
 	info pguInfo
+
+	journal     *txJournal    // Journal of accepted txs to back up to disk
+	journalQuit chan struct{} // Closed to stop the journal rotation
+	journalDone chan struct{} // Closed once the journal rotation has stopped
 }
 
 type pguInfo struct {
@@ -186,6 +190,10 @@ func NewCListMempool(
 		go mempool.consumePendingTxQueueJob()
 	}
 
+	if config.EnableJournal {
+		mempool.journal = newTxJournal(config.JournalFile(), config.MaxTxBytes)
+	}
+
 	return mempool
 }
 
@@ -738,6 +746,7 @@ func (mem *CListMempool) resCbFirstTime(
 
 			if err == nil {
 				mem.logAddTx(memTx, r)
+				mem.journalTx(memTx.tx)
 				mem.notifyTxsAvailable()
 			} else {
 				// ignore bad transaction
@@ -75,6 +75,16 @@ func (p *PendingPool) getTx(address string, nonce uint64) *mempoolTx {
 	return nil
 }
 
+func (p *PendingPool) getTxs() []*mempoolTx {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	txs := make([]*mempoolTx, 0, len(p.txsMap))
+	for _, pendingTx := range p.txsMap {
+		txs = append(txs, pendingTx)
+	}
+	return txs
+}
+
 func (p *PendingPool) hasTx(tx types.Tx) bool {
 	p.mtx.RLock()
 	defer p.mtx.RUnlock()
@@ -0,0 +1,296 @@
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/libs/tendermint/types"
+)
+
+// errNoActiveJournal is returned if a tx is attempted to be inserted into the
+// journal, but no such file is currently open.
+var errNoActiveJournal = errors.New("no active journal")
+
+// devNull is a WriteCloser that just discards anything written into it. Its
+// goal is to allow the journal to write into a fake journal when loading txs
+// on startup without printing warnings due to no file being ready for write.
+type devNull struct{}
+
+func (*devNull) Write(p []byte) (n int, err error) { return len(p), nil }
+func (*devNull) Close() error                      { return nil }
+
+// txJournal is a rotating log of txs accepted into the mempool, with the aim
+// of storing them across node restarts. Each entry is the raw tx prefixed by
+// its uvarint encoded length.
+type txJournal struct {
+	path       string // Filesystem path to store the txs at
+	maxTxBytes int    // Upper bound of a single entry, longer ones mean corruption
+
+	mtx    sync.Mutex
+	writer io.WriteCloser // Output stream to write new txs into
+}
+
+// newTxJournal creates a new tx journal to store txs at path.
+func newTxJournal(path string, maxTxBytes int) *txJournal {
+	return &txJournal{
+		path:       path,
+		maxTxBytes: maxTxBytes,
+	}
+}
+
+// load parses a tx journal dump from disk, loading its contents into the
+// mempool via add. It returns the number of txs read. A truncated trailing
+// entry, left by a crash during write, ends the load silently.
+func (j *txJournal) load(add func(types.Tx)) (int, error) {
+	input, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer input.Close()
+
+	// Temporarily discard any journal additions, the loaded txs are already
+	// in the file and will be written back on the next rotation.
+	j.mtx.Lock()
+	j.writer = new(devNull)
+	j.mtx.Unlock()
+	defer func() {
+		j.mtx.Lock()
+		j.writer = nil
+		j.mtx.Unlock()
+	}()
+
+	reader := bufio.NewReader(input)
+	total := 0
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err != nil {
+			// io.EOF, or a length prefix cut short by a crash
+			return total, nil
+		}
+		if size == 0 || size > uint64(j.maxTxBytes) {
+			return total, fmt.Errorf("invalid journal entry size %d", size)
+		}
+		tx := make(types.Tx, size)
+		if _, err := io.ReadFull(reader, tx); err != nil {
+			return total, nil
+		}
+		total++
+		add(tx)
+	}
+}
+
+// insert adds the specified tx to the local disk journal.
+func (j *txJournal) insert(tx types.Tx) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	_, err := j.writer.Write(encodeJournalEntry(tx))
+	return err
+}
+
+// rotate regenerates the tx journal based on the current contents of the
+// mempool, replacing the old file atomically.
+func (j *txJournal) rotate(txs types.Txs) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	// Close the current journal (if any is open)
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+	if err := os.MkdirAll(filepath.Dir(j.path), 0700); err != nil {
+		return err
+	}
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(replacement)
+	for _, tx := range txs {
+		if _, err = writer.Write(encodeJournalEntry(tx)); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	if err = writer.Flush(); err != nil {
+		replacement.Close()
+		return err
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	return nil
+}
+
+// close flushes the journal contents to disk and closes the file.
+func (j *txJournal) close() error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	var err error
+	if j.writer != nil {
+		err = j.writer.Close()
+		j.writer = nil
+	}
+	return err
+}
+
+func encodeJournalEntry(tx types.Tx) []byte {
+	buf := make([]byte, binary.MaxVarintLen64+len(tx))
+	n := binary.PutUvarint(buf, uint64(len(tx)))
+	n += copy(buf[n:], tx)
+	return buf[:n]
+}
+
+//--------------------------------------------------------------------------------
+
+// LoadJournal re-runs CheckTx on the txs recorded in the journal by a previous
+// run, then rewrites the journal from the mempool content and starts rotating
+// it every JournalRejournal. It is a no-op if the journal is disabled.
+//
+// NOTE: not thread safe - should only be called once, after the TxInfoParser
+// and AccountRetriever are set.
+func (mem *CListMempool) LoadJournal() error {
+	if mem.journal == nil {
+		return nil
+	}
+
+	dropped := 0
+	total, err := mem.journal.load(func(tx types.Tx) {
+		err := mem.CheckTx(tx, func(res *abci.Response) {
+			if r, ok := res.Value.(*abci.Response_CheckTx); ok && r.CheckTx.Code != abci.CodeTypeOK {
+				dropped++
+			}
+		}, TxInfo{SenderID: UnknownPeerID})
+		if err != nil {
+			dropped++
+		}
+	})
+	if err != nil {
+		mem.logger.Error("Failed to load mempool journal", "err", err)
+	}
+	// wait for the responses of all the CheckTx calls above
+	if err = mem.FlushAppConn(); err != nil {
+		return err
+	}
+	mem.logger.Info("Loaded mempool journal", "txs", total, "dropped", dropped, "total", mem.Size())
+
+	mem.updateMtx.Lock()
+	// the txs re-checked above may exceed the limits, evict them as Update does
+	if mem.GetEnableDeleteMinGPTx() {
+		mem.deleteMinGPTxOnlyFull()
+	}
+	err = mem.rotateJournal()
+	mem.updateMtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	mem.journalQuit = make(chan struct{})
+	mem.journalDone = make(chan struct{})
+	go mem.journalRoutine()
+	return nil
+}
+
+// CloseJournal stops the rotation of the journal and closes its file. It is a
+// no-op if the journal is disabled or has not been loaded.
+func (mem *CListMempool) CloseJournal() error {
+	if mem.journal == nil {
+		return nil
+	}
+	if mem.journalQuit != nil {
+		close(mem.journalQuit)
+		<-mem.journalDone
+		mem.journalQuit = nil
+	}
+	return mem.journal.close()
+}
+
+func (mem *CListMempool) journalRoutine() {
+	ticker := time.NewTicker(mem.config.JournalRejournal)
+	defer func() {
+		ticker.Stop()
+		close(mem.journalDone)
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			mem.updateMtx.Lock()
+			err := mem.rotateJournal()
+			mem.updateMtx.Unlock()
+			if err != nil {
+				mem.logger.Error("Failed to rotate mempool journal", "err", err)
+			}
+		case <-mem.journalQuit:
+			return
+		}
+	}
+}
+
+// rotateJournal rewrites the journal with the txs of the mempool and the
+// pending pool, sorted by sender and nonce so that they are re-checked in a
+// valid order on the next load.
+//
+// Lock() must be held by the caller during execution.
+func (mem *CListMempool) rotateJournal() error {
+	var memTxs []*mempoolTx
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTxs = append(memTxs, e.Value.(*mempoolTx))
+	}
+	if mem.pendingPool != nil {
+		memTxs = append(memTxs, mem.pendingPool.getTxs()...)
+	}
+	sort.SliceStable(memTxs, func(i, j int) bool {
+		if memTxs[i].from != memTxs[j].from {
+			return memTxs[i].from < memTxs[j].from
+		}
+		return memTxs[i].realTx.GetNonce() < memTxs[j].realTx.GetNonce()
+	})
+
+	txs := make(types.Txs, len(memTxs))
+	for i, memTx := range memTxs {
+		txs[i] = memTx.tx
+	}
+	return mem.journal.rotate(txs)
+}
+
+// journalTx appends a tx accepted into the mempool or the pending pool to the
+// journal, if it is enabled.
+func (mem *CListMempool) journalTx(tx types.Tx) {
+	if mem.journal == nil {
+		return
+	}
+	// errNoActiveJournal means the journal is not loaded yet, the tx will be
+	// picked up by the rotation at the end of LoadJournal.
+	if err := mem.journal.insert(tx); err != nil && err != errNoActiveJournal {
+		mem.logger.Error("Failed to journal tx", "tx", txID(tx), "err", err)
+	}
+}
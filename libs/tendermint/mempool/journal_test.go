@@ -0,0 +1,96 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okx/okbchain/libs/tendermint/abci/example/kvstore"
+	cfg "github.com/okx/okbchain/libs/tendermint/config"
+	"github.com/okx/okbchain/libs/tendermint/proxy"
+	"github.com/okx/okbchain/libs/tendermint/types"
+)
+
+func TestTxJournal(t *testing.T) {
+	dir, err := os.MkdirTemp("", "mempool_journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "data", "mempool.journal")
+
+	journal := newTxJournal(path, 1024)
+	load := func() types.Txs {
+		var txs types.Txs
+		_, err := journal.load(func(tx types.Tx) { txs = append(txs, tx) })
+		require.NoError(t, err)
+		return txs
+	}
+
+	// nothing to load nor to write to before the first rotation
+	require.Empty(t, load())
+	require.Equal(t, errNoActiveJournal, journal.insert(types.Tx("tx0")))
+
+	require.NoError(t, journal.rotate(types.Txs{types.Tx("tx1"), types.Tx("tx2")}))
+	require.NoError(t, journal.insert(types.Tx("tx3")))
+	require.Equal(t, types.Txs{types.Tx("tx1"), types.Tx("tx2"), types.Tx("tx3")}, load())
+
+	// inserts are discarded while loading, the journal must be rotated again
+	require.Equal(t, errNoActiveJournal, journal.insert(types.Tx("tx4")))
+	require.NoError(t, journal.rotate(types.Txs{types.Tx("tx3")}))
+	require.Equal(t, types.Txs{types.Tx("tx3")}, load())
+
+	// an entry cut short by a crash is dropped
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = f.Write(encodeJournalEntry(types.Tx("tx5"))[:2])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, types.Txs{types.Tx("tx3")}, load())
+
+	// an oversized entry means the journal is corrupted
+	require.NoError(t, journal.rotate(types.Txs{make(types.Tx, 2048)}))
+	_, err = journal.load(func(types.Tx) {})
+	require.Error(t, err)
+}
+
+func TestMempoolJournal(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Mempool.EnableJournal = true
+
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, _ := newMempoolWithAppAndConfig(cc, config)
+	require.NoError(t, mempool.LoadJournal())
+
+	txs := checkTxs(t, mempool, 10, UnknownPeerID)
+	require.Equal(t, len(txs), mempool.Size())
+
+	// a stopped node closes the journal, later txs are not journaled anymore
+	require.NoError(t, mempool.CloseJournal())
+	require.Equal(t, errNoActiveJournal, mempool.journal.insert(types.Tx("tx")))
+
+	// a restarted node re-checks all the journaled txs
+	restarted, _ := newMempoolWithAppAndConfig(cc, config)
+	require.Equal(t, 0, restarted.Size())
+	require.NoError(t, restarted.LoadJournal())
+	require.Equal(t, len(txs), restarted.Size())
+	for _, tx := range txs {
+		_, err := restarted.GetTxByHash(txKey(tx))
+		require.NoError(t, err)
+	}
+
+	// committed txs are dropped from the journal by the next rotation
+	restarted.Lock()
+	err := restarted.Update(1, txs[:4], abciResponses(4, 0), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, restarted.rotateJournal())
+	restarted.Unlock()
+	require.NoError(t, restarted.CloseJournal())
+
+	reloaded, _ := newMempoolWithAppAndConfig(cc, config)
+	require.NoError(t, reloaded.LoadJournal())
+	require.Equal(t, len(txs)-4, reloaded.Size())
+	require.NoError(t, reloaded.CloseJournal())
+}
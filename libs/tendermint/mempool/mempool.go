@@ -95,6 +95,14 @@ type Mempool interface {
 	GetEnableDeleteMinGPTx() bool
 
 	GetPendingPoolTxsBytes() map[string]map[string]types.WrappedMempoolTx
+
+	// LoadJournal restores the txs persisted by a previous run, if the
+	// journal is enabled.
+	LoadJournal() error
+
+	// CloseJournal stops rotating the journal and closes it, if the journal
+	// is enabled.
+	CloseJournal() error
 }
 
 //--------------------------------------------------------------------------------
@@ -90,3 +90,11 @@ func (Mempool) GetEnableDeleteMinGPTx() bool {
 func (Mempool) GetPendingPoolTxsBytes() map[string]map[string]types.WrappedMempoolTx {
 	return make(map[string]map[string]types.WrappedMempoolTx)
 }
+
+func (Mempool) LoadJournal() error {
+	return nil
+}
+
+func (Mempool) CloseJournal() error {
+	return nil
+}
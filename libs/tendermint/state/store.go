@@ -118,6 +118,18 @@ func saveState(db dbm.DB, state State, key []byte) {
 	db.SetSync(key, state.Bytes())
 }
 
+// BootstrapState saves a new state, used e.g. by state sync when starting from non-zero height.
+func BootstrapState(db dbm.DB, state State) error {
+	height := state.LastBlockHeight + 1
+	if height > 1 && !state.LastValidators.IsNilOrEmpty() {
+		saveValidatorsInfo(db, height-1, height-1, state.LastValidators)
+	}
+	saveValidatorsInfo(db, height, height, state.Validators)
+	saveValidatorsInfo(db, height+1, height+1, state.NextValidators)
+	saveConsensusParamsInfo(db, height, state.LastHeightConsensusParamsChanged, state.ConsensusParams)
+	return db.SetSync(stateKey, state.Bytes())
+}
+
 //------------------------------------------------------------------------
 
 // ABCIResponses retains the responses
@@ -0,0 +1,202 @@
+package statesync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okx/okbchain/libs/tendermint/p2p"
+	"github.com/okx/okbchain/libs/tendermint/p2p/mock"
+	sm "github.com/okx/okbchain/libs/tendermint/state"
+	"github.com/okx/okbchain/libs/tendermint/types"
+)
+
+// stubStateProvider returns the height based app hash, failing for heights in failHeights.
+type stubStateProvider struct {
+	failHeights map[uint64]bool
+}
+
+func (s stubStateProvider) AppHash(height uint64) ([]byte, error) {
+	if s.failHeights[height] {
+		return nil, errors.New("app hash not found")
+	}
+	return []byte{byte(height)}, nil
+}
+
+func (s stubStateProvider) Commit(height uint64) (*types.Commit, error) {
+	return &types.Commit{Height: int64(height)}, nil
+}
+
+func (s stubStateProvider) State(height uint64) (sm.State, error) {
+	return sm.State{LastBlockHeight: int64(height)}, nil
+}
+
+func TestSnapshot_Key(t *testing.T) {
+	testcases := map[string]struct {
+		modify func(*snapshot)
+	}{
+		"new height":      {func(s *snapshot) { s.Height = 9 }},
+		"new format":      {func(s *snapshot) { s.Format = 9 }},
+		"new chunk count": {func(s *snapshot) { s.Chunks = 9 }},
+		"new hash":        {func(s *snapshot) { s.Hash = []byte{9} }},
+		"no metadata":     {func(s *snapshot) { s.Metadata = nil }},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := snapshot{
+				Height:   3,
+				Format:   1,
+				Chunks:   7,
+				Hash:     []byte{1, 2, 3},
+				Metadata: []byte{255},
+			}
+			before := s.Key()
+			tc.modify(&s)
+			after := s.Key()
+			assert.NotEqual(t, before, after)
+		})
+	}
+}
+
+func TestSnapshotPool_Add(t *testing.T) {
+	pool := newSnapshotPool(stubStateProvider{})
+	peer := mock.NewPeer(nil)
+
+	added, err := pool.Add(peer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Adding again from a different peer should return false
+	otherPeer := mock.NewPeer(nil)
+	added, err = pool.Add(otherPeer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// The pool should have populated the trusted app hash and know about both peers
+	best := pool.Best()
+	require.NotNil(t, best)
+	assert.Equal(t, []byte{1}, best.trustedAppHash)
+	assert.Len(t, pool.GetPeers(best), 2)
+
+	// A failing state provider should return an error and not add the snapshot
+	pool = newSnapshotPool(stubStateProvider{failHeights: map[uint64]bool{2: true}})
+	added, err = pool.Add(peer, &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}})
+	require.Error(t, err)
+	assert.False(t, added)
+	assert.Nil(t, pool.Best())
+}
+
+func TestSnapshotPool_Ranked_Best(t *testing.T) {
+	pool := newSnapshotPool(stubStateProvider{})
+
+	// snapshots in expected order (best to worst). Highest height wins, then highest format.
+	// Snapshots with different chunk hashes are considered different, and the most peers is
+	// tie-breaker.
+	expectSnapshots := []struct {
+		snapshot *snapshot
+		peers    []string
+	}{
+		{&snapshot{Height: 2, Format: 2, Chunks: 4, Hash: []byte{1, 3}}, []string{"a", "b", "c"}},
+		{&snapshot{Height: 2, Format: 2, Chunks: 5, Hash: []byte{1, 2}}, []string{"a"}},
+		{&snapshot{Height: 2, Format: 1, Chunks: 3, Hash: []byte{1, 2}}, []string{"a", "b"}},
+		{&snapshot{Height: 1, Format: 2, Chunks: 5, Hash: []byte{1, 2}}, []string{"a", "b"}},
+		{&snapshot{Height: 1, Format: 1, Chunks: 4, Hash: []byte{1, 2}}, []string{"a", "b", "c"}},
+	}
+
+	// Add snapshots in reverse order, to make sure the pool enforces some order.
+	peers := map[string]p2p.Peer{}
+	for i := len(expectSnapshots) - 1; i >= 0; i-- {
+		for _, peerID := range expectSnapshots[i].peers {
+			if peers[peerID] == nil {
+				peers[peerID] = mock.NewPeer(nil)
+			}
+			_, err := pool.Add(peers[peerID], expectSnapshots[i].snapshot)
+			require.NoError(t, err)
+		}
+	}
+
+	// Ranked should return the snapshots in the same order
+	ranked := pool.Ranked()
+	assert.Len(t, ranked, len(expectSnapshots))
+	for i := range ranked {
+		assert.Equal(t, expectSnapshots[i].snapshot, ranked[i])
+	}
+
+	// Check that best snapshots are returned in expected order
+	for i := range expectSnapshots {
+		snapshot := expectSnapshots[i].snapshot
+		require.Equal(t, snapshot, pool.Best())
+		pool.Reject(snapshot)
+	}
+	assert.Nil(t, pool.Best())
+}
+
+func TestSnapshotPool_RejectFormat(t *testing.T) {
+	pool := newSnapshotPool(stubStateProvider{})
+	peer := mock.NewPeer(nil)
+
+	snapshots := []*snapshot{
+		{Height: 2, Format: 2, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 1, Format: 2, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1, 2}},
+	}
+	for _, s := range snapshots {
+		_, err := pool.Add(peer, s)
+		require.NoError(t, err)
+	}
+
+	pool.RejectFormat(1)
+	expect := []*snapshot{snapshots[0], snapshots[2]}
+	assert.Equal(t, expect, pool.Ranked())
+
+	// Further additions with the rejected format should be ignored
+	added, err := pool.Add(peer, &snapshot{Height: 3, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Equal(t, expect, pool.Ranked())
+}
+
+func TestSnapshotPool_RejectPeer(t *testing.T) {
+	pool := newSnapshotPool(stubStateProvider{})
+	peerA := mock.NewPeer(nil)
+	peerB := mock.NewPeer(nil)
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+	s3 := &snapshot{Height: 3, Format: 1, Chunks: 1, Hash: []byte{2}}
+
+	_, err := pool.Add(peerA, s1)
+	require.NoError(t, err)
+	_, err = pool.Add(peerA, s2)
+	require.NoError(t, err)
+
+	_, err = pool.Add(peerB, s2)
+	require.NoError(t, err)
+	_, err = pool.Add(peerB, s3)
+	require.NoError(t, err)
+
+	pool.RejectPeer(peerA.ID())
+
+	assert.Empty(t, pool.GetPeers(s1))
+
+	peers2 := pool.GetPeers(s2)
+	assert.Len(t, peers2, 1)
+	assert.Equal(t, peerB.ID(), peers2[0].ID())
+
+	peers3 := pool.GetPeers(s3)
+	assert.Len(t, peers3, 1)
+	assert.Equal(t, peerB.ID(), peers3[0].ID())
+
+	// Snapshots from the rejected peer should be ignored
+	added, err := pool.Add(peerA, &snapshot{Height: 4, Format: 1, Chunks: 1, Hash: []byte{4}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// RemovePeer only drops the peer and its orphaned snapshots
+	pool.RemovePeer(peerB.ID())
+	assert.Empty(t, pool.Ranked())
+}
@@ -0,0 +1,201 @@
+package statesync
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	lite "github.com/okx/okbchain/libs/tendermint/lite2"
+	liteprovider "github.com/okx/okbchain/libs/tendermint/lite2/provider"
+	litehttp "github.com/okx/okbchain/libs/tendermint/lite2/provider/http"
+	literpc "github.com/okx/okbchain/libs/tendermint/lite2/rpc"
+	litedb "github.com/okx/okbchain/libs/tendermint/lite2/store/db"
+	rpchttp "github.com/okx/okbchain/libs/tendermint/rpc/client/http"
+	sm "github.com/okx/okbchain/libs/tendermint/state"
+	"github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/libs/tendermint/version"
+	dbm "github.com/okx/okbchain/libs/tm-db"
+)
+
+// StateProvider is a provider of trusted state data for bootstrapping a node. This refers
+// to the state.State object, not the state machine.
+type StateProvider interface {
+	// AppHash returns the app hash after the given height has been committed.
+	AppHash(height uint64) ([]byte, error)
+	// Commit returns the commit at the given height.
+	Commit(height uint64) (*types.Commit, error)
+	// State returns a state object at the given height.
+	State(height uint64) (sm.State, error)
+}
+
+// lightClientStateProvider is a state provider using the light client.
+type lightClientStateProvider struct {
+	sync.Mutex // lite.Client is not concurrency-safe
+	lc         *lite.Client
+	version    sm.Version
+	providers  map[liteprovider.Provider]string
+}
+
+// NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
+func NewLightClientStateProvider(
+	chainID string,
+	version sm.Version,
+	servers []string,
+	trustOptions lite.TrustOptions,
+	logger log.Logger,
+) (StateProvider, error) {
+	if len(servers) < 2 {
+		return nil, fmt.Errorf("at least 2 RPC servers are required, got %v", len(servers))
+	}
+
+	providers := make([]liteprovider.Provider, 0, len(servers))
+	providerRemotes := make(map[liteprovider.Provider]string)
+	for _, server := range servers {
+		provider, err := litehttp.New(chainID, server)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+		// We store the RPC addresses keyed by provider, so we can find the address of the primary
+		// provider used by the light client and use it to fetch consensus parameters.
+		providerRemotes[provider] = server
+	}
+
+	lc, err := lite.NewClient(chainID, trustOptions, providers[0], providers[1:],
+		litedb.New(dbm.NewMemDB(), ""), lite.Logger(logger), lite.MaxRetryAttempts(5))
+	if err != nil {
+		return nil, err
+	}
+	return &lightClientStateProvider{
+		lc:        lc,
+		version:   version,
+		providers: providerRemotes,
+	}, nil
+}
+
+// AppHash implements StateProvider.
+func (s *lightClientStateProvider) AppHash(height uint64) ([]byte, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// We have to fetch the next height, which contains the app hash for the previous height.
+	header, err := s.lc.VerifyHeaderAtHeight(int64(height+1), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	// We also try to fetch the blocks at height H and H+2, since we need these
+	// when building the state while restoring the snapshot. This avoids the race
+	// condition where we try to restore a snapshot before H+2 exists.
+	//
+	// FIXME This is a hack, since we can't add new methods to the interface without
+	// breaking it. We should instead have a Has(ctx, height) method which checks
+	// that the state provider has access to the necessary data for the height.
+	// We piggyback on AppHash() since it's called when adding snapshots to the pool.
+	_, err = s.lc.VerifyHeaderAtHeight(int64(height+2), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.lc.VerifyHeaderAtHeight(int64(height), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *lightClientStateProvider) Commit(height uint64) (*types.Commit, error) {
+	s.Lock()
+	defer s.Unlock()
+	header, err := s.lc.VerifyHeaderAtHeight(int64(height), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	state := sm.State{
+		ChainID: s.lc.ChainID(),
+		Version: s.version,
+	}
+
+	// The snapshot height maps onto the state heights as follows:
+	//
+	// height: last block, i.e. the snapshotted height
+	// height+1: current block, i.e. the first block we'll process after the snapshot
+	// height+2: next block, i.e. the second block after the snapshot
+	//
+	// We need to fetch the NextValidators from height+2 because if the application changed
+	// the validator set at the snapshot height then this only takes effect at height+2.
+	lastHeader, err := s.lc.VerifyHeaderAtHeight(int64(height), time.Now())
+	if err != nil {
+		return sm.State{}, err
+	}
+	curHeader, err := s.lc.VerifyHeaderAtHeight(int64(height+1), time.Now())
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextHeader, err := s.lc.VerifyHeaderAtHeight(int64(height+2), time.Now())
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	state.Version = sm.Version{
+		Consensus: curHeader.Version,
+		Software:  version.TMCoreSemVer,
+	}
+	state.LastBlockHeight = lastHeader.Height
+	state.LastBlockTime = lastHeader.Time
+	state.LastBlockID = lastHeader.Commit.BlockID
+	state.AppHash = curHeader.AppHash
+	state.LastResultsHash = curHeader.LastResultsHash
+
+	if state.LastValidators, _, err = s.lc.TrustedValidatorSet(lastHeader.Height); err != nil {
+		return sm.State{}, err
+	}
+	if state.Validators, _, err = s.lc.TrustedValidatorSet(curHeader.Height); err != nil {
+		return sm.State{}, err
+	}
+	if state.NextValidators, _, err = s.lc.TrustedValidatorSet(nextHeader.Height); err != nil {
+		return sm.State{}, err
+	}
+	state.LastHeightValidatorsChanged = nextHeader.Height
+
+	// We'll also need to fetch consensus params via RPC, using light client verification.
+	primaryURL, ok := s.providers[s.lc.Primary()]
+	if !ok || primaryURL == "" {
+		return sm.State{}, fmt.Errorf("could not find address for primary light client provider")
+	}
+	primaryRPC, err := rpcClient(primaryURL)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
+	}
+	rpcclient := literpc.NewClient(primaryRPC, s.lc)
+	result, err := rpcclient.ConsensusParams(&nextHeader.Height)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
+			nextHeader.Height, err)
+	}
+	state.ConsensusParams = result.ConsensusParams
+	state.LastHeightConsensusParamsChanged = curHeader.Height
+
+	return state, nil
+}
+
+// rpcClient sets up a new RPC client
+func rpcClient(server string) (*rpchttp.HTTP, error) {
+	if !strings.Contains(server, "://") {
+		server = "http://" + server
+	}
+	c, err := rpchttp.New(server, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -460,6 +460,15 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	batch.WriteSync()
 }
 
+// SaveSeenCommit saves a seen commit, used by e.g. the state sync reactor when bootstrapping node.
+func (bs *BlockStore) SaveSeenCommit(height int64, seenCommit *types.Commit) error {
+	seenCommitBytes, err := cdc.MarshalBinaryBare(seenCommit)
+	if err != nil {
+		return err
+	}
+	return bs.db.Set(calcSeenCommitKey(height), seenCommitBytes)
+}
+
 func (bs *BlockStore) saveBlockPart(batch db.Batch, height int64, index int, part *types.Part) {
 	partBytes := cdc.MustMarshalBinaryBare(part)
 	batch.Set(calcBlockPartKey(height, index), partBytes)
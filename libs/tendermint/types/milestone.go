@@ -15,6 +15,7 @@ var (
 	milestoneVenus4Height  int64
 	milestoneMercuryHeight int64
 	milestoneVenus7Height  int64
+	milestoneVenus8Height  int64
 
 	// note: it stores the earlies height of the node,and it is used by cli
 	nodePruneHeight int64
@@ -29,6 +30,7 @@ const (
 	MILESTONE_MERCURY = "mercury"
 
 	MILESTONE_VENUS7_NAME = "venus7"
+	MILESTONE_VENUS8_NAME = "venus8"
 )
 
 func SetupMainNetEnvironment(pruneH int64) {
@@ -162,3 +164,27 @@ func GetVenus7Height() int64 {
 
 // =========== Venus7 ===============
 // ==================================
+
+// ==================================
+// =========== Venus8 ===============
+func HigherThanVenus8(h int64) bool {
+	if milestoneVenus8Height == 0 {
+		return false
+	}
+	return h > milestoneVenus8Height
+}
+
+func UnittestOnlySetMilestoneVenus8Height(h int64) {
+	milestoneVenus8Height = h
+}
+
+func InitMilestoneVenus8Height(h int64) {
+	milestoneVenus8Height = h
+}
+
+func GetVenus8Height() int64 {
+	return milestoneVenus8Height
+}
+
+// =========== Venus8 ===============
+// ==================================
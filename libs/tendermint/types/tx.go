@@ -54,6 +54,10 @@ func (tx Tx) Hash() []byte {
 	//		return etherhash.Sum(tx)
 	//	}
 	//}
+	// the hash of a typed eth tx only depends on its bytes, as the one of a legacy eth tx does
+	if isTypedEthTx(tx) {
+		return etherhash.Sum(tx)
+	}
 	var msg ethTxData
 	if err := rlp.DecodeBytes(tx, &msg); err != nil {
 		return tmhash.Sum(tx)
@@ -61,6 +65,16 @@ func (tx Tx) Hash() []byte {
 	return etherhash.Sum(tx)
 }
 
+// isTypedEthTx reports whether tx is an EIP-2718 typed eth tx envelope,
+// which is the access list (0x01) or dynamic fee (0x02) type followed by an rlp list.
+func isTypedEthTx(tx Tx) bool {
+	if len(tx) < 2 || (tx[0] != 0x01 && tx[0] != 0x02) {
+		return false
+	}
+	kind, _, rest, err := rlp.Split(tx[1:])
+	return err == nil && kind == rlp.List && len(rest) == 0
+}
+
 // String returns the hex-encoded transaction as a string.
 func (tx Tx) String() string {
 	return fmt.Sprintf("Tx{%X}", []byte(tx))
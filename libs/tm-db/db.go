@@ -3,6 +3,7 @@ package db
 import (
 	"bufio"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -36,11 +37,16 @@ const (
 	//   - requires gcc
 	//   - use rocksdb build tag (go build -tags rocksdb)
 	RocksDBBackend BackendType = "rocksdb"
+	// PebbleDBBackend represents pebble (github.com/cockroachdb/pebble)
+	//   - pure go
+	//   - EXPERIMENTAL
+	PebbleDBBackend BackendType = "pebbledb"
 	// UnknownDBBackend unknown db type
 	UnknownDBBackend BackendType = "unknown"
 
 	FlagGoLeveldbOpts = "goleveldb.opts"
 	FlagRocksdbOpts   = "rocksdb.opts"
+	FlagPebbledbOpts  = "pebbledb.opts"
 )
 
 type dbCreator func(name string, dir string) (DB, error)
@@ -87,12 +93,16 @@ func NewDB(name string, backend BackendType, dir string) DB {
 }
 
 // checkDBType check whether the db file is goleveldb or rocksdb,
-// only goleveldb and rocksdb are supported, otherwise it returns unknown.
+// only goleveldb, rocksdb and pebbledb are supported, otherwise it returns unknown.
 // Ignore artificial changes to db files
 func checkDBType(name string, dir string) BackendType {
 	logPath := filepath.Join(dir, name+".db", "LOG")
 	file, err := os.Open(logPath)
 	if err != nil {
+		// pebbledb writes no LOG file
+		if isPebbleDB(filepath.Join(dir, name+".db")) {
+			return PebbleDBBackend
+		}
 		return UnknownDBBackend
 	}
 	defer file.Close()
@@ -120,3 +130,16 @@ func checkDBType(name string, dir string) BackendType {
 
 	return UnknownDBBackend
 }
+
+// isPebbleDB checks whether the OPTIONS file of the db in dbPath is written by pebble
+func isPebbleDB(dbPath string) bool {
+	optionsPaths, err := filepath.Glob(filepath.Join(dbPath, "OPTIONS-*"))
+	if err != nil || len(optionsPaths) == 0 {
+		return false
+	}
+	bz, err := ioutil.ReadFile(optionsPaths[0])
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(bz), "pebble_version")
+}
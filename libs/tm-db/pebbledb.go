@@ -0,0 +1,213 @@
+package db
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/spf13/viper"
+)
+
+const (
+	pebbleDBCacheSize    = "cache_size"
+	pebbleDBMaxOpenFiles = "max_open_files"
+
+	defaultPebbleDBCacheSize    = 128 << 20
+	defaultPebbleDBMaxOpenFiles = 1024
+
+	pebbleDBNumLevels = 7
+)
+
+func init() {
+	dbCreator := func(name string, dir string) (DB, error) {
+		return NewPebbleDB(name, dir)
+	}
+	registerDBCreator(PebbleDBBackend, dbCreator, false)
+}
+
+// PebbleDB is a PebbleDB backend.
+type PebbleDB struct {
+	db *pebble.DB
+}
+
+var _ DB = (*PebbleDB)(nil)
+
+func NewPebbleDB(name string, dir string) (*PebbleDB, error) {
+	params := parseOptParams(viper.GetString(FlagPebbledbOpts))
+
+	cacheSize := int64(defaultPebbleDBCacheSize)
+	if v, ok := params[pebbleDBCacheSize]; ok {
+		value, err := toBytes(v)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid options parameter %s: %s", pebbleDBCacheSize, err))
+		}
+		cacheSize = int64(value)
+	}
+
+	maxOpenFiles := defaultPebbleDBMaxOpenFiles
+	if v, ok := params[pebbleDBMaxOpenFiles]; ok {
+		value, err := strconv.Atoi(v)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid options parameter %s: %s", pebbleDBMaxOpenFiles, err))
+		}
+		maxOpenFiles = value
+	}
+
+	cache := pebble.NewCache(cacheSize)
+	defer cache.Unref()
+	opts := &pebble.Options{
+		Cache:        cache,
+		MaxOpenFiles: maxOpenFiles,
+		Levels:       make([]pebble.LevelOptions, pebbleDBNumLevels),
+	}
+	// the other options are defaulted by pebble on opening
+	for i := range opts.Levels {
+		opts.Levels[i].FilterPolicy = bloom.FilterPolicy(10)
+	}
+	return NewPebbleDBWithOpts(name, dir, opts)
+}
+
+func NewPebbleDBWithOpts(name string, dir string, opts *pebble.Options) (*PebbleDB, error) {
+	dbPath := filepath.Join(dir, name+".db")
+	db, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+// Get implements DB.
+func (db *PebbleDB) Get(key []byte) ([]byte, error) {
+	v, err := db.GetUnsafeValue(key, func(value []byte) (interface{}, error) {
+		if value == nil {
+			return []byte(nil), nil
+		}
+		res := make([]byte, len(value))
+		copy(res, value)
+		return res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+func (db *PebbleDB) GetUnsafeValue(key []byte, processor UnsafeValueProcessor) (interface{}, error) {
+	key = nonNilBytes(key)
+	res, closer, err := db.db.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return processor(nil)
+		}
+		return nil, err
+	}
+	defer closer.Close()
+	// an empty value is still an existing value
+	return processor(nonNilBytes(res))
+}
+
+// Has implements DB.
+func (db *PebbleDB) Has(key []byte) (bool, error) {
+	v, err := db.GetUnsafeValue(key, func(value []byte) (interface{}, error) {
+		return value != nil, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+// Set implements DB.
+func (db *PebbleDB) Set(key []byte, value []byte) error {
+	key = nonNilBytes(key)
+	value = nonNilBytes(value)
+	return db.db.Set(key, value, pebble.NoSync)
+}
+
+// SetSync implements DB.
+func (db *PebbleDB) SetSync(key []byte, value []byte) error {
+	key = nonNilBytes(key)
+	value = nonNilBytes(value)
+	return db.db.Set(key, value, pebble.Sync)
+}
+
+// Delete implements DB.
+func (db *PebbleDB) Delete(key []byte) error {
+	key = nonNilBytes(key)
+	return db.db.Delete(key, pebble.NoSync)
+}
+
+// DeleteSync implements DB.
+func (db *PebbleDB) DeleteSync(key []byte) error {
+	key = nonNilBytes(key)
+	return db.db.Delete(key, pebble.Sync)
+}
+
+func (db *PebbleDB) DB() *pebble.DB {
+	return db.db
+}
+
+// Close implements DB.
+func (db *PebbleDB) Close() error {
+	return db.db.Close()
+}
+
+// Print implements DB.
+func (db *PebbleDB) Print() error {
+	fmt.Printf("%v\n", db.db.Metrics())
+
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()
+		value := itr.Value()
+		fmt.Printf("[%X]:\t[%X]\n", key, value)
+	}
+	return nil
+}
+
+// Stats implements DB.
+func (db *PebbleDB) Stats() map[string]string {
+	return map[string]string{
+		"pebble.metrics": db.db.Metrics().String(),
+	}
+}
+
+// NewBatch implements DB.
+func (db *PebbleDB) NewBatch() Batch {
+	return NewPebbleDBBatch(db)
+}
+
+// Iterator implements DB.
+func (db *PebbleDB) Iterator(start, end []byte) (Iterator, error) {
+	itr := db.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return NewPebbleDBIterator(itr, start, end, false), nil
+}
+
+// ReverseIterator implements DB.
+func (db *PebbleDB) ReverseIterator(start, end []byte) (Iterator, error) {
+	itr := db.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return NewPebbleDBIterator(itr, start, end, true), nil
+}
+
+// Compact compacts the whole key range of the database
+func (db *PebbleDB) Compact() error {
+	itr := db.db.NewIter(nil)
+	defer itr.Close()
+
+	if !itr.First() {
+		return itr.Error()
+	}
+	first := cp(itr.Key())
+	if !itr.Last() {
+		return itr.Error()
+	}
+	// the end of the range is exclusive, so compact up to the successor of the last key
+	last := append(cp(itr.Key()), 0)
+	return db.db.Compact(first, last, true)
+}
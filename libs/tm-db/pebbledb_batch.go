@@ -0,0 +1,78 @@
+package db
+
+import "github.com/cockroachdb/pebble"
+
+type PebbleDBBatch struct {
+	db    *PebbleDB
+	batch *pebble.Batch
+}
+
+var _ Batch = (*PebbleDBBatch)(nil)
+
+func NewPebbleDBBatch(db *PebbleDB) *PebbleDBBatch {
+	return &PebbleDBBatch{
+		db:    db,
+		batch: db.db.NewBatch(),
+	}
+}
+
+func (b *PebbleDBBatch) assertOpen() {
+	if b.batch == nil {
+		panic("batch has been written or closed")
+	}
+}
+
+// Set implements Batch.
+func (b *PebbleDBBatch) Set(key, value []byte) {
+	b.assertOpen()
+	if err := b.batch.Set(nonNilBytes(key), nonNilBytes(value), nil); err != nil {
+		panic(err)
+	}
+}
+
+// Delete implements Batch.
+func (b *PebbleDBBatch) Delete(key []byte) {
+	b.assertOpen()
+	if err := b.batch.Delete(nonNilBytes(key), nil); err != nil {
+		panic(err)
+	}
+}
+
+// Write implements Batch.
+func (b *PebbleDBBatch) Write() error {
+	return b.write(pebble.NoSync)
+}
+
+// WriteSync implements Batch.
+func (b *PebbleDBBatch) WriteSync() error {
+	return b.write(pebble.Sync)
+}
+
+func (b *PebbleDBBatch) write(opts *pebble.WriteOptions) error {
+	b.assertOpen()
+	if err := b.batch.Commit(opts); err != nil {
+		return err
+	}
+	// Make sure batch cannot be used afterwards. Callers should still call Close(), for errors.
+	b.Close()
+	return nil
+}
+
+// Close implements Batch.
+// Close may be called multiple times.
+func (b *PebbleDBBatch) Close() {
+	if b.batch != nil {
+		_ = b.batch.Close()
+		b.batch = nil
+	}
+}
+
+func (b *PebbleDBBatch) Size() int {
+	b.assertOpen()
+	return int(b.batch.Count())
+}
+
+func (b *PebbleDBBatch) Reset() {
+	b.assertOpen()
+	b.batch.Reset()
+}
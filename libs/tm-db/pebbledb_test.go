@@ -0,0 +1,47 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPebbleDBNewPebbleDB(t *testing.T) {
+	name := fmt.Sprintf("test_%x", randStr(12))
+	defer cleanupDBDir("", name)
+
+	// Test we can't open the db twice for writing
+	wr1, err := NewPebbleDB(name, "")
+	require.Nil(t, err)
+	_, err = NewPebbleDB(name, "")
+	require.NotNil(t, err)
+
+	require.Nil(t, wr1.Set([]byte("key"), []byte("value")))
+	require.Nil(t, wr1.Compact())
+	wr1.Close() // Close the db to release the lock
+
+	// Test the backend of an existing db is detected
+	require.Equal(t, PebbleDBBackend, checkDBType(name, ""))
+	require.Panics(t, func() { NewDB(name, GoLevelDBBackend, "") })
+
+	db := NewDB(name, PebbleDBBackend, "")
+	defer db.Close()
+	value, err := db.Get([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
+func BenchmarkPebbleDBRandomReadsWrites(b *testing.B) {
+	name := fmt.Sprintf("test_%x", randStr(12))
+	db, err := NewPebbleDB(name, "")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		db.Close()
+		cleanupDBDir("", name)
+	}()
+
+	benchmarkRandomReadsWrites(b, db)
+}
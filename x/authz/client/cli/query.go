@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryGrants(queryRoute, cdc),
+		GetCmdQueryGranterGrants(queryRoute, cdc),
+		GetCmdQueryGranteeGrants(queryRoute, cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryGrants implements a command to return the grants given by a
+// granter to a grantee
+func GetCmdQueryGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [granter] [grantee] [msg-type]",
+		Short: "Query the grants given by a granter to a grantee, optionally for a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unexpired grants given by a granter to a grantee, optionally for a msg type.
+
+Example:
+$ %s query %s grants ex1... ex1... token/send
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid granter address %w", err)
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+			var msgType string
+			if len(args) == 3 {
+				msgType = args[2]
+			}
+
+			params := types.NewQueryGrantsParams(granter, grantee, msgType)
+			return queryGrants(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGrants), params)
+		},
+	}
+}
+
+// GetCmdQueryGranterGrants implements a command to return the grants given by
+// a granter
+func GetCmdQueryGranterGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants-by-granter [granter]",
+		Short: "Query the grants given by a granter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid granter address %w", err)
+			}
+
+			params := types.NewQueryAccountGrantsParams(granter)
+			return queryGrants(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGranterGrants), params)
+		},
+	}
+}
+
+// GetCmdQueryGranteeGrants implements a command to return the grants given to
+// a grantee
+func GetCmdQueryGranteeGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants-by-grantee [grantee]",
+		Short: "Query the grants given to a grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			params := types.NewQueryAccountGrantsParams(grantee)
+			return queryGrants(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGranteeGrants), params)
+		},
+	}
+}
+
+func queryGrants(cliCtx context.CLIContext, cdc *codec.Codec, route string, params interface{}) error {
+	data, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	bz, _, err := cliCtx.QueryWithData(route, data)
+	if err != nil {
+		return err
+	}
+
+	var grants []types.GrantAuthorization
+	cdc.MustUnmarshalJSON(bz, &grants)
+	return cliCtx.PrintOutput(grants)
+}
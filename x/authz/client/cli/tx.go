@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/x/authz/types"
+)
+
+const (
+	flagMsgType    = "msg-type"
+	flagSpendLimit = "spend-limit"
+	flagExpiration = "expiration"
+
+	authorizationGeneric = "generic"
+	authorizationSend    = "send"
+)
+
+// GetTxCmd returns a root CLI command handler for certain modules/authz
+// transaction commands.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "authz subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.PostCommands(
+		GetCmdGrant(cdc),
+		GetCmdRevoke(cdc),
+		GetCmdExec(cdc),
+	)...)
+	return cmd
+}
+
+// GetCmdGrant returns a CLI command handler for granting an authorization to
+// a grantee
+func GetCmdGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [generic|send]",
+		Short: "Grant an authorization to the grantee to execute msgs on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an authorization to the grantee to execute msgs on your behalf.
+A generic authorization allows any msg of the type given by --%s, as "<route>/<type>".
+A send authorization allows token transfers up to the limit given by --%s.
+
+Example:
+$ %s tx %s grant ex1... generic --%s distribution/withdraw_delegator_reward --from mykey
+$ %s tx %s grant ex1... send --%s 100%s --%s 2025-01-01T00:00:00Z --from mykey
+`,
+				flagMsgType, flagSpendLimit,
+				version.ClientName, types.ModuleName, flagMsgType,
+				version.ClientName, types.ModuleName, flagSpendLimit, sdk.DefaultBondDenom, flagExpiration,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case authorizationGeneric:
+				authorization = types.NewGenericAuthorization(viper.GetString(flagMsgType))
+			case authorizationSend:
+				spendLimit, err := sdk.ParseDecCoins(viper.GetString(flagSpendLimit))
+				if err != nil {
+					return fmt.Errorf("invalid spend limit %w", err)
+				}
+				authorization = types.NewSendAuthorization(spendLimit)
+			default:
+				return fmt.Errorf("invalid authorization type %q, expected %s or %s", args[1], authorizationGeneric, authorizationSend)
+			}
+
+			var expiration time.Time
+			if expirationStr := viper.GetString(flagExpiration); expirationStr != "" {
+				if expiration, err = time.Parse(time.RFC3339, expirationStr); err != nil {
+					return fmt.Errorf("invalid expiration %w", err)
+				}
+			}
+
+			msg := types.NewMsgGrant(cliCtx.GetFromAddress(), grantee, types.NewGrant(authorization, expiration))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagMsgType, "", "The msg type of a generic authorization, as <route>/<type>")
+	cmd.Flags().String(flagSpendLimit, "", "The spend limit of a send authorization")
+	cmd.Flags().String(flagExpiration, "", "The expiration time of the authorization, in RFC3339 format. Never expires if empty")
+	return cmd
+}
+
+// GetCmdRevoke returns a CLI command handler for revoking the authorization of
+// a grantee
+func GetCmdRevoke(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke the authorization of the grantee for a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization of the grantee for a msg type, as "<route>/<type>".
+
+Example:
+$ %s tx %s revoke ex1... token/send --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			msg := types.NewMsgRevoke(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdExec returns a CLI command handler for executing the msgs of a tx on
+// behalf of their signers
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the msgs of a tx on behalf of their signers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the msgs of a tx generated with --generate-only on behalf of their signers,
+who must have granted you an authorization for each of them.
+
+Example:
+$ %s tx token send granter-key ex1... 10%s --generate-only > tx.json
+$ %s tx %s exec tx.json --from grantee-key
+`,
+				version.ClientName, sdk.DefaultBondDenom,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
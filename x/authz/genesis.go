@@ -0,0 +1,26 @@
+package authz
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+
+	"github.com/okx/okbchain/x/authz/keeper"
+	"github.com/okx/okbchain/x/authz/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, auth := range data.Authorizations {
+		// expired grants are dropped
+		if auth.Grant().IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		if err := k.SaveGrant(ctx, auth.Grantee, auth.Granter, auth.Authorization, auth.Expiration); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetGrantAuthorizations(ctx, types.GrantKey, nil))
+}
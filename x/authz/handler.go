@@ -0,0 +1,75 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/authz/keeper"
+	"github.com/okx/okbchain/x/authz/types"
+	"github.com/okx/okbchain/x/authz/typesadapter"
+)
+
+// NewHandler defines the authz module handler instance. It handles both the
+// amino msgs and their protobuf counterparts.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+			errMsg := fmt.Sprintf("authz is not supported at height %d", ctx.BlockHeight())
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgGrant:
+			return handleMsgGrant(ctx, msg, k)
+		case types.MsgRevoke:
+			return handleMsgRevoke(ctx, msg, k)
+		case types.MsgExec:
+			return handleMsgExec(ctx, msg, k)
+		case *typesadapter.MsgGrant:
+			res, err := msgServer.Grant(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *typesadapter.MsgRevoke:
+			res, err := msgServer.Revoke(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *typesadapter.MsgExec:
+			res, err := msgServer.Exec(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+// handleMsgGrant grants an authorization to the grantee
+func handleMsgGrant(ctx sdk.Context, msg types.MsgGrant, k keeper.Keeper) (*sdk.Result, error) {
+	err := k.SaveGrant(ctx, msg.Grantee, msg.Granter, msg.Grant.Authorization, msg.Grant.Expiration)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRevoke revokes the authorization of the grantee for a msg type
+func handleMsgRevoke(ctx sdk.Context, msg types.MsgRevoke, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.DeleteGrant(ctx, msg.Grantee, msg.Granter, msg.MsgType); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgExec executes the msgs on behalf of their signers
+func handleMsgExec(ctx sdk.Context, msg types.MsgExec, k keeper.Keeper) (*sdk.Result, error) {
+	results, err := k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Data:   types.ModuleCdc.MustMarshalBinaryLengthPrefixed(results),
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
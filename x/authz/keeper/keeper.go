@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	"github.com/okx/okbchain/x/authz/types"
+)
+
+// Keeper of the authz module stores the grants given by granters to grantees,
+// and executes msgs on behalf of the granters.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	router   sdk.Router
+}
+
+// NewKeeper creates new instances of the authz Keeper. The msgs executed on
+// behalf of the granters are dispatched by router.
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SaveGrant grants authorization to grantee on granter's account until
+// expiration, or without expiry if expiration is zero. It replaces any grant
+// for the same msg type.
+func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	if !expiration.IsZero() && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiration %s, block time %s", expiration, ctx.BlockTime())
+	}
+
+	msgType := authorization.MsgType()
+	k.setGrant(ctx, granter, grantee, msgType, types.NewGrant(authorization, expiration))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrant,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+	))
+	return nil
+}
+
+// DeleteGrant revokes the authorization for msgType granted to grantee by
+// granter.
+func (k Keeper) DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	if _, found := k.GetGrant(ctx, granter, grantee, msgType); !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, msg type %s", granter, grantee, msgType)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetGrantKey(granter, grantee, msgType))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevoke,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+	))
+	return nil
+}
+
+// GetGrant returns the grant for msgType given to grantee by granter, expired
+// or not.
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) (grant types.Grant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGrantKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string, grant types.Grant) {
+	ctx.KVStore(k.storeKey).Set(types.GetGrantKey(granter, grantee, msgType), k.cdc.MustMarshalBinaryBare(grant))
+}
+
+// IterateGrants iterates over the grants with the given key prefix, until cb
+// returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, prefix []byte, cb func(granter, grantee sdk.AccAddress, grant types.Grant) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		granter, grantee, _ := types.ParseGrantKey(iterator.Key())
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+}
+
+// GetGrantAuthorizations returns the unexpired grants with the given key
+// prefix, filtered by filter if it is not nil.
+func (k Keeper) GetGrantAuthorizations(ctx sdk.Context, prefix []byte, filter func(granter, grantee sdk.AccAddress) bool) []types.GrantAuthorization {
+	auths := []types.GrantAuthorization{}
+	k.IterateGrants(ctx, prefix, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		if !grant.IsExpired(ctx.BlockTime()) && (filter == nil || filter(granter, grantee)) {
+			auths = append(auths, types.NewGrantAuthorization(granter, grantee, grant))
+		}
+		return false
+	})
+	return auths
+}
+
+// DispatchActions executes msgs on behalf of their signers. The signer of a
+// msg must be the grantee, or have granted the grantee an authorization for
+// its msg type, which is updated or deleted as the authorization requires.
+// The msgs are routed by the baseapp router, as the msgs of a tx are. It
+// returns the data of the results of the msgs.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg %s must have exactly one signer", types.MsgType(msg))
+		}
+
+		granter := signers[0]
+		// no need for an authorization if the grantee signs for itself
+		if !granter.Equals(grantee) {
+			if err := k.acceptMsg(ctx, granter, grantee, msg); err != nil {
+				return nil, err
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		// the handler resets the event manager, so the events must be
+		// propagated back to the current context
+		ctx.EventManager().EmitEvents(res.Events)
+		results[i] = res.Data
+	}
+	return results, nil
+}
+
+// acceptMsg checks that granter has granted grantee an unexpired authorization
+// which accepts msg, and updates it.
+func (k Keeper) acceptMsg(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgType(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, msg type %s", granter, grantee, msgType)
+	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrAuthorizationExpired, "granter %s, grantee %s, msg type %s", granter, grantee, msgType)
+	}
+
+	resp, err := grant.Authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if !resp.Accept {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization of %s rejected the msg", msgType)
+	}
+
+	if resp.Delete {
+		if err = k.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return err
+		}
+	} else if resp.Updated != nil {
+		grant.Authorization = resp.Updated
+		k.setGrant(ctx, granter, grantee, msgType, grant)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExec,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+	))
+	return nil
+}
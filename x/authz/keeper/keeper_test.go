@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/okx/okbchain/app"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/authz"
+	"github.com/okx/okbchain/x/authz/keeper"
+	"github.com/okx/okbchain/x/authz/types"
+	tokentypes "github.com/okx/okbchain/x/token/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	granter   = sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	grantee   = sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	recipient = sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+)
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.OKBChainApp
+
+	querier sdk.Querier
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+
+	suite.app = app.Setup(checkTx)
+	suite.ctx = suite.app.NewContext(checkTx, abci.Header{
+		Height:  2,
+		ChainID: "ethermint-3",
+		Time:    time.Now().UTC(),
+	})
+	suite.querier = keeper.NewQuerier(suite.app.AuthzKeeper)
+
+	err := suite.app.BankKeeper.SetCoins(suite.ctx, granter, okb(100))
+	suite.Require().NoError(err)
+}
+
+func okb(amount int64) sdk.SysCoins {
+	return sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(amount))
+}
+
+func (suite *KeeperTestSuite) TestSaveAndDeleteGrant() {
+	k := suite.app.AuthzKeeper
+	msgType := types.SendMsgType
+
+	past := suite.ctx.BlockTime().Add(-time.Hour)
+	err := k.SaveGrant(suite.ctx, grantee, granter, types.NewGenericAuthorization(msgType), past)
+	suite.Require().Error(err)
+
+	future := suite.ctx.BlockTime().Add(time.Hour)
+	err = k.SaveGrant(suite.ctx, grantee, granter, types.NewGenericAuthorization(msgType), future)
+	suite.Require().NoError(err)
+
+	grant, found := k.GetGrant(suite.ctx, granter, grantee, msgType)
+	suite.Require().True(found)
+	suite.Require().Equal(msgType, grant.Authorization.MsgType())
+	suite.Require().True(future.Equal(grant.Expiration))
+
+	suite.Require().NoError(k.DeleteGrant(suite.ctx, grantee, granter, msgType))
+	_, found = k.GetGrant(suite.ctx, granter, grantee, msgType)
+	suite.Require().False(found)
+
+	err = k.DeleteGrant(suite.ctx, grantee, granter, msgType)
+	suite.Require().True(types.ErrNoAuthorizationFound.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestDispatchActionsSendAuthorization() {
+	k := suite.app.AuthzKeeper
+
+	msg := tokentypes.NewMsgTokenSend(granter, recipient, okb(4))
+	_, err := k.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().True(types.ErrNoAuthorizationFound.Is(err))
+
+	err = k.SaveGrant(suite.ctx, grantee, granter, types.NewSendAuthorization(okb(10)), time.Time{})
+	suite.Require().NoError(err)
+
+	_, err = k.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+	suite.Require().Equal(okb(96), suite.app.BankKeeper.GetCoins(suite.ctx, granter))
+	suite.Require().Equal(okb(4), suite.app.BankKeeper.GetCoins(suite.ctx, recipient))
+
+	grant, found := k.GetGrant(suite.ctx, granter, grantee, types.SendMsgType)
+	suite.Require().True(found)
+	suite.Require().Equal(okb(6), grant.Authorization.(types.SendAuthorization).SpendLimit)
+
+	// exceeding the remaining spend limit is rejected
+	_, err = k.DispatchActions(suite.ctx, grantee, []sdk.Msg{tokentypes.NewMsgTokenSend(granter, recipient, okb(7))})
+	suite.Require().True(types.ErrSpendLimitExceeded.Is(err))
+
+	// spending the whole remaining limit removes the grant
+	_, err = k.DispatchActions(suite.ctx, grantee, []sdk.Msg{tokentypes.NewMsgTokenSend(granter, recipient, okb(6))})
+	suite.Require().NoError(err)
+	_, found = k.GetGrant(suite.ctx, granter, grantee, types.SendMsgType)
+	suite.Require().False(found)
+	suite.Require().Equal(okb(10), suite.app.BankKeeper.GetCoins(suite.ctx, recipient))
+}
+
+func (suite *KeeperTestSuite) TestDispatchActionsExpiredGrant() {
+	k := suite.app.AuthzKeeper
+
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	err := k.SaveGrant(suite.ctx, grantee, granter, types.NewGenericAuthorization(types.SendMsgType), expiration)
+	suite.Require().NoError(err)
+
+	msg := tokentypes.NewMsgTokenSend(granter, recipient, okb(1))
+	_, err = k.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithBlockTime(expiration.Add(time.Second))
+	_, err = k.DispatchActions(ctx, grantee, []sdk.Msg{msg})
+	suite.Require().True(types.ErrAuthorizationExpired.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestDispatchActionsOwnMsg() {
+	err := suite.app.BankKeeper.SetCoins(suite.ctx, grantee, okb(5))
+	suite.Require().NoError(err)
+
+	// the grantee signing its own msg needs no authorization
+	msg := tokentypes.NewMsgTokenSend(grantee, recipient, okb(5))
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+	suite.Require().Equal(okb(5), suite.app.BankKeeper.GetCoins(suite.ctx, recipient))
+}
+
+func (suite *KeeperTestSuite) TestHandlerBeforeVenus8() {
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	handler := authz.NewHandler(suite.app.AuthzKeeper)
+	msg := types.NewMsgRevoke(granter, grantee, types.SendMsgType)
+
+	// the authz store is not committed before venus8, its msgs are rejected
+	_, err := handler(suite.ctx, msg)
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(1)
+	_, err = handler(suite.ctx, msg)
+	suite.Require().True(types.ErrNoAuthorizationFound.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestCommitFilter() {
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	filter := *authz.NewAppModule(suite.app.AuthzKeeper).CommitFilter()
+
+	// the authz store is not committed until venus8
+	suite.Require().True(filter(types.StoreKey, 10, nil))
+	suite.Require().False(filter("bank", 10, nil))
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(10)
+	suite.Require().True(filter(types.StoreKey, 9, nil))
+	suite.Require().False(filter(types.StoreKey, 10, nil))
+	suite.Require().False(filter(types.StoreKey, 11, nil))
+}
+
+func (suite *KeeperTestSuite) TestQuerier() {
+	k := suite.app.AuthzKeeper
+	err := k.SaveGrant(suite.ctx, grantee, granter, types.NewSendAuthorization(okb(10)), time.Time{})
+	suite.Require().NoError(err)
+
+	cdc := suite.app.Codec()
+	req := abci.RequestQuery{
+		Data: cdc.MustMarshalJSON(types.NewQueryGrantsParams(granter, grantee, types.SendMsgType)),
+	}
+	res, err := suite.querier(suite.ctx, []string{types.QueryGrants}, req)
+	suite.Require().NoError(err)
+
+	var grants []types.GrantAuthorization
+	suite.Require().NoError(cdc.UnmarshalJSON(res, &grants))
+	suite.Require().Len(grants, 1)
+	suite.Require().Equal(granter, grants[0].Granter)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountGrantsParams(grantee))
+	res, err = suite.querier(suite.ctx, []string{types.QueryGranteeGrants}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(cdc.UnmarshalJSON(res, &grants))
+	suite.Require().Len(grants, 1)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryGrantsParams(granter, grantee, types.MsgType(types.MsgRevoke{})))
+	_, err = suite.querier(suite.ctx, []string{types.QueryGrants}, req)
+	suite.Require().True(types.ErrNoAuthorizationFound.Is(err))
+
+	_, err = suite.querier(suite.ctx, []string{"unknown"}, req)
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/authz/typesadapter"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the authz MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) typesadapter.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ typesadapter.MsgServer = msgServer{}
+
+// Grant implements the MsgServer.Grant method.
+func (k msgServer) Grant(goCtx context.Context, msg *typesadapter.MsgGrant) (*typesadapter.MsgGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	aminoMsg, err := msg.ToMsgGrant()
+	if err != nil {
+		return nil, err
+	}
+
+	grant := aminoMsg.Grant
+	if err = k.SaveGrant(ctx, aminoMsg.Grantee, aminoMsg.Granter, grant.Authorization, grant.Expiration); err != nil {
+		return nil, err
+	}
+	return &typesadapter.MsgGrantResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method.
+func (k msgServer) Exec(goCtx context.Context, msg *typesadapter.MsgExec) (*typesadapter.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	aminoMsg, err := msg.ToMsgExec()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.DispatchActions(ctx, aminoMsg.Grantee, aminoMsg.Msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &typesadapter.MsgExecResponse{Results: results}, nil
+}
+
+// Revoke implements the MsgServer.Revoke method.
+func (k msgServer) Revoke(goCtx context.Context, msg *typesadapter.MsgRevoke) (*typesadapter.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	aminoMsg, err := msg.ToMsgRevoke()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.DeleteGrant(ctx, aminoMsg.Grantee, aminoMsg.Granter, aminoMsg.MsgType); err != nil {
+		return nil, err
+	}
+	return &typesadapter.MsgRevokeResponse{}, nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/authz/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) < 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"Insufficient parameters, at least 1 parameter is required")
+		}
+
+		switch path[0] {
+		case types.QueryGrants:
+			return queryGrants(ctx, req, keeper)
+		case types.QueryGranterGrants:
+			return queryGranterGrants(ctx, req, keeper)
+		case types.QueryGranteeGrants:
+			return queryGranteeGrants(ctx, req, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
+		}
+	}
+}
+
+// queryGrants returns the unexpired grants given by a granter to a grantee,
+// optionally restricted to a msg type
+func queryGrants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGrantsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Granter.Empty() || params.Grantee.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter or grantee address")
+	}
+
+	var grants []types.GrantAuthorization
+	if params.MsgType != "" {
+		grant, found := k.GetGrant(ctx, params.Granter, params.Grantee, params.MsgType)
+		if !found || grant.IsExpired(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, msg type %s",
+				params.Granter, params.Grantee, params.MsgType)
+		}
+		grants = []types.GrantAuthorization{types.NewGrantAuthorization(params.Granter, params.Grantee, grant)}
+	} else {
+		grants = k.GetGrantAuthorizations(ctx, types.GetGrantPrefix(params.Granter, params.Grantee), nil)
+	}
+	return marshalGrants(k, grants)
+}
+
+// queryGranterGrants returns the unexpired grants given by a granter
+func queryGranterGrants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccountGrantsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	return marshalGrants(k, k.GetGrantAuthorizations(ctx, types.GetGranterPrefix(params.Address), nil))
+}
+
+// queryGranteeGrants returns the unexpired grants given to a grantee. The
+// grants are indexed by granter, so all of them are iterated over.
+func queryGranteeGrants(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccountGrantsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	grants := k.GetGrantAuthorizations(ctx, types.GrantKey, func(_, grantee sdk.AccAddress) bool {
+		return grantee.Equals(params.Address)
+	})
+	return marshalGrants(k, grants)
+}
+
+func marshalGrants(k Keeper, grants []types.GrantAuthorization) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,168 @@
+package authz
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/module"
+	"github.com/okx/okbchain/libs/ibc-go/modules/core/base"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/authz/client/cli"
+	"github.com/okx/okbchain/x/authz/keeper"
+	"github.com/okx/okbchain/x/authz/types"
+	"github.com/okx/okbchain/x/authz/typesadapter"
+)
+
+// type check to ensure the interface is properly implemented
+var (
+	_ module.AppModuleAdapter      = AppModule{}
+	_ module.AppModuleBasicAdapter = AppModuleBasic{}
+)
+
+// AppModuleBasic type for the authz module
+type AppModuleBasic struct{}
+
+// Name returns the authz module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec registers types for module
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// DefaultGenesis is json default structure
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis is the validation check of the Genesis
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	if len(bz) > 0 {
+		var genesisState types.GenesisState
+		err := types.ModuleCdc.UnmarshalJSON(bz, &genesisState)
+		if err != nil {
+			return err
+		}
+
+		return genesisState.Validate()
+	}
+	return nil
+}
+
+// RegisterRESTRoutes Registers rest routes
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {}
+
+// GetQueryCmd Gets the root query command of this module
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(types.QuerierRoute, cdc)
+}
+
+// GetTxCmd returns the root tx command for the authz module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
+}
+
+// RegisterInterfaces registers the protobuf msgs of the authz module, used
+// by the ibc tx signing
+func (AppModuleBasic) RegisterInterfaces(registry interfacetypes.InterfaceRegistry) {
+	typesadapter.RegisterInterfaces(registry)
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(ctx context.CLIContext, mux *runtime.ServeMux) {}
+
+func (AppModuleBasic) GetTxCmdV2(cdc *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) GetQueryCmdV2(cdc *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return nil
+}
+
+func (AppModuleBasic) RegisterRouterForGRPC(cliCtx context.CLIContext, r *mux.Router) {}
+
+// ___________________________________________________________________________
+
+// AppModule implements the AppModule interface for the authz module. Its store
+// is committed from the venus8 upgrade on.
+type AppModule struct {
+	*base.BaseIBCUpgradeModule
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule Object
+func NewAppModule(k keeper.Keeper) AppModule {
+	ret := AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         k,
+	}
+	ret.BaseIBCUpgradeModule = base.NewBaseIBCUpgradeModule(ret)
+	return ret
+}
+
+// Name returns the authz module's name.
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants registers the authz module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+// NewHandler returns the authz module's handler of both amino and protobuf msgs
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// Route returns the authz module's message routing key.
+func (am AppModule) Route() string {
+	return types.RouterKey
+}
+
+// QuerierRoute returns the authz module's query routing key.
+func (am AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// NewQuerierHandler sets up new querier handler for module
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(am.keeper)
+}
+
+// RegisterServices registers the protobuf Msg service of the authz module
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	typesadapter.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+}
+
+// BeginBlock executes all ABCI BeginBlock logic respective to the authz module.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock executes all ABCI EndBlock logic respective to the authz module. It
+// returns no validator updates.
+func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// InitGenesis performs the authz module's genesis initialization. It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+
+	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the authz module's exported genesis state as raw JSON bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.ModuleCdc.MustMarshalJSON(gs)
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	tokentypes "github.com/okx/okbchain/x/token/types"
+)
+
+var (
+	_ Authorization = GenericAuthorization{}
+	_ Authorization = SendAuthorization{}
+)
+
+// SendMsgType is the msg type of the token transfers, signed with amino or
+// protobuf
+var SendMsgType = tokentypes.RouterKey + "/send"
+
+// MsgType returns the type of msg that authorizations are granted for, as
+// "<route>/<type>". The amino and the protobuf encodings of a msg share the
+// same route and type, so a single grant covers both signing modes.
+func MsgType(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
+
+// Authorization represents the permission given by a granter to a grantee to
+// execute a type of msg on the granter's behalf
+type Authorization interface {
+	// MsgType returns the type of msg the authorization is granted for
+	MsgType() string
+
+	// Accept determines whether msg may be executed by the grantee. It can
+	// return an updated authorization to store in place of the current one,
+	// or ask for the current one to be deleted.
+	Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+}
+
+// AcceptResponse is the result of Authorization.Accept
+type AcceptResponse struct {
+	// Accept is true if the msg may be executed
+	Accept bool
+	// Delete is true if the authorization must be deleted once the msg is
+	// executed
+	Delete bool
+	// Updated replaces the authorization once the msg is executed, if not nil
+	Updated Authorization
+}
+
+// GenericAuthorization gives the grantee unrestricted permissions to execute
+// the msg type on behalf of the granter
+type GenericAuthorization struct {
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization instance
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements Authorization
+func (a GenericAuthorization) Accept(_ sdk.Context, _ sdk.Msg) (AcceptResponse, error) {
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a GenericAuthorization) ValidateBasic() error {
+	return ValidateMsgType(a.Msg)
+}
+
+// SendAuthorization allows the grantee to spend up to SpendLimit coins from
+// the granter's account
+type SendAuthorization struct {
+	SpendLimit sdk.SysCoins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization instance
+func NewSendAuthorization(spendLimit sdk.SysCoins) SendAuthorization {
+	return SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization
+func (a SendAuthorization) MsgType() string {
+	return SendMsgType
+}
+
+// Accept implements Authorization. It lowers the spend limit by the amount
+// sent, and deletes the authorization once the limit is used up.
+func (a SendAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	var amount sdk.SysCoins
+	switch msg := msg.(type) {
+	case tokentypes.MsgSend:
+		amount = msg.Amount
+	case walletTokenTransfer:
+		amount = msg.GetAmount()
+	default:
+		return AcceptResponse{}, sdkerrors.Wrapf(ErrInvalidMsgType, "%T is not a token transfer", msg)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(amount)
+	if isNegative {
+		return AcceptResponse{}, sdkerrors.Wrapf(ErrSpendLimitExceeded, "spend limit %s, amount %s", a.SpendLimit, amount)
+	}
+	if limitLeft.IsZero() {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return AcceptResponse{Accept: true, Updated: SendAuthorization{SpendLimit: limitLeft}}, nil
+}
+
+// ValidateBasic implements Authorization
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit %s", a.SpendLimit)
+	}
+	return nil
+}
+
+// walletTokenTransfer is implemented by the protobuf MsgSend
+type walletTokenTransfer interface {
+	GetAmount() []sdk.DecCoin
+}
+
+// ValidateMsgType checks that msgType has the "<route>/<type>" form
+func ValidateMsgType(msgType string) error {
+	parts := strings.SplitN(msgType, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return sdkerrors.Wrapf(ErrInvalidMsgType, "%q, expected <route>/<type>", msgType)
+	}
+	return nil
+}
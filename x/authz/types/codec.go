@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	"github.com/okx/okbchain/libs/system"
+)
+
+// ModuleCdc defines the authz module's codec
+var ModuleCdc = codec.New()
+
+const (
+	// Amino names
+	grantName                = system.Chain + "/authz/MsgGrant"
+	revokeName               = system.Chain + "/authz/MsgRevoke"
+	execName                 = system.Chain + "/authz/MsgExec"
+	genericAuthorizationName = system.Chain + "/authz/GenericAuthorization"
+	sendAuthorizationName    = system.Chain + "/authz/SendAuthorization"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// authz module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgGrant{}, grantName, nil)
+	cdc.RegisterConcrete(MsgRevoke{}, revokeName, nil)
+	cdc.RegisterConcrete(MsgExec{}, execName, nil)
+
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(GenericAuthorization{}, genericAuthorizationName, nil)
+	cdc.RegisterConcrete(SendAuthorization{}, sendAuthorizationName, nil)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+const DefaultCodespace string = ModuleName
+
+// errors
+var (
+	ErrNoAuthorizationFound = sdkerrors.Register(DefaultCodespace, 2, "authorization not found")
+	ErrInvalidExpiration    = sdkerrors.Register(DefaultCodespace, 3, "expiration time of authorization should be more than current time")
+	ErrAuthorizationExpired = sdkerrors.Register(DefaultCodespace, 4, "authorization expired")
+	ErrGranteeIsGranter     = sdkerrors.Register(DefaultCodespace, 5, "grantee and granter should be different")
+	ErrNoMsgs               = sdkerrors.Register(DefaultCodespace, 6, "no msgs to execute")
+	ErrInvalidMsgType       = sdkerrors.Register(DefaultCodespace, 7, "invalid msg type")
+	ErrSpendLimitExceeded   = sdkerrors.Register(DefaultCodespace, 8, "spend limit exceeded")
+)
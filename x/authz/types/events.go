@@ -0,0 +1,12 @@
+package types
+
+// authz events
+const (
+	EventTypeGrant  = "grant"
+	EventTypeRevoke = "revoke"
+	EventTypeExec   = "exec"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+	AttributeKeyMsgType = "msg_type"
+)
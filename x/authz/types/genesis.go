@@ -0,0 +1,34 @@
+package types
+
+// GenesisState defines the authz module's genesis state
+type GenesisState struct {
+	Authorizations []GrantAuthorization `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(authorizations []GrantAuthorization) GenesisState {
+	return GenesisState{
+		Authorizations: authorizations,
+	}
+}
+
+// DefaultGenesisState returns a genesis state without any grant
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorizations: []GrantAuthorization{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, auth := range gs.Authorizations {
+		if err := validateGranterGrantee(auth.Granter, auth.Grantee); err != nil {
+			return err
+		}
+		if err := auth.Grant().ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// Grant gives permissions to execute a type of msg until the expiration time.
+// A zero expiration means the grant never expires.
+type Grant struct {
+	Authorization Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time     `json:"expiration" yaml:"expiration"`
+}
+
+// NewGrant creates a new Grant instance
+func NewGrant(authorization Authorization, expiration time.Time) Grant {
+	return Grant{
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant is expired at blockTime
+func (g Grant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiration.IsZero() && !g.Expiration.After(blockTime)
+}
+
+// ValidateBasic does a simple validation check that
+// doesn't require access to any other information.
+func (g Grant) ValidateBasic() error {
+	if g.Authorization == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "authorization is missing")
+	}
+	return g.Authorization.ValidateBasic()
+}
+
+func (g Grant) String() string {
+	expiration := "never"
+	if !g.Expiration.IsZero() {
+		expiration = g.Expiration.String()
+	}
+	return fmt.Sprintf("Authorization: %s\nExpiration:    %s", g.Authorization.MsgType(), expiration)
+}
+
+// GrantAuthorization is a grant along with its granter and grantee, used in
+// genesis and in query responses
+type GrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization instance
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, grant Grant) GrantAuthorization {
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: grant.Authorization,
+		Expiration:    grant.Expiration,
+	}
+}
+
+// Grant returns the grant of the GrantAuthorization
+func (g GrantAuthorization) Grant() Grant {
+	return NewGrant(g.Authorization, g.Expiration)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "authz"
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+
+	QueryGrants        = "grants"
+	QueryGranterGrants = "granter-grants"
+	QueryGranteeGrants = "grantee-grants"
+)
+
+// KVStore key prefixes
+var (
+	// GrantKey is the prefix of the grants, indexed by granter, grantee and msg type
+	GrantKey = []byte{0x01}
+)
+
+// GetGrantKey returns the KVStore key of the grant given by granter to grantee
+// for msgType: 0x01<granter_len><granter><grantee_len><grantee><msg_type>
+func GetGrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetGrantPrefix(granter, grantee), msgType...)
+}
+
+// GetGrantPrefix returns the KVStore key prefix of all the grants given by
+// granter to grantee.
+func GetGrantPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := GetGranterPrefix(granter)
+	key = append(key, byte(len(grantee)))
+	return append(key, grantee...)
+}
+
+// GetGranterPrefix returns the KVStore key prefix of all the grants given by
+// granter.
+func GetGranterPrefix(granter sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKey)+1+len(granter))
+	key = append(key, GrantKey...)
+	key = append(key, byte(len(granter)))
+	return append(key, granter...)
+}
+
+// ParseGrantKey returns the granter, the grantee and the msg type encoded in
+// a grant key.
+func ParseGrantKey(key []byte) (granter, grantee sdk.AccAddress, msgType string) {
+	key = key[len(GrantKey):]
+	granterLen := int(key[0])
+	granter, key = key[1:1+granterLen], key[1+granterLen:]
+	granteeLen := int(key[0])
+	grantee, key = key[1:1+granteeLen], key[1+granteeLen:]
+	return granter, grantee, string(key)
+}
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// authz msg types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_ sdk.Msg = MsgGrant{}
+	_ sdk.Msg = MsgRevoke{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgGrant grants the grantee an authorization to execute a type of msg on
+// behalf of the granter
+type MsgGrant struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Grant   Grant          `json:"grant" yaml:"grant"`
+}
+
+// NewMsgGrant creates a new MsgGrant instance
+func NewMsgGrant(granter, grantee sdk.AccAddress, grant Grant) MsgGrant {
+	return MsgGrant{
+		Granter: granter,
+		Grantee: grantee,
+		Grant:   grant,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGrant) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevoke revokes the authorization given by the granter to the grantee for
+// a type of msg
+type MsgRevoke struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgType string) MsgRevoke {
+	return MsgRevoke{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRevoke) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	return ValidateMsgType(msg.MsgType)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes msgs on behalf of their signers, which must have granted
+// the grantee an authorization for each of them
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgExec creates a new MsgExec instance
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgExec) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrNoMsgs
+	}
+	for _, m := range msg.Msgs {
+		if len(m.GetSigners()) != 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg %s must have exactly one signer", MsgType(m))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The executed msgs are signed with their own
+// sign bytes, as the msgs of a StdTx are.
+func (msg MsgExec) GetSignBytes() []byte {
+	msgsBytes := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(execSignDoc{
+		Grantee: msg.Grantee,
+		Msgs:    msgsBytes,
+	}))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+type execSignDoc struct {
+	Grantee sdk.AccAddress    `json:"grantee"`
+	Msgs    []json.RawMessage `json:"msgs"`
+}
+
+func validateGranterGrantee(granter, grantee sdk.AccAddress) error {
+	if granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if granter.Equals(grantee) {
+		return ErrGranteeIsGranter
+	}
+	return nil
+}
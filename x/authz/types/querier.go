@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+// QueryGrantsParams defines the params of the grants query. The msg type is
+// optional.
+type QueryGrantsParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+}
+
+// NewQueryGrantsParams creates a new QueryGrantsParams instance
+func NewQueryGrantsParams(granter, grantee sdk.AccAddress, msgType string) QueryGrantsParams {
+	return QueryGrantsParams{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// QueryAccountGrantsParams defines the params of the queries of the grants
+// given by a granter or to a grantee
+type QueryAccountGrantsParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewQueryAccountGrantsParams creates a new QueryAccountGrantsParams instance
+func NewQueryAccountGrantsParams(address sdk.AccAddress) QueryAccountGrantsParams {
+	return QueryAccountGrantsParams{Address: address}
+}
@@ -0,0 +1,38 @@
+package typesadapter
+
+import (
+	interfacetypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types"
+	txmsg "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the protobuf msgs and authorizations of the
+// authz module
+func RegisterInterfaces(registry interfacetypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*txmsg.Msg)(nil),
+		&MsgGrant{},
+		&MsgExec{},
+		&MsgRevoke{},
+	)
+	registry.RegisterImplementations(
+		(*types.MsgProtoAdapter)(nil),
+		&MsgGrant{},
+		&MsgExec{},
+		&MsgRevoke{},
+	)
+	registry.RegisterImplementations(
+		(*types.Msg)(nil),
+		&MsgGrant{},
+		&MsgExec{},
+		&MsgRevoke{},
+	)
+	registry.RegisterInterface(
+		"okbchain.authz.v1.Authorization",
+		(*Authorization)(nil),
+		&GenericAuthorization{},
+		&SendAuthorization{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,280 @@
+package typesadapter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	codectypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	txmsg "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	ibc_tx "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/ibc-tx"
+	"github.com/okx/okbchain/x/authz/types"
+)
+
+var (
+	_ txmsg.Msg = &MsgGrant{}
+	_ txmsg.Msg = &MsgExec{}
+	_ txmsg.Msg = &MsgRevoke{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgGrant{}
+	_ codectypes.UnpackInterfacesMessage = MsgExec{}
+	_ codectypes.UnpackInterfacesMessage = Grant{}
+
+	_ Authorization = &GenericAuthorization{}
+	_ Authorization = &SendAuthorization{}
+)
+
+// Authorization is implemented by the protobuf authorizations, which are
+// converted to their amino counterparts to be stored and accepted
+type Authorization interface {
+	proto.Message
+
+	ToAuthorization() types.Authorization
+}
+
+// ToAuthorization implements Authorization
+func (a *GenericAuthorization) ToAuthorization() types.Authorization {
+	return types.NewGenericAuthorization(a.Msg)
+}
+
+// ToAuthorization implements Authorization
+func (a *SendAuthorization) ToAuthorization() types.Authorization {
+	return types.NewSendAuthorization(a.SpendLimit.ToCoins())
+}
+
+// NewGrant packs authorization into a new Grant instance
+func NewGrant(authorization Authorization, expiration *time.Time) (Grant, error) {
+	any, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (g Grant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// ToGrant converts the grant to its amino counterpart
+func (g Grant) ToGrant() (types.Grant, error) {
+	if g.Authorization == nil {
+		return types.Grant{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "authorization is missing")
+	}
+	authorization, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return types.Grant{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack authorization %s", g.Authorization.TypeUrl)
+	}
+	var expiration time.Time
+	if g.Expiration != nil {
+		expiration = *g.Expiration
+	}
+	return types.NewGrant(authorization.ToAuthorization(), expiration), nil
+}
+
+// NewMsgGrant creates a new MsgGrant instance
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration *time.Time) (*MsgGrant, error) {
+	grant, err := NewGrant(authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   grant,
+	}, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg MsgGrant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// ToMsgGrant converts the msg to its amino counterpart
+func (msg MsgGrant) ToMsgGrant() (types.MsgGrant, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return types.MsgGrant{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return types.MsgGrant{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	grant, err := msg.Grant.ToGrant()
+	if err != nil {
+		return types.MsgGrant{}, err
+	}
+	return types.NewMsgGrant(granter, grantee, grant), nil
+}
+
+func (msg MsgGrant) ValidateBasic() error {
+	aminoMsg, err := msg.ToMsgGrant()
+	if err != nil {
+		return err
+	}
+	return aminoMsg.ValidateBasic()
+}
+
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgGrant) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgGrant) Type() string {
+	return types.TypeMsgGrant
+}
+
+// GetSignBytes returns the sign bytes of the amino counterpart of the msg
+func (msg MsgGrant) GetSignBytes() []byte {
+	aminoMsg, err := msg.ToMsgGrant()
+	if err != nil {
+		panic(err)
+	}
+	return aminoMsg.GetSignBytes()
+}
+
+// NewMsgExec packs msgs into a new MsgExec instance
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		protoMsg, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("can't proto marshal %T", msg)
+		}
+		any, err := codectypes.NewAnyWithValue(protoMsg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    anys,
+	}, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg MsgExec) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m txmsg.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToMsgExec converts the msg to its amino counterpart. The executed msgs are
+// filtered by the same denom rules as the msgs of an ibc tx.
+func (msg MsgExec) ToMsgExec() (types.MsgExec, error) {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return types.MsgExec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return types.MsgExec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack msg %s", any.TypeUrl)
+		}
+		if denomMsg, ok := m.(ibc_tx.DenomAdapterMsg); ok {
+			if m, err = denomMsg.RulesFilter(); err != nil {
+				return types.MsgExec{}, err
+			}
+		}
+		msgs[i] = m
+	}
+	return types.NewMsgExec(grantee, msgs), nil
+}
+
+func (msg MsgExec) ValidateBasic() error {
+	aminoMsg, err := msg.ToMsgExec()
+	if err != nil {
+		return err
+	}
+	return aminoMsg.ValidateBasic()
+}
+
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+func (msg MsgExec) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgExec) Type() string {
+	return types.TypeMsgExec
+}
+
+// GetSignBytes returns the sign bytes of the amino counterpart of the msg
+func (msg MsgExec) GetSignBytes() []byte {
+	aminoMsg, err := msg.ToMsgExec()
+	if err != nil {
+		panic(err)
+	}
+	return aminoMsg.GetSignBytes()
+}
+
+// ToMsgRevoke converts the msg to its amino counterpart
+func (msg MsgRevoke) ToMsgRevoke() (types.MsgRevoke, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return types.MsgRevoke{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return types.MsgRevoke{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	return types.NewMsgRevoke(granter, grantee, msg.MsgType), nil
+}
+
+func (msg MsgRevoke) ValidateBasic() error {
+	aminoMsg, err := msg.ToMsgRevoke()
+	if err != nil {
+		return err
+	}
+	return aminoMsg.ValidateBasic()
+}
+
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgRevoke) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgRevoke) Type() string {
+	return types.TypeMsgRevoke
+}
+
+// GetSignBytes returns the sign bytes of the amino counterpart of the msg
+func (msg MsgRevoke) GetSignBytes() []byte {
+	aminoMsg, err := msg.ToMsgRevoke()
+	if err != nil {
+		panic(err)
+	}
+	return aminoMsg.GetSignBytes()
+}
@@ -33,4 +33,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// compound the rewards allocated above for the delegators who enabled it
+	if tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		k.AutoCompoundRewards(ctx)
+	}
 }
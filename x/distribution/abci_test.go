@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/distribution/keeper"
+	"github.com/okx/okbchain/x/distribution/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +26,23 @@ func TestBeginBlocker(t *testing.T) {
 		require.Equal(t, k.GetPreviousProposerConsAddr(ctx), valConsAddrs[index])
 	}
 }
+
+func TestBeginBlockerAutoCompound(t *testing.T) {
+	_, _, valConsAddrs := keeper.GetTestAddrs()
+	ctx, _, k, _, _ := keeper.CreateTestInputDefault(t, false, 1000)
+	k.SetDistributionType(ctx, types.DistributionTypeOnChain)
+	k.SetAutoCompoundInterval(ctx, 1)
+	// the delegator without any deposit is removed once auto-compounding runs
+	delAddr := keeper.TestDelAddrs[0]
+	k.SetAutoCompound(ctx, delAddr, true)
+
+	req := abci.RequestBeginBlock{Header: abci.Header{Height: 1, ProposerAddress: valConsAddrs[0].Bytes()}}
+	ctx.SetBlockHeight(1)
+	BeginBlocker(ctx, req, k)
+	require.True(t, k.GetAutoCompound(ctx, delAddr))
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	BeginBlocker(ctx, req, k)
+	require.False(t, k.GetAutoCompound(ctx, delAddr))
+}
@@ -16,4 +16,5 @@ var (
 	WithdrawRewardEnabledProposalHandler   = client.WithdrawRewardEnabledProposalHandler
 	RewardTruncatePrecisionProposalHandler = client.RewardTruncatePrecisionProposalHandler
 	NewMsgWithdrawDelegatorAllRewards      = types.NewMsgWithdrawDelegatorAllRewards
+	NewMsgSetAutoCompound                  = types.NewMsgSetAutoCompound
 )
@@ -4,6 +4,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
@@ -51,6 +52,41 @@ $ %s tx distr withdraw-all-rewards --from mykey
 	return cmd
 }
 
+// GetCmdSetAutoCompound command to enable or disable the auto-compounding of rewards
+func GetCmdSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Short: "enable or disable the auto-compounding of the delegator's rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto-compounding of the delegator's rewards. The rewards are withdrawn and
+deposited periodically, and the shares are added to the validators which the delegator added shares to.
+The rewards are compounded only if they are withdrawn to the delegator address.
+
+Example:
+$ %s tx distr set-auto-compound true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid enabled value: %s", args[0])
+			}
+
+			msg := types.NewMsgSetAutoCompound(cliCtx.GetFromAddress(), enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
 // GetChangeDistributionTypeProposal implements the command to submit a change-distr-type proposal
 func GetChangeDistributionTypeProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
 	cmd := &cobra.Command{
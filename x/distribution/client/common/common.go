@@ -55,7 +55,24 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (params types.Par
 	}
 	cliCtx.Codec.MustUnmarshalJSON(bytes, &rewardTruncatePrecision)
 
-	return types.NewParams(communityTax, withdrawAddrEnabled, distributionType, withdrawRewardEnabled, rewardTruncatePrecision), nil
+	var autoCompoundInterval int64
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoCompoundInterval)
+	bytes, _, err = cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return
+	}
+	cliCtx.Codec.MustUnmarshalJSON(bytes, &autoCompoundInterval)
+
+	var maxAutoCompoundsPerBlock int64
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamMaxAutoCompoundsPerBlock)
+	bytes, _, err = cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return
+	}
+	cliCtx.Codec.MustUnmarshalJSON(bytes, &maxAutoCompoundsPerBlock)
+
+	return types.NewParams(communityTax, withdrawAddrEnabled, distributionType, withdrawRewardEnabled,
+		rewardTruncatePrecision, autoCompoundInterval, maxAutoCompoundsPerBlock), nil
 }
 
 // QueryValidatorCommission returns a validator's commission.
@@ -18,6 +18,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
 	}
+	for _, delAddr := range data.AutoCompoundDelegators {
+		keeper.SetAutoCompound(ctx, delAddr, true)
+	}
 
 	moduleHoldings := sdk.SysCoins{}
 	for _, acc := range data.ValidatorAccumulatedCommissions {
@@ -64,5 +67,13 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, acc)
+	var autoCompounds []sdk.AccAddress
+	keeper.IterateAutoCompounds(ctx, func(delAddr sdk.AccAddress) (stop bool) {
+		autoCompounds = append(autoCompounds, delAddr)
+		return false
+	})
+
+	genesisState := types.NewGenesisState(params, feePool, dwi, pp, acc)
+	genesisState.AutoCompoundDelegators = autoCompounds
+	return genesisState
 }
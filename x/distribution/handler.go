@@ -25,6 +25,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
 		case types.MsgWithdrawDelegatorAllRewards:
 			return handleMsgWithdrawDelegatorAllRewards(ctx, msg, k)
+		case types.MsgSetAutoCompound:
+			return handleMsgSetAutoCompound(ctx, msg, k)
 		default:
 			return nil, types.ErrUnknownDistributionMsgType()
 		}
@@ -1,7 +1,11 @@
 package distribution
 
 import (
+	"fmt"
+
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/distribution/keeper"
 	"github.com/okx/okbchain/x/distribution/types"
 )
@@ -39,3 +43,27 @@ func handleMsgWithdrawDelegatorAllRewards(ctx sdk.Context, msg types.MsgWithdraw
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetAutoCompound(ctx sdk.Context, msg types.MsgSetAutoCompound, k keeper.Keeper) (*sdk.Result, error) {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "auto-compound is not supported at height %d", ctx.BlockHeight())
+	}
+	if msg.Enabled && k.StakingKeeper().Delegator(ctx, msg.DelegatorAddress) == nil {
+		return nil, types.ErrCodeEmptyDelegationDistInfo()
+	}
+	k.SetAutoCompound(ctx, msg.DelegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprintf("%t", msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
 	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
 	"github.com/okx/okbchain/libs/tendermint/crypto"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/distribution/keeper"
 	"github.com/okx/okbchain/x/distribution/types"
 	"github.com/okx/okbchain/x/staking"
@@ -94,6 +95,34 @@ func (suite *HandlerSuite) TestHandlerWithdrawDelegatorReward() {
 	}
 }
 
+func (suite *HandlerSuite) TestHandlerSetAutoCompound() {
+	ctx, _, dk, sk, _ := keeper.CreateTestInputDefault(suite.T(), false, 10)
+	handler := NewHandler(dk)
+	delAddr1 := keeper.TestDelAddrs[0]
+
+	// not supported before venus8
+	_, err := handler(ctx, NewMsgSetAutoCompound(delAddr1, true))
+	require.Error(suite.T(), err)
+	require.False(suite.T(), dk.GetAutoCompound(ctx, delAddr1))
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+
+	// no deposit
+	_, err = handler(ctx, NewMsgSetAutoCompound(delAddr1, true))
+	require.Equal(suite.T(), types.ErrCodeEmptyDelegationDistInfo(), err)
+	require.False(suite.T(), dk.GetAutoCompound(ctx, delAddr1))
+
+	keeper.DoDeposit(suite.T(), ctx, sk, delAddr1, sdk.NewCoin(sk.BondDenom(ctx), sdk.NewInt(100)))
+	_, err = handler(ctx, NewMsgSetAutoCompound(delAddr1, true))
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), dk.GetAutoCompound(ctx, delAddr1))
+
+	_, err = handler(ctx, NewMsgSetAutoCompound(delAddr1, false))
+	require.NoError(suite.T(), err)
+	require.False(suite.T(), dk.GetAutoCompound(ctx, delAddr1))
+}
+
 type allocationParam struct {
 	totalPower int64
 	isVote     []bool
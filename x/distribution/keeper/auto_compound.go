@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/distribution/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the delegator's rewards
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetAutoCompoundKey(delAddr), []byte{0x01})
+	} else {
+		store.Delete(types.GetAutoCompoundKey(delAddr))
+	}
+}
+
+// GetAutoCompound returns true if the rewards of the delegator are compounded automatically
+func (k Keeper) GetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAutoCompoundKey(delAddr))
+}
+
+// IterateAutoCompounds iterates over the delegators whose rewards are compounded automatically
+func (k Keeper) IterateAutoCompounds(ctx sdk.Context, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// AutoCompoundRewards compounds the rewards of the delegators who enabled the auto-compounding. A round starts
+// every AutoCompoundInterval blocks, and at most MaxAutoCompoundsPerBlock delegators are processed in a block,
+// the round goes on in the next blocks from where it stopped until all the delegators are processed.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	interval := k.GetAutoCompoundInterval(ctx)
+	if interval <= 0 || k.GetDistributionType(ctx) != types.DistributionTypeOnChain || !k.GetWithdrawRewardEnabled(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.AutoCompoundCursorKey)
+	if start == nil {
+		if ctx.BlockHeight()%interval != 0 {
+			return
+		}
+		start = types.AutoCompoundPrefix
+	}
+
+	// collect the delegators first, the store mustn't be written while being iterated
+	max := int(k.GetMaxAutoCompoundsPerBlock(ctx))
+	delAddrs := make([]sdk.AccAddress, 0, max)
+	var next []byte
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundPrefix))
+	for ; iter.Valid(); iter.Next() {
+		if len(delAddrs) == max {
+			next = iter.Key()
+			break
+		}
+		delAddrs = append(delAddrs, types.GetAutoCompoundAddress(iter.Key()))
+	}
+	iter.Close()
+
+	if next != nil {
+		store.Set(types.AutoCompoundCursorKey, next)
+	} else {
+		store.Delete(types.AutoCompoundCursorKey)
+	}
+
+	for _, delAddr := range delAddrs {
+		k.autoCompound(ctx, delAddr)
+	}
+}
+
+// autoCompound withdraws the rewards of the delegator and deposits them, the shares added to the validators are
+// updated with the new deposit. The state isn't changed if the rewards can't be compounded, e.g. the rewards are
+// less than the minimum deposit, so that they are compounded with the rewards of the next rounds.
+func (k Keeper) autoCompound(ctx sdk.Context, delAddr sdk.AccAddress) {
+	del := k.stakingKeeper.Delegator(ctx, delAddr)
+	if del == nil {
+		// the delegator has withdrawn all the deposit
+		k.SetAutoCompound(ctx, delAddr, false)
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	amount, err := k.compound(cacheCtx, delAddr, del.GetShareAddedValidatorAddresses())
+	if err != nil {
+		k.Logger(ctx).Debug("failed to compound rewards", "delegator", delAddr, "err", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+func (k Keeper) compound(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) (sdk.SysCoin, error) {
+	// the rewards are deposited from the delegator's account
+	if withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr); !withdrawAddr.Equals(delAddr) {
+		return sdk.SysCoin{}, fmt.Errorf("rewards are withdrawn to %s", withdrawAddr)
+	}
+	if len(valAddrs) == 0 {
+		return sdk.SysCoin{}, types.ErrCodeEmptyDelegationVoteValidator()
+	}
+
+	var rewards sdk.SysCoins
+	for _, valAddr := range valAddrs {
+		reward, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return sdk.SysCoin{}, err
+		}
+		rewards = rewards.Add(reward...)
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := sdk.NewDecCoinFromDec(bondDenom, rewards.AmountOf(bondDenom))
+	if err := k.stakingKeeper.Delegate(ctx, delAddr, amount); err != nil {
+		return sdk.SysCoin{}, err
+	}
+	return amount, nil
+}
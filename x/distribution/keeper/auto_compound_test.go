@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/distribution/types"
+	"github.com/okx/okbchain/x/staking"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoCompoundRewards(t *testing.T) {
+	communityTax := sdk.NewDecWithPrec(2, 2)
+	ctx, _, _, dk, sk, _, _ := CreateTestInputAdvanced(t, false, 1000, communityTax)
+	dk.SetDistributionType(ctx, types.DistributionTypeOnChain)
+
+	//set module account coins
+	distrAcc := dk.GetDistributionAccount(ctx)
+	require.NoError(t, distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), sdk.NewInt(1000)))))
+	dk.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator
+	DoCreateValidator(t, ctx, sk, valOpAddr1, valConsPk1)
+	staking.EndBlocker(ctx, sk)
+	ctx.SetBlockHeight(ctx.BlockHeight() + 1)
+
+	// set new rate 0.5
+	newRate, _ := sdk.NewDecFromStr("0.5")
+	ctx.SetBlockTime(time.Now().UTC().Add(48 * time.Hour))
+	DoEditValidator(t, ctx, sk, valOpAddr1, newRate)
+	staking.EndBlocker(ctx, sk)
+	ctx.SetBlockHeight(ctx.BlockHeight() + 1)
+
+	valOpAddrs := []sdk.ValAddress{valOpAddr1}
+	delAddrs := []sdk.AccAddress{delAddr1, delAddr2}
+	for _, delAddr := range delAddrs {
+		DoDeposit(t, ctx, sk, delAddr, sdk.NewCoin(sk.BondDenom(ctx), sdk.NewInt(100)))
+		DoAddShares(t, ctx, sk, delAddr, valOpAddrs)
+		dk.SetAutoCompound(ctx, delAddr, true)
+	}
+	require.True(t, dk.GetAutoCompound(ctx, delAddr1))
+	// the delegator without any deposit is removed
+	dk.SetAutoCompound(ctx, delAddr3, true)
+
+	dk.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1),
+		sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(20)}})
+
+	tokensOf := func(delAddr sdk.AccAddress) sdk.Dec {
+		delegator, found := sk.GetDelegator(ctx, delAddr)
+		require.True(t, found)
+		return delegator.Tokens
+	}
+	compounded := func() (count int) {
+		for _, delAddr := range delAddrs {
+			if tokensOf(delAddr).GT(sdk.NewDec(100)) {
+				count++
+			}
+		}
+		return count
+	}
+
+	// disabled by default
+	ctx.SetBlockHeight(10)
+	dk.AutoCompoundRewards(ctx)
+	require.Equal(t, 0, compounded())
+
+	dk.SetAutoCompoundInterval(ctx, 10)
+	dk.SetMaxAutoCompoundsPerBlock(ctx, 1)
+
+	// not the beginning of a round
+	ctx.SetBlockHeight(11)
+	dk.AutoCompoundRewards(ctx)
+	require.Equal(t, 0, compounded())
+
+	// one delegator is processed in a block
+	processed := func() int {
+		if dk.GetAutoCompound(ctx, delAddr3) {
+			return compounded()
+		}
+		return compounded() + 1
+	}
+	for i := int64(0); i < 3; i++ {
+		ctx.SetBlockHeight(20 + i)
+		dk.AutoCompoundRewards(ctx)
+		require.Equal(t, int(i+1), processed())
+	}
+	require.Equal(t, 2, compounded())
+	require.False(t, dk.GetAutoCompound(ctx, delAddr3))
+	require.Nil(t, ctx.KVStore(dk.storeKey).Get(types.AutoCompoundCursorKey))
+
+	// the compounded deposit is added to the shares of the validator
+	delegator, found := sk.GetDelegator(ctx, delAddr1)
+	require.True(t, found)
+	require.Equal(t, valOpAddrs, delegator.ValidatorAddresses)
+	shares, found := sk.GetShares(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	require.True(t, delegator.Shares.Equal(shares))
+
+	// the rewards less than the minimum deposit are kept
+	tokens := tokensOf(delAddr1)
+	for i := int64(0); i < 2; i++ {
+		ctx.SetBlockHeight(30 + i)
+		dk.AutoCompoundRewards(ctx)
+	}
+	require.Equal(t, tokens, tokensOf(delAddr1))
+
+	// the rewards withdrawn to another address are not compounded
+	dk.SetDelegatorWithdrawAddr(ctx, delAddr1, delAddr3)
+	dk.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1),
+		sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(20)}})
+	for i := int64(0); i < 2; i++ {
+		ctx.SetBlockHeight(40 + i)
+		dk.AutoCompoundRewards(ctx)
+	}
+	require.Equal(t, tokens, tokensOf(delAddr1))
+	require.True(t, tokensOf(delAddr2).GT(tokens))
+
+	dk.SetAutoCompound(ctx, delAddr1, false)
+	require.False(t, dk.GetAutoCompound(ctx, delAddr1))
+}
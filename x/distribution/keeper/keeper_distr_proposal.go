@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
 	"github.com/okx/okbchain/x/distribution/types"
 	govTypes "github.com/okx/okbchain/x/gov/types"
 )
@@ -94,6 +95,38 @@ func (k Keeper) WithdrawDelegationAllRewards(ctx sdk.Context, delAddr sdk.AccAdd
 	return nil
 }
 
+// DelegationRewards calculates the rewards accrued by a delegator from a validator it added shares to.
+// It increments the validator period, so callers are expected to pass a cache-wrapped context.
+func (k Keeper) DelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrCodeEmptyValidatorDistInfo(), valAddr.String())
+	}
+
+	del := k.stakingKeeper.Delegator(ctx, delAddr)
+	if del == nil {
+		return nil, types.ErrCodeEmptyDelegationDistInfo()
+	}
+
+	found := false
+	for _, addr := range del.GetShareAddedValidatorAddresses() {
+		if addr.Equals(valAddr) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrCodeEmptyDelegationVoteValidator(), valAddr.String())
+	}
+
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	rewards := k.calculateDelegationRewards(ctx, val, delAddr, endingPeriod)
+	if rewards == nil {
+		rewards = sdk.DecCoins{}
+	}
+	return rewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
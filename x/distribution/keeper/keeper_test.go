@@ -13,6 +13,8 @@ func TestSetWithdrawAddr(t *testing.T) {
 	keeper.SetDistributionType(ctx, types.DistributionTypeOffChain)
 	keeper.SetWithdrawRewardEnabled(ctx, true)
 	keeper.SetRewardTruncatePrecision(ctx, 0)
+	keeper.SetAutoCompoundInterval(ctx, 0)
+	keeper.SetMaxAutoCompoundsPerBlock(ctx, 100)
 
 	params := keeper.GetParams(ctx)
 	params.WithdrawAddrEnabled = false
@@ -42,3 +42,27 @@ func (k Keeper) GetRewardTruncatePrecision(ctx sdk.Context) (precision int64) {
 func (k Keeper) SetRewardTruncatePrecision(ctx sdk.Context, precision int64) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeyRewardTruncatePrecision, &precision)
 }
+
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) (interval int64) {
+	interval = 0
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyAutoCompoundInterval) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundInterval, &interval)
+	}
+	return interval
+}
+
+func (k Keeper) SetAutoCompoundInterval(ctx sdk.Context, interval int64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundInterval, &interval)
+}
+
+func (k Keeper) GetMaxAutoCompoundsPerBlock(ctx sdk.Context) (max int64) {
+	max = types.DefaultParams().MaxAutoCompoundsPerBlock
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxAutoCompoundsPerBlock) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxAutoCompoundsPerBlock, &max)
+	}
+	return max
+}
+
+func (k Keeper) SetMaxAutoCompoundsPerBlock(ctx sdk.Context, max int64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyMaxAutoCompoundsPerBlock, &max)
+}
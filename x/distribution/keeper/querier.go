@@ -75,6 +75,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, comm.ErrMarshalJSONFailed(err.Error())
 		}
 		return bz, nil
+	case types.ParamAutoCompoundInterval:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAutoCompoundInterval(ctx))
+		if err != nil {
+			return nil, comm.ErrMarshalJSONFailed(err.Error())
+		}
+		return bz, nil
+	case types.ParamMaxAutoCompoundsPerBlock:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetMaxAutoCompoundsPerBlock(ctx))
+		if err != nil {
+			return nil, comm.ErrMarshalJSONFailed(err.Error())
+		}
+		return bz, nil
 	default:
 		return nil, types.ErrUnknownDistributionParamType()
 	}
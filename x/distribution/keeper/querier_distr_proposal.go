@@ -5,7 +5,6 @@ import (
 
 	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
-	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
 	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
 	comm "github.com/okx/okbchain/x/common"
 	"github.com/okx/okbchain/x/distribution/types"
@@ -21,34 +20,12 @@ func queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery,
 	// cache-wrap context as to not persist state changes during querying
 	ctx, _ = ctx.CacheContext()
 
-	val := k.stakingKeeper.Validator(ctx, params.ValidatorAddress)
-	if val == nil {
-		return nil, sdkerrors.Wrap(types.ErrCodeEmptyValidatorDistInfo(), params.ValidatorAddress.String())
-	}
-
-	del := k.stakingKeeper.Delegator(ctx, params.DelegatorAddress)
-	if del == nil {
-		return nil, types.ErrCodeEmptyDelegationDistInfo()
-	}
-
-	found := false
-	for _, valAddr := range del.GetShareAddedValidatorAddresses() {
-		if valAddr.Equals(params.ValidatorAddress) {
-			found = true
-		}
-	}
-	if !found {
-		return nil, sdkerrors.Wrap(types.ErrCodeEmptyDelegationVoteValidator(), params.ValidatorAddress.String())
-	}
-
-	logger := k.Logger(ctx)
-	endingPeriod := k.incrementValidatorPeriod(ctx, val)
-	rewards := k.calculateDelegationRewards(ctx, val, params.DelegatorAddress, endingPeriod)
-	if rewards == nil {
-		rewards = sdk.DecCoins{}
+	rewards, err := k.DelegationRewards(ctx, params.DelegatorAddress, params.ValidatorAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	logger.Debug("queryDelegationRewards", "Validator", val.GetOperator(),
+	k.Logger(ctx).Debug("queryDelegationRewards", "Validator", params.ValidatorAddress,
 		"Delegator", params.DelegatorAddress, "Reward", rewards)
 
 	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
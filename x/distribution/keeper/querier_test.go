@@ -47,6 +47,18 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(0), precision)
 
+	bz, err := querior(ctx, []string{types.QueryParams, types.ParamAutoCompoundInterval}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var interval int64
+	require.NoError(t, amino.UnmarshalJSON(bz, &interval))
+	require.Equal(t, int64(0), interval)
+
+	bz, err = querior(ctx, []string{types.QueryParams, types.ParamMaxAutoCompoundsPerBlock}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var maxCompounds int64
+	require.NoError(t, amino.UnmarshalJSON(bz, &maxCompounds))
+	require.Equal(t, int64(100), maxCompounds)
+
 	_, err = querior(ctx, []string{"unknown"}, abci.RequestQuery{})
 	require.Error(t, err)
 	_, err = querior(ctx, []string{types.QueryParams, "unknown"}, abci.RequestQuery{})
@@ -0,0 +1,87 @@
+package distribution
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/distribution/keeper"
+	"github.com/okx/okbchain/x/distribution/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+const (
+	PrecompileWithdrawDelegatorReward = "withdrawDelegatorReward"
+)
+
+var (
+	PrecompileABI evmtypes.ABI
+
+	//go:embed precompile.json
+	precompileJson []byte
+
+	precompileGas = map[string]uint64{
+		PrecompileWithdrawDelegatorReward: 30000,
+	}
+)
+
+func init() {
+	ret, err := abi.JSON(bytes.NewReader(precompileJson))
+	if err != nil {
+		panic(err)
+	}
+	PrecompileABI = evmtypes.ABI{ABI: &ret}
+}
+
+var _ evmtypes.Precompile = Precompile{}
+
+// Precompile lets evm contracts withdraw the rewards of their delegations
+type Precompile struct {
+	handler sdk.Handler
+}
+
+// NewPrecompile creates a new distribution precompile
+func NewPrecompile(k keeper.Keeper) Precompile {
+	return Precompile{handler: NewHandler(k)}
+}
+
+// ABI returns the abi of the distribution precompile
+func (p Precompile) ABI() evmtypes.ABI {
+	return PrecompileABI
+}
+
+// RequiredGas returns the gas charged before the distribution method is run
+func (p Precompile) RequiredGas(method *abi.Method) uint64 {
+	return precompileGas[method.Name]
+}
+
+// Run executes the distribution message built from the args with the caller as the delegator
+func (p Precompile) Run(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}) ([]byte, error) {
+	delAddr := sdk.AccAddress(caller.Bytes())
+
+	var msg sdk.Msg
+	switch method.Name {
+	case PrecompileWithdrawDelegatorReward:
+		valAddr, err := sdk.ValAddressFromBech32(args[0].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator address %s: %s", args[0], err)
+		}
+		msg = types.NewMsgWithdrawDelegatorReward(delAddr, valAddr)
+	default:
+		return nil, fmt.Errorf("distribution precompile: unknown method %s", method.Name)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	res, err := p.handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(res.Events)
+
+	return method.Outputs.Pack(true)
+}
@@ -15,6 +15,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(WithdrawRewardEnabledProposal{}, system.Chain+"/distribution/WithdrawRewardEnabledProposal", nil)
 	cdc.RegisterConcrete(RewardTruncatePrecisionProposal{}, system.Chain+"/distribution/RewardTruncatePrecisionProposal", nil)
 	cdc.RegisterConcrete(MsgWithdrawDelegatorAllRewards{}, system.Chain+"/distribution/MsgWithdrawDelegatorAllRewards", nil)
+	cdc.RegisterConcrete(MsgSetAutoCompound{}, system.Chain+"/distribution/MsgSetAutoCompound", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
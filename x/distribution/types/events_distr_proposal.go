@@ -4,4 +4,9 @@ package types
 const (
 	EventTypeRewards         = "rewards"
 	EventTypeWithdrawRewards = "withdraw_rewards"
+	EventTypeSetAutoCompound = "set_auto_compound"
+	EventTypeAutoCompound    = "auto_compound"
+
+	AttributeKeyDelegator = "delegator"
+	AttributeKeyEnabled   = "enabled"
 )
@@ -42,6 +42,10 @@ type StakingKeeper interface {
 	IsValidator(ctx sdk.Context, addr sdk.AccAddress) bool
 
 	ParamsConsensusType(ctx sdk.Context) (consensusType common.ConsensusType)
+
+	BondDenom(ctx sdk.Context) string
+	// deposit the token of the delegator, and update the shares added to the validators
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysCoin) error
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
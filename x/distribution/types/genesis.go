@@ -24,6 +24,7 @@ type GenesisState struct {
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos" yaml:"delegator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer" yaml:"previous_proposer"`
 	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `json:"validator_accumulated_commissions" yaml:"validator_accumulated_commissions"`
+	AutoCompoundDelegators          []sdk.AccAddress                       `json:"auto_compound_delegators,omitempty" yaml:"auto_compound_delegators,omitempty"`
 }
 
 // NewGenesisState creates a new object of GenesisState
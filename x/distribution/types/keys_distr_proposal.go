@@ -10,6 +10,8 @@ var (
 	DelegatorStartingInfoPrefix       = []byte{0x04} // key for delegator starting info
 	ValidatorHistoricalRewardsPrefix  = []byte{0x05} // key for historical validators rewards / stake
 	ValidatorCurrentRewardsPrefix     = []byte{0x06} // key for current validator rewards
+	AutoCompoundPrefix                = []byte{0x08} // key for delegators compounding rewards automatically
+	AutoCompoundCursorKey             = []byte{0x09} // key for the next auto-compound key of the current round
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -60,6 +62,15 @@ func GetValidatorCurrentRewardsAddress(key []byte) (valAddr sdk.ValAddress) {
 	return sdk.ValAddress(addr)
 }
 
+// gets the address from a delegator's auto-compound key
+func GetAutoCompoundAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -86,3 +97,8 @@ func GetValidatorHistoricalRewardsKey(v sdk.ValAddress, k uint64) []byte {
 func GetValidatorCurrentRewardsKey(v sdk.ValAddress) []byte {
 	return append(ValidatorCurrentRewardsPrefix, v.Bytes()...)
 }
+
+// gets the key for a delegator compounding rewards automatically
+func GetAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundPrefix, delAddr.Bytes()...)
+}
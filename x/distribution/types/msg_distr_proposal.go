@@ -83,3 +83,42 @@ func (msg MsgWithdrawDelegatorAllRewards) ValidateBasic() error {
 
 	return nil
 }
+
+// Verify interface at compile time
+var _ = &MsgSetAutoCompound{}
+
+// msg struct for enabling or disabling the auto-compounding of the delegator's rewards
+type MsgSetAutoCompound struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, enabled bool) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+func (msg MsgSetAutoCompound) Type() string  { return "set_auto_compound" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.DelegatorAddress)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+
+	return nil
+}
@@ -85,3 +85,16 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetAutoCompound test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	msg := NewMsgSetAutoCompound(delAddr1, true)
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	require.Equal(t, ModuleName, msg.Route())
+	require.Equal(t, "set_auto_compound", msg.Type())
+	require.Equal(t, []sdk.AccAddress{delAddr1}, msg.GetSigners())
+	require.Equal(t, sdk.MustSortJSON(bz), msg.GetSignBytes())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.Error(t, NewMsgSetAutoCompound(emptyDelAddr, true).ValidateBasic())
+}
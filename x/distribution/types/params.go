@@ -14,11 +14,13 @@ const (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax            = []byte("communitytax")
-	ParamStoreKeyWithdrawAddrEnabled     = []byte("withdrawaddrenabled")
-	ParamStoreKeyDistributionType        = []byte("distributiontype")
-	ParamStoreKeyWithdrawRewardEnabled   = []byte("withdrawrewardenabled")
-	ParamStoreKeyRewardTruncatePrecision = []byte("rewardtruncateprecision")
+	ParamStoreKeyCommunityTax             = []byte("communitytax")
+	ParamStoreKeyWithdrawAddrEnabled      = []byte("withdrawaddrenabled")
+	ParamStoreKeyDistributionType         = []byte("distributiontype")
+	ParamStoreKeyWithdrawRewardEnabled    = []byte("withdrawrewardenabled")
+	ParamStoreKeyRewardTruncatePrecision  = []byte("rewardtruncateprecision")
+	ParamStoreKeyAutoCompoundInterval     = []byte("autocompoundinterval")
+	ParamStoreKeyMaxAutoCompoundsPerBlock = []byte("maxautocompoundsperblock")
 )
 
 // Params defines the set of distribution parameters.
@@ -28,6 +30,10 @@ type Params struct {
 	DistributionType        uint32  `json:"distribution_type" yaml:"distribution_type"`
 	WithdrawRewardEnabled   bool    `json:"withdraw_reward_enabled" yaml:"withdraw_reward_enabled"`
 	RewardTruncatePrecision int64   `json:"reward_truncate_precision" yaml:"reward_truncate_precision"`
+	// AutoCompoundInterval is the number of blocks between two rounds of auto-compounding, 0 disables it
+	AutoCompoundInterval int64 `json:"auto_compound_interval" yaml:"auto_compound_interval"`
+	// MaxAutoCompoundsPerBlock is the maximum number of delegators whose rewards are compounded in a block
+	MaxAutoCompoundsPerBlock int64 `json:"max_auto_compounds_per_block" yaml:"max_auto_compounds_per_block"`
 }
 
 // ParamKeyTable returns the parameter key table.
@@ -38,11 +44,13 @@ func ParamKeyTable() params.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:            sdk.NewDecWithPrec(2, 2), // 2%
-		WithdrawAddrEnabled:     true,
-		DistributionType:        0,
-		WithdrawRewardEnabled:   true,
-		RewardTruncatePrecision: 0,
+		CommunityTax:             sdk.NewDecWithPrec(2, 2), // 2%
+		WithdrawAddrEnabled:      true,
+		DistributionType:         0,
+		WithdrawRewardEnabled:    true,
+		RewardTruncatePrecision:  0,
+		AutoCompoundInterval:     0,
+		MaxAutoCompoundsPerBlock: 100,
 	}
 }
 
@@ -53,8 +61,11 @@ func (p Params) String() string {
   Withdraw Addr Enabled:  %t
   Distribution Type: %d
   Withdraw Reward Enabled: %t
-  Reward Truncate Precision: %d`,
-		p.CommunityTax, p.WithdrawAddrEnabled, p.DistributionType, p.WithdrawRewardEnabled, p.RewardTruncatePrecision)
+  Reward Truncate Precision: %d
+  Auto Compound Interval: %d
+  Max Auto Compounds Per Block: %d`,
+		p.CommunityTax, p.WithdrawAddrEnabled, p.DistributionType, p.WithdrawRewardEnabled, p.RewardTruncatePrecision,
+		p.AutoCompoundInterval, p.MaxAutoCompoundsPerBlock)
 }
 
 // ParamSetPairs returns the parameter set pairs.
@@ -66,6 +77,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyDistributionType, &p.DistributionType, validateDistributionType),
 		params.NewParamSetPair(ParamStoreKeyWithdrawRewardEnabled, &p.WithdrawRewardEnabled, validateWithdrawRewardEnabled),
 		params.NewParamSetPair(ParamStoreKeyRewardTruncatePrecision, &p.RewardTruncatePrecision, validateRewardTruncatePrecision),
+		params.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, &p.AutoCompoundInterval, validateAutoCompoundInterval),
+		params.NewParamSetPair(ParamStoreKeyMaxAutoCompoundsPerBlock, &p.MaxAutoCompoundsPerBlock, validateMaxAutoCompoundsPerBlock),
 	}
 }
 
@@ -76,8 +89,11 @@ func (p Params) ValidateBasic() error {
 			"community tax should non-negative and less than one: %s", p.CommunityTax,
 		)
 	}
+	if err := validateAutoCompoundInterval(p.AutoCompoundInterval); err != nil {
+		return err
+	}
 
-	return nil
+	return validateMaxAutoCompoundsPerBlock(p.MaxAutoCompoundsPerBlock)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -142,14 +158,41 @@ func validateRewardTruncatePrecision(i interface{}) error {
 	return nil
 }
 
+func validateAutoCompoundInterval(i interface{}) error {
+	interval, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if interval < 0 {
+		return fmt.Errorf("auto compound interval must be non-negative: %d", interval)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundsPerBlock(i interface{}) error {
+	max, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if max <= 0 {
+		return fmt.Errorf("max auto compounds per block must be positive: %d", max)
+	}
+
+	return nil
+}
+
 // NewParams creates a new instance of Params
-func NewParams(communityTax sdk.Dec, withdrawAddrEnabled bool, distributionType uint32, withdrawRewardEnabled bool, rewardTruncatePrecision int64) Params {
+func NewParams(communityTax sdk.Dec, withdrawAddrEnabled bool, distributionType uint32, withdrawRewardEnabled bool,
+	rewardTruncatePrecision, autoCompoundInterval, maxAutoCompoundsPerBlock int64) Params {
 	return Params{
-		CommunityTax:            communityTax,
-		WithdrawAddrEnabled:     withdrawAddrEnabled,
-		DistributionType:        distributionType,
-		WithdrawRewardEnabled:   withdrawRewardEnabled,
-		RewardTruncatePrecision: rewardTruncatePrecision,
+		CommunityTax:             communityTax,
+		WithdrawAddrEnabled:      withdrawAddrEnabled,
+		DistributionType:         distributionType,
+		WithdrawRewardEnabled:    withdrawRewardEnabled,
+		RewardTruncatePrecision:  rewardTruncatePrecision,
+		AutoCompoundInterval:     autoCompoundInterval,
+		MaxAutoCompoundsPerBlock: maxAutoCompoundsPerBlock,
 	}
 }
 
@@ -13,7 +13,9 @@ const (
   Withdraw Addr Enabled:  true
   Distribution Type: 0
   Withdraw Reward Enabled: true
-  Reward Truncate Precision: 0`
+  Reward Truncate Precision: 0
+  Auto Compound Interval: 0
+  Max Auto Compounds Per Block: 100`
 )
 
 func TestParams(t *testing.T) {
@@ -53,3 +55,19 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAutoCompoundParams(t *testing.T) {
+	require.Error(t, validateAutoCompoundInterval(int32(1)))
+	require.Error(t, validateAutoCompoundInterval(int64(-1)))
+	require.NoError(t, validateAutoCompoundInterval(int64(0)))
+	require.NoError(t, validateAutoCompoundInterval(int64(100)))
+
+	require.Error(t, validateMaxAutoCompoundsPerBlock(int32(1)))
+	require.Error(t, validateMaxAutoCompoundsPerBlock(int64(0)))
+	require.NoError(t, validateMaxAutoCompoundsPerBlock(int64(1)))
+
+	params := DefaultParams()
+	require.NoError(t, params.ValidateBasic())
+	params.MaxAutoCompoundsPerBlock = 0
+	require.Error(t, params.ValidateBasic())
+}
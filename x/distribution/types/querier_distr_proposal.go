@@ -9,9 +9,11 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryDelegationRewards           = "delegation_rewards"
 
-	ParamDistributionType        = "distribution_type"
-	ParamWithdrawRewardEnabled   = "withdraw_reward_enabled"
-	ParamRewardTruncatePrecision = "reward_truncate_precision"
+	ParamDistributionType         = "distribution_type"
+	ParamWithdrawRewardEnabled    = "withdraw_reward_enabled"
+	ParamRewardTruncatePrecision  = "reward_truncate_precision"
+	ParamAutoCompoundInterval     = "auto_compound_interval"
+	ParamMaxAutoCompoundsPerBlock = "max_auto_compounds_per_block"
 )
 
 // params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/x/erc20/types"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for the erc20 module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Erc20 transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdConvertCoin(cdc),
+		GetCmdConvertERC20(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdConvertCoin returns a CLI command handler for converting a coin into the evm token
+func GetCmdConvertCoin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-coin [coin]",
+		Short: "Convert a coin into the evm token of the contract mapped to its denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert a coin of your account into the evm token of the contract mapped to its denom.
+
+Example:
+$ %s tx %s convert-coin 10stokb --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			coin, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertCoin(cliCtx.GetFromAddress(), coin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdConvertERC20 returns a CLI command handler for converting the evm token back into a coin
+func GetCmdConvertERC20(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-erc20 [coin]",
+		Short: "Convert the evm token of the contract mapped to the denom back into a coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the evm token of the contract mapped to the denom of the coin back into the coin of your account.
+
+Example:
+$ %s tx %s convert-erc20 10stokb --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			coin, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertERC20(cliCtx.GetFromAddress(), coin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
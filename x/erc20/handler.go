@@ -3,6 +3,7 @@ package erc20
 import (
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/x/erc20/types"
 )
 
 // NewHandler returns a handler for erc20 type messages.
@@ -11,8 +12,42 @@ func NewHandler(k Keeper) sdk.Handler {
 		ctx.SetEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
+		case types.MsgConvertCoin:
+			return handleMsgConvertCoin(ctx, k, msg)
+		case types.MsgConvertERC20:
+			return handleMsgConvertERC20(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
 	}
 }
+
+func handleMsgConvertCoin(ctx sdk.Context, k Keeper, msg types.MsgConvertCoin) (*sdk.Result, error) {
+	if err := k.ConvertCoinToERC20(ctx, msg.Sender, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConvertERC20(ctx sdk.Context, k Keeper, msg types.MsgConvertERC20) (*sdk.Result, error) {
+	if err := k.ConvertERC20ToCoin(ctx, msg.Sender, msg.Coin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -1,14 +1,18 @@
 package erc20_test
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/okx/okbchain/app"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	minttypes "github.com/okx/okbchain/libs/cosmos-sdk/x/mint"
 	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
 	"github.com/okx/okbchain/x/erc20"
 	"github.com/okx/okbchain/x/erc20/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -32,3 +36,47 @@ func (suite *Erc20TestSuite) SetupTest() {
 	suite.handler = erc20.NewHandler(suite.app.Erc20Keeper)
 	suite.app.Erc20Keeper.SetParams(suite.ctx, types.DefaultParams())
 }
+
+func (suite *Erc20TestSuite) TestHandleMsgConvertTokenizedDeposit() {
+	addr := sdk.AccAddress(common.BigToAddress(big.NewInt(1)).Bytes())
+	stakingKeeper := suite.app.StakingKeeper
+	bondDenom := stakingKeeper.BondDenom(suite.ctx)
+
+	stakingParams := stakingKeeper.GetParams(suite.ctx)
+	stakingParams.EnableDposOp = true
+	stakingKeeper.SetParams(suite.ctx, stakingParams)
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EnableCreate = true
+	evmParams.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+
+	deposit := sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100))
+	suite.Require().NoError(suite.app.SupplyKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.NewCoins(deposit)))
+	suite.Require().NoError(suite.app.SupplyKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addr, sdk.NewCoins(deposit)))
+	suite.Require().NoError(stakingKeeper.Delegate(suite.ctx, addr, deposit))
+	tokenized, err := stakingKeeper.TokenizeDeposit(suite.ctx, addr, deposit)
+	suite.Require().NoError(err)
+
+	// no contract mapped to the tokenized deposit yet
+	_, err = suite.handler(suite.ctx, types.NewMsgConvertCoin(addr, tokenized))
+	suite.Require().Error(err)
+
+	suite.app.Erc20Keeper.InitInternalTemplateContract(suite.ctx)
+	contract, err := suite.app.Erc20Keeper.DeployModuleERC20(suite.ctx, tokenized.Denom)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.Erc20Keeper.SetContractForDenom(suite.ctx, tokenized.Denom, contract))
+
+	_, err = suite.handler(suite.ctx, types.NewMsgConvertCoin(addr, tokenized))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetCoins().AmountOf(tokenized.Denom).IsZero())
+
+	_, err = suite.handler(suite.ctx, types.NewMsgConvertERC20(addr, tokenized))
+	suite.Require().NoError(err)
+	suite.Require().Equal(tokenized.Amount, suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetCoins().AmountOf(tokenized.Denom))
+
+	// the tokenized deposit is redeemed into the deposit
+	suite.Require().NoError(stakingKeeper.RedeemTokenizedDeposit(suite.ctx, addr, tokenized))
+	delegator, found := stakingKeeper.GetDelegator(suite.ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(deposit.Amount, delegator.Tokens)
+}
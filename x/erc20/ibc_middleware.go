@@ -0,0 +1,183 @@
+package erc20
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/05-port/types"
+	"github.com/okx/okbchain/libs/ibc-go/modules/core/exported"
+	"github.com/okx/okbchain/x/erc20/keeper"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// DefaultCallbackGasLimit is the gas limit of the contract callbacks executed on the acknowledgement
+// or timeout of the ibc transfers
+const DefaultCallbackGasLimit uint64 = 1000000
+
+// IBCMiddleware implements the ICS26 callbacks which call back the evm and wasm contracts with the
+// results of the ibc transfers they sent, given the erc20 keeper and the underlying transfer application.
+type IBCMiddleware struct {
+	app    porttypes.Middleware
+	keeper keeper.Keeper
+
+	callbackGasLimit uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the underlying transfer application
+func NewIBCMiddleware(app porttypes.Middleware, k keeper.Keeper, callbackGasLimit uint64) IBCMiddleware {
+	return IBCMiddleware{
+		app:              app,
+		keeper:           k,
+		callbackGasLimit: callbackGasLimit,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface.
+// Once the underlying application has processed the acknowledgement, the contract which sent
+// the packet is called back with the result.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.Marshal.GetProtocMarshal().UnmarshalJSON(acknowledgement, &ack); err != nil {
+		// the underlying application has accepted the acknowledgement, so it is not expected to happen
+		im.keeper.Logger(ctx).Error("cannot unmarshal ICS-20 transfer packet acknowledgement", "error", err)
+		return nil
+	}
+
+	errMsg := ""
+	if !ack.Success() {
+		errMsg = ack.GetError()
+	}
+	im.keeper.OnPacketResult(ctx, packet, errMsg, im.callbackGasLimit)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface.
+// Once the underlying application has refunded the sender, the contract which sent the packet
+// is called back with the timeout.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnPacketResult(ctx, packet, "packet timed out", im.callbackGasLimit)
+	return nil
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+) error {
+	return im.app.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.app.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.app.GetAppVersion(ctx, portID, channelID)
+}
+
+func (im IBCMiddleware) NegotiateAppVersion(ctx sdk.Context, order channeltypes.Order, connectionID string, portID string, counterparty channeltypes.Counterparty, proposedVersion string) (version string, err error) {
+	return im.app.NegotiateAppVersion(ctx, order, connectionID, portID, counterparty, proposedVersion)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	"github.com/okx/okbchain/x/erc20/types"
+)
+
+// ConvertCoinToERC20 converts the coin of the account into the evm token of the contract mapped to its denom.
+// The ibc vouchers are locked in the contract address, while the natives are burnt
+func (k Keeper) ConvertCoinToERC20(ctx sdk.Context, from sdk.AccAddress, coin sdk.SysCoin) error {
+	if types.IsValidIBCDenom(coin.Denom) {
+		return k.ConvertVoucherToERC20(ctx, from, coin, false)
+	}
+
+	contract, found := k.GetContractByDenom(ctx, coin.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoContractDeployed, "no contract mapped to denom %s", coin.Denom)
+	}
+	return k.ConvertNativeToERC20(ctx, from, coin, contract)
+}
+
+// ConvertERC20ToCoin burns the evm token of the contract mapped to the denom of the coin, and gives the coin back to
+// the account. The ibc vouchers are unlocked from the contract address, while the natives are minted
+func (k Keeper) ConvertERC20ToCoin(ctx sdk.Context, from sdk.AccAddress, coin sdk.SysCoin) error {
+	k.Logger(ctx).Info("convert evm tokens into coin",
+		"fromBech32", from.String(),
+		"fromEth", common.BytesToAddress(from.Bytes()).String(),
+		"coin", coin.String())
+
+	contract, found := k.GetContractByDenom(ctx, coin.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoContractDeployed, "no contract mapped to denom %s", coin.Denom)
+	}
+
+	// 1. call contract, burn token of user address in contract
+	if _, err := k.CallModuleERC20(
+		ctx,
+		contract,
+		types.ContractBurnMethod,
+		common.BytesToAddress(from.Bytes()),
+		coin.Amount.BigInt()); err != nil {
+		return err
+	}
+
+	// 2. give the coin back to user address in bank
+	coins := sdk.NewCoins(coin)
+	var event sdk.Event
+	if types.IsValidIBCDenom(coin.Denom) {
+		if err := k.bankKeeper.SendCoins(ctx, sdk.AccAddress(contract.Bytes()), from, coins); err != nil {
+			return err
+		}
+		event = sdk.NewEvent(
+			types.EventTypUnlock,
+			sdk.NewAttribute(types.AttributeKeyFrom, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyTo, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+		)
+	} else {
+		if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, from, coins); err != nil {
+			return err
+		}
+		event = sdk.NewEvent(
+			types.EventTypMint,
+			sdk.NewAttribute(types.AttributeKeyTo, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+		)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypCallModuleERC20,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyContractMethod, types.ContractBurnMethod),
+			sdk.NewAttribute(types.AttributeKeyFrom, from.String()),
+			sdk.NewAttribute(ibctransfertypes.AttributeKeyAmount, coin.Amount.BigInt().String()),
+		),
+		event,
+	})
+	return nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestConvertCoinAndERC20() {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr1Bech := sdk.AccAddress(addr1.Bytes())
+	amountDec := sdk.NewDec(123)
+
+	testCases := []struct {
+		msg   string
+		denom string
+	}{
+		{"native coin", NativeDenom},
+		{"ibc voucher", CorrectIbcDenom},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			coin := sdk.NewDecCoinFromDec(tc.denom, amountDec)
+
+			// no contract mapped to the denom
+			suite.Require().Error(suite.app.Erc20Keeper.ConvertCoinToERC20(suite.ctx, addr1Bech, coin))
+			suite.Require().Error(suite.app.Erc20Keeper.ConvertERC20ToCoin(suite.ctx, addr1Bech, coin))
+
+			suite.Require().NoError(suite.MintCoins(addr1Bech, sdk.NewCoins(coin)))
+			evmParams := evmtypes.DefaultParams()
+			evmParams.EnableCreate = true
+			evmParams.EnableCall = true
+			suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+			suite.app.Erc20Keeper.InitInternalTemplateContract(suite.ctx)
+			contract, err := suite.app.Erc20Keeper.DeployModuleERC20(suite.ctx, tc.denom)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.app.Erc20Keeper.SetContractForDenom(suite.ctx, tc.denom, contract))
+
+			// 1. convert the coin into evm tokens
+			suite.Require().NoError(suite.app.Erc20Keeper.ConvertCoinToERC20(suite.ctx, addr1Bech, coin))
+			suite.Require().True(suite.GetBalance(addr1Bech, tc.denom).Amount.IsZero())
+			ret, err := suite.app.Erc20Keeper.CallModuleERC20(suite.ctx, contract, "balanceOf", addr1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(amountDec.BigInt(), big.NewInt(0).SetBytes(ret))
+
+			// 2. convert more evm tokens than held
+			more := sdk.NewDecCoinFromDec(tc.denom, amountDec.Add(sdk.OneDec()))
+			suite.Require().Error(suite.app.Erc20Keeper.ConvertERC20ToCoin(suite.ctx, addr1Bech, more))
+
+			// 3. convert the evm tokens back into the coin
+			suite.Require().NoError(suite.app.Erc20Keeper.ConvertERC20ToCoin(suite.ctx, addr1Bech, coin))
+			suite.Require().Equal(amountDec, suite.GetBalance(addr1Bech, tc.denom).Amount)
+			ret, err = suite.app.Erc20Keeper.CallModuleERC20(suite.ctx, contract, "balanceOf", addr1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(0, big.NewInt(0).SetBytes(ret).Sign())
+		})
+	}
+}
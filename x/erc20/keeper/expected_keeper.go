@@ -9,6 +9,7 @@ import (
 	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
 	tmbytes "github.com/okx/okbchain/libs/tendermint/libs/bytes"
 	govtypes "github.com/okx/okbchain/x/gov/types"
+	wasmtypes "github.com/okx/okbchain/x/wasm/types"
 )
 
 // GovKeeper defines the expected gov Keeper
@@ -53,6 +54,28 @@ type TransferKeeper interface {
 		timeoutHeight clienttypes.Height,
 		timeoutTimestamp uint64,
 	) error
+	SendTransferWithMemo(
+		ctx sdk.Context,
+		sourcePort,
+		sourceChannel string,
+		token sdk.CoinAdapter,
+		sender sdk.AccAddress,
+		receiver string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		memo string,
+	) error
 	DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool)
 }
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// WasmKeeper defines the expected wasm keeper which calls back the wasm contracts
+type WasmKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.WasmAddress, msg []byte) ([]byte, error)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.WasmAddress) *wasmtypes.ContractInfo
+}
@@ -11,4 +11,5 @@ type EvmKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	AddInnerTx(...interface{})
 	AddContract(...interface{})
+	IsContractAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 }
@@ -422,3 +422,29 @@ func (k Keeper) ibcSendTransferWithChannel(ctx sdk.Context, sender sdk.AccAddres
 		timeoutTimestamp,
 	)
 }
+
+// IbcTransfer sends the token of the sender to the receiver through the ibc channel, and returns the sequence
+// of the packet. The timeout of the erc20 params is used if neither the timeout height nor the timeout
+// timestamp is set.
+func (k Keeper) IbcTransfer(ctx sdk.Context, sender sdk.AccAddress, portID, channelID string, token sdk.CoinAdapter,
+	receiver string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, memo string) (uint64, error) {
+	if k.channelKeeper == nil {
+		return 0, errors.New("ibc channel keeper is not set")
+	}
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + k.GetParams(ctx).IbcTimeout
+	}
+
+	if err := k.transferKeeper.SendTransferWithMemo(
+		ctx, portID, channelID, token, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+	); err != nil {
+		return 0, err
+	}
+
+	// the sequence has been increased by the packet just sent
+	nextSequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found || nextSequence == 0 {
+		return 0, fmt.Errorf("sequence send not found, port %s channel %s", portID, channelID)
+	}
+	return nextSequence - 1, nil
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/erc20/types"
+	"github.com/okx/okbchain/x/evm/watcher"
+)
+
+// SetPacketCallback records the contract which is called back once the packet is acknowledged or timed out
+func (k Keeper) SetPacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64, contract sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketCallbackKey(portID, channelID, sequence), contract.Bytes())
+}
+
+// GetPacketCallback returns the contract which is called back once the packet is acknowledged or timed out
+func (k Keeper) GetPacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketCallbackKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// DeletePacketCallback deletes the contract which is called back once the packet is acknowledged or timed out
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketCallbackKey(portID, channelID, sequence))
+}
+
+// isCallbackContract returns true if the address is an evm or wasm contract which can be called back
+func (k Keeper) isCallbackContract(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.evmKeeper.IsContractAccount(ctx, addr) {
+		return true
+	}
+	return k.wasmKeeper != nil && k.wasmKeeper.GetContractInfo(ctx, sdk.AccToAWasmddress(addr)) != nil
+}
+
+// recordPacketCallback records the contract sending the latest packet through the channel, so that the
+// contract is called back with the result of the transfer. The contracts are only called back from venus8 on.
+func (k Keeper) recordPacketCallback(ctx sdk.Context, portID, channelID string, sender sdk.AccAddress) {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		return
+	}
+	if k.channelKeeper == nil || !k.isCallbackContract(ctx, sender) {
+		return
+	}
+	// the sequence has been increased by the packet just sent
+	nextSequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found || nextSequence == 0 {
+		return
+	}
+	k.SetPacketCallback(ctx, portID, channelID, nextSequence-1, sender)
+}
+
+// OnPacketResult calls back the contract which sent the packet with the result of the ibc transfer. The
+// callback is executed with the gas limit, its failure doesn't affect the acknowledgement or timeout of the
+// packet, the state changes of the callback are discarded and the error is emitted instead.
+func (k Keeper) OnPacketResult(ctx sdk.Context, packet channeltypes.Packet, errMsg string, gasLimit uint64) {
+	contract, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contract.String()),
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", errMsg == "")),
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data)
+	if err == nil {
+		result := types.IbcTransferResult{
+			ChannelID: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+			Sender:    data.Sender,
+			Receiver:  data.Receiver,
+			Denom:     data.Denom,
+			Amount:    data.Amount,
+			Success:   errMsg == "",
+			Error:     errMsg,
+		}
+		err = k.callbackWithGasLimit(ctx, contract, result, gasLimit)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("ibc transfer callback failed", "contract", contract.String(),
+			"channel", packet.SourceChannel, "sequence", packet.Sequence, "error", err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypIbcCallback, attributes...))
+}
+
+// callbackWithGasLimit calls back the contract in a cached context with the gas limit applied
+func (k Keeper) callbackWithGasLimit(ctx sdk.Context, contract sdk.AccAddress, result types.IbcTransferResult, gasLimit uint64) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	limitedMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx.SetGasMeter(limitedMeter)
+
+	// catch out of gas panic and just charge the entire gas limit
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ctx.GasMeter().ConsumeGas(gasLimit, "ibc callback OutOfGas panic")
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "ibc callback hit gas limit")
+		}
+	}()
+
+	if k.wasmKeeper != nil && k.wasmKeeper.GetContractInfo(cacheCtx, sdk.AccToAWasmddress(contract)) != nil {
+		err = k.callbackWasm(cacheCtx, contract, result)
+	} else {
+		err = k.callbackEvm(cacheCtx, contract, result)
+	}
+
+	// make sure we charge the parent what was spent
+	ctx.GasMeter().ConsumeGas(limitedMeter.GasConsumed(), "ibc callback")
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+	return nil
+}
+
+func (k Keeper) callbackWasm(ctx sdk.Context, contract sdk.AccAddress, result types.IbcTransferResult) error {
+	msg, err := result.GetSudoMsg()
+	if err != nil {
+		return err
+	}
+	_, err = k.wasmKeeper.Sudo(ctx, sdk.AccToAWasmddress(contract), msg)
+	return err
+}
+
+func (k Keeper) callbackEvm(ctx sdk.Context, contract sdk.AccAddress, result types.IbcTransferResult) error {
+	sender, err := sdk.AccAddressFromBech32(result.Sender)
+	if err != nil {
+		return err
+	}
+	amount, ok := new(big.Int).SetString(result.Amount, 10)
+	if !ok {
+		return fmt.Errorf("invalid transfer amount %s", result.Amount)
+	}
+	input, err := result.GetEvmInput(common.BytesToAddress(sender.Bytes()), amount)
+	if err != nil {
+		return err
+	}
+
+	if watcher.IsWatcherEnabled() {
+		ctx.SetWatcher(watcher.NewTxWatcher())
+	}
+	to := common.BytesToAddress(contract.Bytes())
+	if _, _, err = k.callEvmByModule(ctx, &to, big.NewInt(0), input); err != nil {
+		return err
+	}
+	if watcher.IsWatcherEnabled() {
+		ctx.GetWatcher().Finalize()
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/erc20/keeper"
+	"github.com/okx/okbchain/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) findCallbackEvent(events sdk.Events) (sdk.Event, bool) {
+	for _, event := range events {
+		if event.Type == types.EventTypIbcCallback {
+			return event, true
+		}
+	}
+	return sdk.Event{}, false
+}
+
+func (suite *KeeperTestSuite) hasAttribute(event sdk.Event, key string) bool {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *KeeperTestSuite) TestRecordPacketCallback() {
+	k := suite.app.Erc20Keeper
+	hooks := keeper.NewIBCTransferHooks(k)
+	user := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	token := sdk.NewCoin("ibc/token", sdk.NewInt(1))
+
+	contract, err := k.DeployModuleERC20(suite.ctx, "native20")
+	suite.Require().NoError(err)
+	contractAddr := sdk.AccAddress(contract.Bytes())
+
+	suite.app.IBCKeeper.V2Keeper.ChannelKeeper.SetNextSequenceSend(suite.ctx, transfertypes.PortID, "channel-0", 3)
+
+	// the transfers sent by the users are not called back
+	suite.Require().NoError(hooks.AfterSendTransfer(suite.ctx, transfertypes.PortID, "channel-0", token, user, "receiver", true))
+	_, found := k.GetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().False(found)
+
+	// the contracts are not called back before venus8
+	suite.Require().NoError(hooks.AfterSendTransfer(suite.ctx, transfertypes.PortID, "channel-0", token, contractAddr, "receiver", true))
+	_, found = k.GetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().False(found)
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	suite.Require().NoError(hooks.AfterSendTransfer(suite.ctx, transfertypes.PortID, "channel-0", token, contractAddr, "receiver", true))
+	got, found := k.GetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(contractAddr, got)
+
+	k.DeletePacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	_, found = k.GetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestOnPacketResult() {
+	k := suite.app.Erc20Keeper
+	user := sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	data := transfertypes.NewFungibleTokenPacketData("transfer/channel-1/stake", "100", user.String(), "receiver")
+	newPacket := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "channel-0",
+			transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	// packets without callbacks are ignored
+	ctx := suite.ctx
+	ctx.SetEventManager(sdk.NewEventManager())
+	k.OnPacketResult(ctx, newPacket(1), "", 100000)
+	_, found := suite.findCallbackEvent(ctx.EventManager().Events())
+	suite.Require().False(found)
+
+	// the module erc20 contract doesn't implement the callback, the failure is emitted
+	contract, err := k.DeployModuleERC20(suite.ctx, "native20")
+	suite.Require().NoError(err)
+	k.SetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2, sdk.AccAddress(contract.Bytes()))
+	ctx.SetEventManager(sdk.NewEventManager())
+	k.OnPacketResult(ctx, newPacket(2), "", 100000)
+	event, found := suite.findCallbackEvent(ctx.EventManager().Events())
+	suite.Require().True(found)
+	suite.Require().True(suite.hasAttribute(event, types.AttributeKeyCallbackError))
+	_, found = k.GetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().False(found)
+
+	// the callback is executed within the gas limit
+	callee := common.BytesToAddress(ethsecp256k1.GenerateAddress().Bytes())
+	k.SetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 3, sdk.AccAddress(callee.Bytes()))
+	ctx.SetEventManager(sdk.NewEventManager())
+	k.OnPacketResult(ctx, newPacket(3), "packet timed out", 1)
+	event, found = suite.findCallbackEvent(ctx.EventManager().Events())
+	suite.Require().True(found)
+	suite.Require().True(suite.hasAttribute(event, types.AttributeKeyCallbackError))
+
+	k.SetPacketCallback(suite.ctx, transfertypes.PortID, "channel-0", 4, sdk.AccAddress(callee.Bytes()))
+	ctx.SetEventManager(sdk.NewEventManager())
+	k.OnPacketResult(ctx, newPacket(4), "packet timed out", 100000)
+	event, found = suite.findCallbackEvent(ctx.EventManager().Events())
+	suite.Require().True(found)
+	suite.Require().False(suite.hasAttribute(event, types.AttributeKeyCallbackError))
+}
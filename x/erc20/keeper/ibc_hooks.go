@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	packetforwardtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
 	trensferTypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
 	"github.com/okx/okbchain/x/erc20/types"
 	"github.com/okx/okbchain/x/evm/watcher"
@@ -35,6 +36,8 @@ func (iths IBCTransferHooks) AfterSendTransfer(
 		"sender", sender.String(),
 		"receiver", receiver,
 		"isSource", isSource)
+	// the contracts sending the transfers are called back with the results
+	iths.Keeper.recordPacketCallback(ctx, sourcePort, sourceChannel, sender)
 	return nil
 }
 
@@ -52,6 +55,11 @@ func (iths IBCTransferHooks) AfterRecvTransfer(
 		"token", token.String(),
 		"receiver", receiver,
 		"isSource", isSource)
+	// the funds held by the intermediate receiver of the packet forward middleware are going
+	// to be forwarded or refunded, so they must not be converted
+	if packetforwardtypes.IsForwarding(ctx) {
+		return nil
+	}
 	// only after minting vouchers on this chain
 	if watcher.IsWatcherEnabled() {
 		ctx.SetWatcher(watcher.NewTxWatcher())
@@ -88,6 +96,11 @@ func (iths IBCTransferHooks) AfterRefundTransfer(
 		"token", token.String(),
 		"sender", sender,
 		"isSource", isSource)
+	// the funds held by the intermediate receiver of the packet forward middleware are going
+	// to be forwarded or refunded, so they must not be converted
+	if packetforwardtypes.IsForwarding(ctx) {
+		return nil
+	}
 	// only after minting vouchers on this chain
 	if watcher.IsWatcherEnabled() {
 		ctx.SetWatcher(watcher.NewTxWatcher())
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	packetforwardtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/packet-forward-middleware/types"
+	erc20Keeper "github.com/okx/okbchain/x/erc20/keeper"
+	"github.com/okx/okbchain/x/erc20/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestIBCTransferHooksForwarding() {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr1Bech := sdk.AccAddress(addr1.Bytes())
+	amountDec := sdk.NewDec(123)
+	coin := sdk.NewCoin(CorrectIbcDenom, amountDec)
+
+	testCases := []struct {
+		msg        string
+		forwarding bool
+		hook       func(hooks erc20Keeper.IBCTransferHooks, ctx sdk.Context) error
+	}{
+		{
+			"receive vouchers",
+			false,
+			func(hooks erc20Keeper.IBCTransferHooks, ctx sdk.Context) error {
+				return hooks.AfterRecvTransfer(ctx, "transfer", "channel-0", coin, addr1Bech.String(), false)
+			},
+		},
+		{
+			"receive vouchers to forward",
+			true,
+			func(hooks erc20Keeper.IBCTransferHooks, ctx sdk.Context) error {
+				return hooks.AfterRecvTransfer(ctx, "transfer", "channel-0", coin, addr1Bech.String(), false)
+			},
+		},
+		{
+			"refund vouchers",
+			false,
+			func(hooks erc20Keeper.IBCTransferHooks, ctx sdk.Context) error {
+				return hooks.AfterRefundTransfer(ctx, "transfer", "channel-0", coin, addr1Bech.String(), false)
+			},
+		},
+		{
+			"refund vouchers to forward again",
+			true,
+			func(hooks erc20Keeper.IBCTransferHooks, ctx sdk.Context) error {
+				return hooks.AfterRefundTransfer(ctx, "transfer", "channel-0", coin, addr1Bech.String(), false)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.MintCoins(addr1Bech, sdk.NewCoins(coin)))
+
+			params := types.DefaultParams()
+			params.EnableAutoDeployment = true
+			suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			suite.app.Erc20Keeper.InitInternalTemplateContract(suite.ctx)
+
+			evmParams := evmtypes.DefaultParams()
+			evmParams.EnableCreate = true
+			evmParams.EnableCall = true
+			suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+
+			ctx := suite.ctx
+			if tc.forwarding {
+				ctx = packetforwardtypes.WithForwarding(ctx)
+			}
+			suite.Require().NoError(tc.hook(erc20Keeper.NewIBCTransferHooks(suite.app.Erc20Keeper), ctx))
+
+			_, found := suite.app.Erc20Keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+			if tc.forwarding {
+				// the vouchers held by the intermediate receiver are left untouched
+				suite.Require().False(found)
+				suite.Require().Equal(coin, suite.GetBalance(addr1Bech, CorrectIbcDenom))
+			} else {
+				suite.Require().True(found)
+				suite.Require().True(suite.GetBalance(addr1Bech, CorrectIbcDenom).Amount.IsZero())
+			}
+		})
+	}
+}
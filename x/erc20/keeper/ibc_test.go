@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	transfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
 	erc20Keeper "github.com/okx/okbchain/x/erc20/keeper"
 	"github.com/okx/okbchain/x/erc20/types"
 	evmtypes "github.com/okx/okbchain/x/evm/types"
@@ -290,6 +292,7 @@ func (suite *KeeperTestSuite) TestIbcTransferVouchers() {
 				suite.app.BankKeeper,
 				suite.app.EvmKeeper,
 				IbcKeeperMock{},
+				suite.app.IBCKeeper.V2Keeper.ChannelKeeper,
 			)
 			suite.app.Erc20Keeper = erc20Keeper
 
@@ -441,3 +444,59 @@ func (suite *KeeperTestSuite) TestConvertNatives() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestIbcTransfer() {
+	sender := sdk.AccAddress(common.BigToAddress(big.NewInt(1)).Bytes())
+	token := sdk.NewCoinAdapter(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expSequence uint64
+		expError    bool
+	}{
+		{
+			"sequence not found",
+			func() {},
+			0,
+			true,
+		},
+		{
+			"success",
+			func() {
+				// the mock transfer keeper doesn't send the packet, so the sequence isn't increased
+				suite.app.IBCKeeper.V2Keeper.ChannelKeeper.SetNextSequenceSend(suite.ctx, transfertypes.PortID, "channel-0", 5)
+			},
+			4,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			// Create erc20 Keeper with mock transfer keeper
+			erc20Keeper := erc20Keeper.NewKeeper(
+				suite.app.Codec(),
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetSubspace(types.ModuleName),
+				suite.app.AccountKeeper,
+				suite.app.SupplyKeeper,
+				suite.app.BankKeeper,
+				suite.app.EvmKeeper,
+				IbcKeeperMock{},
+				suite.app.IBCKeeper.V2Keeper.ChannelKeeper,
+			)
+
+			tc.malleate()
+			sequence, err := erc20Keeper.IbcTransfer(suite.ctx, sender, transfertypes.PortID, "channel-0", token,
+				"receiver", clienttypes.ZeroHeight(), 0, "memo")
+			if tc.expError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSequence, sequence)
+			}
+		})
+	}
+}
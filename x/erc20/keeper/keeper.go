@@ -25,13 +25,15 @@ type Keeper struct {
 	govKeeper      GovKeeper
 	evmKeeper      EvmKeeper
 	transferKeeper TransferKeeper
+	channelKeeper  ChannelKeeper
+	wasmKeeper     WasmKeeper
 }
 
 // NewKeeper generates new erc20 module keeper
 func NewKeeper(
 	cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
 	ak AccountKeeper, sk SupplyKeeper, bk BankKeeper,
-	ek EvmKeeper, tk TransferKeeper) Keeper {
+	ek EvmKeeper, tk TransferKeeper, ck ChannelKeeper) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -46,6 +48,7 @@ func NewKeeper(
 		bankKeeper:     bk,
 		evmKeeper:      ek,
 		transferKeeper: tk,
+		channelKeeper:  ck,
 	}
 }
 
@@ -59,6 +62,11 @@ func (k *Keeper) SetGovKeeper(gk GovKeeper) {
 	k.govKeeper = gk
 }
 
+// SetWasmKeeper sets the wasm keeper which calls back the wasm contracts sending ibc transfers
+func (k *Keeper) SetWasmKeeper(wk WasmKeeper) {
+	k.wasmKeeper = wk
+}
+
 // SetContractForDenom set the contract for native denom,
 // 1. if any existing for denom, replace the old one.
 // 2. if any existing for contract, return error.
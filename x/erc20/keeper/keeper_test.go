@@ -77,6 +77,11 @@ func (i IbcKeeperMock) SendTransfer(ctx sdk.Context, sourcePort, sourceChannel s
 	return nil
 }
 
+func (i IbcKeeperMock) SendTransferWithMemo(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.CoinAdapter,
+	sender sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string) error {
+	return nil
+}
+
 func (i IbcKeeperMock) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) { //nolint
 	if denom == "ibc/ddcd907790b8aa2bf9b2b3b614718fa66bfc7540e832ce3e3696ea717dceff49" {
 		return "transfer/channel-0", nil
@@ -44,6 +44,7 @@ func (suite *KeeperTestSuite) TestGetSourceChannelID() {
 				suite.app.BankKeeper,
 				suite.app.EvmKeeper,
 				IbcKeeperMock{},
+				suite.app.IBCKeeper.V2Keeper.ChannelKeeper,
 			)
 			suite.app.Erc20Keeper = erc20Keeper
 
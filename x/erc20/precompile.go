@@ -0,0 +1,106 @@
+package erc20
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	ibctransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/transfer/types"
+	ibcclienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	"github.com/okx/okbchain/x/erc20/keeper"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+const (
+	PrecompileTransfer = "transfer"
+)
+
+var (
+	PrecompileABI evmtypes.ABI
+
+	//go:embed precompile.json
+	precompileJson []byte
+
+	precompileGas = map[string]uint64{
+		PrecompileTransfer: 50000,
+	}
+)
+
+func init() {
+	ret, err := abi.JSON(bytes.NewReader(precompileJson))
+	if err != nil {
+		panic(err)
+	}
+	PrecompileABI = evmtypes.ABI{ABI: &ret}
+}
+
+var _ evmtypes.Precompile = Precompile{}
+
+// Precompile lets evm contracts send the coins of the caller to other chains through ibc transfer
+type Precompile struct {
+	k keeper.Keeper
+}
+
+// NewPrecompile creates a new ics-20 transfer precompile
+func NewPrecompile(k keeper.Keeper) Precompile {
+	return Precompile{k: k}
+}
+
+// ABI returns the abi of the ics-20 transfer precompile
+func (p Precompile) ABI() evmtypes.ABI {
+	return PrecompileABI
+}
+
+// RequiredGas returns the gas charged before the transfer method is run
+func (p Precompile) RequiredGas(method *abi.Method) uint64 {
+	return precompileGas[method.Name]
+}
+
+// Run sends the coin of the caller through the ibc channel and returns the sequence of the packet
+func (p Precompile) Run(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}) ([]byte, error) {
+	if method.Name != PrecompileTransfer {
+		return nil, fmt.Errorf("erc20 precompile: unknown method %s", method.Name)
+	}
+
+	timeoutHeight, ok := abi.ConvertType(args[5], new(ibcclienttypes.Height)).(*ibcclienttypes.Height)
+	if !ok {
+		return nil, fmt.Errorf("invalid timeout height %v", args[5])
+	}
+	// the amount is in the minimum unit of the coin, the same as the amount of the erc20 tokens
+	msg := ibctransfertypes.MsgTransfer{
+		SourcePort:       args[0].(string),
+		SourceChannel:    args[1].(string),
+		Token:            sdk.NewCoinAdapter(args[2].(string), sdk.NewIntFromBigInt(args[3].(*big.Int))),
+		Sender:           sdk.AccAddress(caller.Bytes()).String(),
+		Receiver:         args[4].(string),
+		TimeoutHeight:    *timeoutHeight,
+		TimeoutTimestamp: args[6].(uint64),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sequence, err := p.k.IbcTransfer(ctx, caller.Bytes(), msg.SourcePort, msg.SourceChannel, msg.Token,
+		msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, args[7].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ibctransfertypes.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(ibctransfertypes.AttributeKeyReceiver, msg.Receiver),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ibctransfertypes.ModuleName),
+		),
+	})
+
+	return method.Outputs.Pack(sequence)
+}
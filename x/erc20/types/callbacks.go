@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// IbcCallbackMethodName is the method of the evm contract which receives the result of the ibc transfer
+	IbcCallbackMethodName = "onIbcTransferResult"
+
+	ibcCallbackABIJson = `[{
+		"inputs": [
+			{"internalType": "address", "name": "sender", "type": "address"},
+			{"internalType": "string", "name": "receiver", "type": "string"},
+			{"internalType": "string", "name": "denom", "type": "string"},
+			{"internalType": "uint256", "name": "amount", "type": "uint256"},
+			{"internalType": "string", "name": "channelId", "type": "string"},
+			{"internalType": "uint64", "name": "sequence", "type": "uint64"},
+			{"internalType": "bool", "name": "success", "type": "bool"},
+			{"internalType": "string", "name": "error", "type": "string"}
+		],
+		"name": "onIbcTransferResult",
+		"outputs": [],
+		"stateMutability": "nonpayable",
+		"type": "function"
+	}]`
+)
+
+// IbcCallbackABI is the abi of the callback method implemented by the evm contracts sending ibc transfers
+var IbcCallbackABI abi.ABI
+
+func init() {
+	var err error
+	if IbcCallbackABI, err = abi.JSON(strings.NewReader(ibcCallbackABIJson)); err != nil {
+		panic(err)
+	}
+}
+
+// IbcTransferResult is the result of an ibc transfer delivered to the contract which sent it
+type IbcTransferResult struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	Sender    string `json:"sender"`
+	Receiver  string `json:"receiver"`
+	Denom     string `json:"denom"`
+	Amount    string `json:"amount"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error"`
+}
+
+// GetEvmInput returns the input of the evm callback method
+func (r IbcTransferResult) GetEvmInput(sender common.Address, amount *big.Int) ([]byte, error) {
+	return IbcCallbackABI.Pack(IbcCallbackMethodName, sender, r.Receiver, r.Denom, amount, r.ChannelID, r.Sequence, r.Success, r.Error)
+}
+
+// GetSudoMsg returns the sudo msg which delivers the result to the wasm contract
+func (r IbcTransferResult) GetSudoMsg() ([]byte, error) {
+	msg := struct {
+		Result IbcTransferResult `json:"ibc_transfer_result"`
+	}{
+		Result: r,
+	}
+	return json.Marshal(msg)
+}
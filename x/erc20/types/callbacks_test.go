@@ -0,0 +1,35 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIbcTransferResult(t *testing.T) {
+	result := IbcTransferResult{
+		ChannelID: "channel-0",
+		Sequence:  1,
+		Sender:    "sender",
+		Receiver:  "receiver",
+		Denom:     "transfer/channel-1/stake",
+		Amount:    "100",
+		Success:   false,
+		Error:     "packet timed out",
+	}
+
+	msg, err := result.GetSudoMsg()
+	require.NoError(t, err)
+	require.Equal(t, `{"ibc_transfer_result":{"channel_id":"channel-0","sequence":1,"sender":"sender","receiver":"receiver","denom":"transfer/channel-1/stake","amount":"100","success":false,"error":"packet timed out"}}`, string(msg))
+
+	sender := common.HexToAddress("0x01")
+	input, err := result.GetEvmInput(sender, big.NewInt(100))
+	require.NoError(t, err)
+	method := IbcCallbackABI.Methods[IbcCallbackMethodName]
+	require.Equal(t, method.ID, input[:4])
+	args, err := method.Inputs.Unpack(input[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{sender, "receiver", "transfer/channel-1/stake", big.NewInt(100), "channel-0", uint64(1), false, "packet timed out"}, args)
+}
@@ -18,6 +18,8 @@ const (
 // RegisterCodec registers all the necessary types and interfaces for the
 // erc20 module
 func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgConvertCoin{}, system.Chain+"/erc20/MsgConvertCoin", nil)
+	cdc.RegisterConcrete(MsgConvertERC20{}, system.Chain+"/erc20/MsgConvertERC20", nil)
 	cdc.RegisterConcrete(TokenMappingProposal{}, TokenMappingProposalName, nil)
 
 	cdc.RegisterConcrete(ProxyContractRedirectProposal{}, ProxyContractRedirectProposalName, nil)
@@ -35,6 +35,7 @@ const (
 	IbcEvmModuleName = "ibc-evm"
 
 	ContractMintMethod = "mint_by_okbc_module"
+	ContractBurnMethod = "burn_by_okbc_module"
 
 	ProxyContractUpgradeTo   = "upgradeTo"
 	ProxyContractChangeAdmin = "changeAdmin"
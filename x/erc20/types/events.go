@@ -5,11 +5,18 @@ const (
 	EventTypCallModuleERC20   = "call_erc20_contract"
 	EventTypLock              = "erc20_lock"
 	EventTypBurn              = "erc20_burn"
+	EventTypUnlock            = "erc20_unlock"
+	EventTypMint              = "erc20_mint"
+	EventTypIbcCallback       = "erc20_ibc_callback"
 
 	AttributeKeyContractAddr   = "contract_address"
 	AttributeKeyContractMethod = "contract_method"
 	AttributeKeyFrom           = "from"
 	AttributeKeyTo             = "to"
+	AttributeKeyChannel        = "channel"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyCallbackError  = "callback_error"
 
 	InnerTxUnlock    = "erc20-unlock"
 	InnerTxMint      = "erc20-mint"
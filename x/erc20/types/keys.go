@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/binary"
+	"fmt"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "erc20"
@@ -22,6 +27,7 @@ var (
 	KeyPrefixContractToDenom  = []byte{0x01}
 	KeyPrefixDenomToContract  = []byte{0x02}
 	KeyPrefixTemplateContract = []byte{0x03}
+	KeyPrefixPacketCallback   = []byte{0x04}
 )
 
 // ContractToDenomKey defines the store key for contract to denom reverse index
@@ -37,3 +43,11 @@ func DenomToContractKey(denom string) []byte {
 func ConstructContractKey(str string) []byte {
 	return append(KeyPrefixTemplateContract, []byte(str)...)
 }
+
+// PacketCallbackKey defines the store key for the contract which is called back
+// once the packet sent from the port and channel is acknowledged or timed out
+func PacketCallbackKey(portID, channelID string, sequence uint64) []byte {
+	seq := make([]byte, 8)
+	binary.BigEndian.PutUint64(seq, sequence)
+	return append(append(KeyPrefixPacketCallback, fmt.Sprintf("%s/%s/", portID, channelID)...), seq...)
+}
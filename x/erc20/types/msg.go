@@ -1 +1,99 @@
 package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgConvertCoin  = "convert_coin"
+	TypeMsgConvertERC20 = "convert_erc20"
+)
+
+var (
+	_ sdk.Msg = MsgConvertCoin{}
+	_ sdk.Msg = MsgConvertERC20{}
+)
+
+// MsgConvertCoin converts the coin of the sender into the evm token of the contract mapped to its denom
+type MsgConvertCoin struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Coin   sdk.SysCoin    `json:"coin" yaml:"coin"`
+}
+
+// NewMsgConvertCoin creates a new instance of MsgConvertCoin
+func NewMsgConvertCoin(sender sdk.AccAddress, coin sdk.SysCoin) MsgConvertCoin {
+	return MsgConvertCoin{
+		Sender: sender,
+		Coin:   coin,
+	}
+}
+
+// Route returns the message route of MsgConvertCoin
+func (msg MsgConvertCoin) Route() string { return RouterKey }
+
+// Type returns the message type of MsgConvertCoin
+func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// ValidateBasic runs stateless checks on MsgConvertCoin
+func (msg MsgConvertCoin) ValidateBasic() error {
+	return validateConvert(msg.Sender, msg.Coin)
+}
+
+// GetSignBytes returns the bytes of MsgConvertCoin to sign over
+func (msg MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the signer of MsgConvertCoin
+func (msg MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgConvertERC20 converts the evm token of the contract mapped to the denom back into the coin of the sender
+type MsgConvertERC20 struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Coin   sdk.SysCoin    `json:"coin" yaml:"coin"`
+}
+
+// NewMsgConvertERC20 creates a new instance of MsgConvertERC20
+func NewMsgConvertERC20(sender sdk.AccAddress, coin sdk.SysCoin) MsgConvertERC20 {
+	return MsgConvertERC20{
+		Sender: sender,
+		Coin:   coin,
+	}
+}
+
+// Route returns the message route of MsgConvertERC20
+func (msg MsgConvertERC20) Route() string { return RouterKey }
+
+// Type returns the message type of MsgConvertERC20
+func (msg MsgConvertERC20) Type() string { return TypeMsgConvertERC20 }
+
+// ValidateBasic runs stateless checks on MsgConvertERC20
+func (msg MsgConvertERC20) ValidateBasic() error {
+	return validateConvert(msg.Sender, msg.Coin)
+}
+
+// GetSignBytes returns the bytes of MsgConvertERC20 to sign over
+func (msg MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the signer of MsgConvertERC20
+func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func validateConvert(sender sdk.AccAddress, coin sdk.SysCoin) error {
+	if sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if !coin.IsValid() || !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+	if coin.Denom == sdk.DefaultBondDenom || coin.Denom == sdk.DefaultIbcWei {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s is not supported for converting", coin.Denom)
+	}
+	return nil
+}
@@ -1 +1,40 @@
 package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+func TestMsgConvert(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	testCases := []struct {
+		name     string
+		sender   sdk.AccAddress
+		coin     sdk.SysCoin
+		expError bool
+	}{
+		{"valid", addr, sdk.NewDecCoinFromDec("stokb", sdk.NewDec(1)), false},
+		{"empty sender", nil, sdk.NewDecCoinFromDec("stokb", sdk.NewDec(1)), true},
+		{"zero amount", addr, sdk.NewDecCoinFromDec("stokb", sdk.ZeroDec()), true},
+		{"bond denom", addr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1)), true},
+	}
+
+	for _, tc := range testCases {
+		msgs := []sdk.Msg{NewMsgConvertCoin(tc.sender, tc.coin), NewMsgConvertERC20(tc.sender, tc.coin)}
+		for _, msg := range msgs {
+			err := msg.ValidateBasic()
+			if tc.expError {
+				require.Error(t, err, tc.name)
+			} else {
+				require.NoError(t, err, tc.name)
+				require.Equal(t, RouterKey, msg.Route())
+				require.Equal(t, []sdk.AccAddress{tc.sender}, msg.GetSigners())
+				require.NotEmpty(t, msg.GetSignBytes())
+			}
+		}
+	}
+}
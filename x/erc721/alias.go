@@ -0,0 +1,24 @@
+package erc721
+
+import (
+	"github.com/okx/okbchain/x/erc721/keeper"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+// nolint
+const (
+	ModuleName = types.ModuleName
+	StoreKey   = types.StoreKey
+	RouterKey  = types.RouterKey
+)
+
+// nolint
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+// nolint
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+)
@@ -0,0 +1,50 @@
+package erc721
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorUpdate {
+	for _, m := range data.ClassMappings {
+		if !types.IsVoucherClass(m.Class.ID) {
+			panic(fmt.Sprintf("Invalid class to map to contract: %s", m.Class.ID))
+		}
+		if !common.IsHexAddress(m.Contract) {
+			panic(fmt.Sprintf("Invalid contract address: %s", m.Contract))
+		}
+		if err := k.SetContractForClass(ctx, m.Class.ID, common.HexToAddress(m.Contract)); err != nil {
+			panic(err)
+		}
+		k.SetClassInfo(ctx, m.Class)
+	}
+
+	for _, t := range data.Tokens {
+		contract, found := k.GetContractByClass(ctx, t.ClassID)
+		if !found {
+			panic(fmt.Sprintf("Class of token %s is not mapped: %s", t.ID, t.ClassID))
+		}
+		k.SetTokenInfo(ctx, contract, t)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the erc721 module
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var tokens []types.TokenInfo
+	k.IterateTokens(ctx, func(token types.TokenInfo) bool {
+		tokens = append(tokens, token)
+		return false
+	})
+
+	return GenesisState{
+		ClassMappings: k.GetClassMappings(ctx),
+		Tokens:        tokens,
+	}
+}
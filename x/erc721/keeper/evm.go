@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	ethermint "github.com/okx/okbchain/app/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/erc721/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+// DeployModuleERC721 deploys a module erc721 contract for a voucher class, the class id is used as
+// both the name and the symbol of the contract.
+func (k Keeper) DeployModuleERC721(ctx sdk.Context, classID string) (common.Address, error) {
+	input, err := types.ModuleERC721Contract.ABI.Pack("", classID, classID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	data := append(common.Hex2Bytes(types.ModuleERC721Contract.Bin), input...)
+
+	_, res, err := k.callEvm(ctx, types.IbcNftEvmModuleETHAddr, nil, data)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return res.ContractAddress, nil
+}
+
+// CallModuleERC721 call a method of ModuleERC721 contract as the erc721 module
+func (k Keeper) CallModuleERC721(ctx sdk.Context, contract common.Address, method string, args ...interface{}) ([]byte, error) {
+	k.Logger(ctx).Info("call erc721 module contract", "contract", contract.String(), "method", method, "args", args)
+
+	data, err := types.ModuleERC721Contract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, res, err := k.callEvm(ctx, types.IbcNftEvmModuleETHAddr, &contract, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCallModuleERC721,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyContractMethod, method),
+		),
+	)
+	return res.Ret, nil
+}
+
+// callEvm execute an evm message on behalf of the caller, the caller is either the erc721 module
+// or the owner of the tokens who has been authenticated by the ibc nft-transfer module.
+func (k Keeper) callEvm(ctx sdk.Context, callerAddr common.Address, to *common.Address, data []byte) (*evmtypes.ExecutionResult, *evmtypes.ResultData, error) {
+	config, found := k.evmKeeper.GetChainConfig(ctx)
+	if !found {
+		return nil, nil, types.ErrChainConfigNotFound
+	}
+
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, callerAddr.Bytes())
+	if acc == nil {
+		acc = k.accountKeeper.NewAccountWithAddress(ctx, callerAddr.Bytes())
+	}
+	nonce := acc.GetSequence()
+	txHash := tmtypes.Tx(ctx.TxBytes()).Hash()
+	ethTxHash := common.BytesToHash(txHash)
+
+	gasLimit := ctx.GasMeter().Limit()
+	if gasLimit == sdk.NewInfiniteGasMeter().Limit() {
+		gasLimit = k.evmKeeper.GetParams(ctx).MaxGasLimitPerTx
+	}
+
+	st := evmtypes.StateTransition{
+		AccountNonce: nonce,
+		Price:        big.NewInt(0),
+		GasLimit:     gasLimit,
+		Recipient:    to,
+		Amount:       big.NewInt(0),
+		Payload:      data,
+		Csdb:         evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx),
+		ChainID:      chainIDEpoch,
+		TxHash:       &ethTxHash,
+		Sender:       callerAddr,
+		Simulate:     ctx.IsCheckTx(),
+		TraceTx:      false,
+		TraceTxLog:   false,
+	}
+
+	executionResult, resultData, err, innertxs, contracts := st.TransitionDb(ctx, config)
+	if !ctx.IsCheckTx() && !ctx.IsTraceTx() {
+		if innertxs != nil {
+			k.evmKeeper.AddInnerTx(ethTxHash.Hex(), innertxs)
+		}
+		if contracts != nil {
+			k.evmKeeper.AddContract(contracts)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st.Csdb.Commit(false) // write code to db
+
+	// the state transition may have created the caller account, reload it before bumping the nonce
+	temp := k.accountKeeper.GetAccount(ctx, callerAddr.Bytes())
+	if temp == nil {
+		temp = acc
+	}
+	if err := temp.SetSequence(nonce + 1); err != nil {
+		return nil, nil, err
+	}
+	k.accountKeeper.SetAccount(ctx, temp)
+
+	return executionResult, resultData, nil
+}
+
+// staticCallEvm executes a read-only evm call. The evm gas is limited by the gas left in the
+// context and charged to it afterwards.
+func (k Keeper) staticCallEvm(ctx sdk.Context, to common.Address, data []byte) ([]byte, error) {
+	config, found := k.evmKeeper.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	maxGas := k.evmKeeper.GetParams(ctx).MaxGasLimitPerTx
+	st := evmtypes.StateTransition{
+		Price:     big.NewInt(0),
+		Recipient: &to,
+		Amount:    big.NewInt(0),
+		Payload:   data,
+		Csdb:      evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx),
+		ChainID:   chainIDEpoch,
+		Sender:    types.IbcNftEvmModuleETHAddr,
+		Simulate:  true,
+	}
+
+	gasMeter := ctx.GasMeter()
+	st.GasLimit = maxGas
+	if limit := gasMeter.Limit(); limit != sdk.NewInfiniteGasMeter().Limit() {
+		if left := limit - gasMeter.GasConsumed(); left < st.GasLimit {
+			st.GasLimit = left
+		}
+	}
+
+	ret, gasConsumed, err := st.StaticCall(ctx, config)
+	gasMeter.ConsumeGas(gasConsumed, "evm static call")
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// hasEvmCode returns true if a contract is deployed at the address
+func (k Keeper) hasEvmCode(ctx sdk.Context, addr common.Address) bool {
+	csdb := evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx)
+	return csdb.GetCodeSize(addr) > 0
+}
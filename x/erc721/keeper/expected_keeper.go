@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authexported "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/exported"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+	wasmtypes "github.com/okx/okbchain/x/wasm/types"
+)
+
+// AccountKeeper defines the expected account keeper interface
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+	SetAccount(ctx sdk.Context, acc authexported.Account)
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+}
+
+// EvmKeeper defines the expected evm keeper interface
+type EvmKeeper interface {
+	GetChainConfig(ctx sdk.Context) (evmtypes.ChainConfig, bool)
+	GenerateCSDBParams() evmtypes.CommitStateDBParams
+	GetParams(ctx sdk.Context) evmtypes.Params
+	AddInnerTx(...interface{})
+	AddContract(...interface{})
+}
+
+// WasmViewKeeper defines the read only wasm keeper interface used to inspect cw721 contracts
+type WasmViewKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.WasmAddress, req []byte) ([]byte, error)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.WasmAddress) *wasmtypes.ContractInfo
+}
+
+// WasmKeeper defines the wasm keeper interface used to move cw721 tokens
+type WasmKeeper interface {
+	Execute(ctx sdk.Context, contractAddress sdk.WasmAddress, caller sdk.WasmAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+)
+
+// CallEvm exports callEvm for tests deploying erc721 contracts owned by users.
+func (k Keeper) CallEvm(ctx sdk.Context, callerAddr common.Address, to *common.Address, data []byte) (*evmtypes.ExecutionResult, *evmtypes.ResultData, error) {
+	return k.callEvm(ctx, callerAddr, to, data)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+// Keeper maps the non fungible token classes received through ibc to module erc721 contracts,
+// and moves the tokens of native erc721 and cw721 contracts for the ibc nft-transfer module.
+type Keeper struct {
+	cdc            *codec.Codec
+	storeKey       sdk.StoreKey
+	accountKeeper  AccountKeeper
+	evmKeeper      EvmKeeper
+	wasmViewKeeper WasmViewKeeper
+	wasmKeeper     WasmKeeper
+}
+
+// NewKeeper generates new erc721 module keeper
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey,
+	ak AccountKeeper, ek EvmKeeper, wvk WasmViewKeeper) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		accountKeeper:  ak,
+		evmKeeper:      ek,
+		wasmViewKeeper: wvk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetWasmKeeper sets the wasm keeper which executes cw721 contracts
+func (k *Keeper) SetWasmKeeper(wk WasmKeeper) {
+	k.wasmKeeper = wk
+}
+
+// SetContractForClass set the module contract for a voucher class,
+// returns error if the contract is mapped to any class already.
+func (k Keeper) SetContractForClass(ctx sdk.Context, classID string, contract common.Address) error {
+	if class, found := k.GetClassByContract(ctx, contract); found {
+		return sdkerrors.Wrapf(types.ErrRegisteredContract, "contract %s of class %s", contract, class)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClassToContractKey(classID), contract.Bytes())
+	store.Set(types.ContractToClassKey(contract.Bytes()), []byte(classID))
+	return nil
+}
+
+// GetContractByClass find the module contract of a voucher class
+func (k Keeper) GetContractByClass(ctx sdk.Context, classID string) (contract common.Address, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClassToContractKey(classID))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// GetClassByContract find the voucher class by module contract address
+func (k Keeper) GetClassByContract(ctx sdk.Context, contract common.Address) (classID string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ContractToClassKey(contract.Bytes()))
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IterateMapping iterates over all the stored mapping and performs a callback function
+func (k Keeper) IterateMapping(ctx sdk.Context, cb func(classID string, contract common.Address) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixClassToContract)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		classID := string(iterator.Key()[len(types.KeyPrefixClassToContract):])
+		contract := common.BytesToAddress(iterator.Value())
+
+		if cb(classID, contract) {
+			break
+		}
+	}
+}
+
+// SetClassInfo stores the metadata of a voucher class
+func (k Keeper) SetClassInfo(ctx sdk.Context, class types.ClassInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClassKey(class.ID), k.cdc.MustMarshalBinaryBare(class))
+}
+
+// GetClassInfo returns the metadata of a voucher class
+func (k Keeper) GetClassInfo(ctx sdk.Context, classID string) (class types.ClassInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClassKey(classID))
+	if len(bz) == 0 {
+		return types.ClassInfo{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &class)
+	return class, true
+}
+
+// SetTokenInfo stores the metadata of a voucher token and indexes it by its evm token id
+func (k Keeper) SetTokenInfo(ctx sdk.Context, contract common.Address, token types.TokenInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TokenKey(token.ClassID, token.ID), k.cdc.MustMarshalBinaryBare(token))
+	store.Set(types.EvmIDToTokenKey(contract.Bytes(), types.EvmTokenID(token.ID).Bytes()), []byte(token.ID))
+}
+
+// GetTokenInfo returns the metadata of a voucher token
+func (k Keeper) GetTokenInfo(ctx sdk.Context, classID, tokenID string) (token types.TokenInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenKey(classID, tokenID))
+	if len(bz) == 0 {
+		return types.TokenInfo{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &token)
+	return token, true
+}
+
+// DeleteTokenInfo deletes the metadata of a voucher token with its evm token id index
+func (k Keeper) DeleteTokenInfo(ctx sdk.Context, contract common.Address, classID, tokenID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TokenKey(classID, tokenID))
+	store.Delete(types.EvmIDToTokenKey(contract.Bytes(), types.EvmTokenID(tokenID).Bytes()))
+}
+
+// GetTokenIDByEvmID finds the token id of a voucher token by its id inside the module contract
+func (k Keeper) GetTokenIDByEvmID(ctx sdk.Context, contract common.Address, evmID []byte) (tokenID string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EvmIDToTokenKey(contract.Bytes(), evmID))
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IterateTokens iterates over the metadata of all voucher tokens and performs a callback function
+func (k Keeper) IterateTokens(ctx sdk.Context, cb func(token types.TokenInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixToken)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.TokenInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &token)
+
+		if cb(token) {
+			break
+		}
+	}
+}
+
+// GetClassMappings returns all voucher classes with their module contracts
+func (k Keeper) GetClassMappings(ctx sdk.Context) (out []types.ClassMapping) {
+	k.IterateMapping(ctx, func(classID string, contract common.Address) bool {
+		class, _ := k.GetClassInfo(ctx, classID)
+		out = append(out, types.ClassMapping{
+			Class:    class,
+			Contract: contract.Hex(),
+		})
+		return false
+	})
+	return
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/okx/okbchain/app"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	nfttransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/erc721"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.OKBChainApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+
+	suite.app = app.Setup(checkTx)
+	suite.ctx = suite.app.NewContext(checkTx, abci.Header{
+		Height:  1,
+		ChainID: "ethermint-3",
+		Time:    time.Now().UTC(),
+	})
+}
+
+func newAddress() sdk.AccAddress {
+	return sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+}
+
+func (suite *KeeperTestSuite) TestVoucherClass() {
+	k := suite.app.Erc721Keeper
+	trace := nfttransfertypes.ParseClassTrace("nft-transfer/channel-0/cryptopunks")
+	classID := trace.IBCClassID()
+	alice, bob := newAddress(), newAddress()
+
+	// native classes can't be created by the module
+	suite.Require().Error(k.CreateOrUpdateClass(suite.ctx, "cryptopunks", "", ""))
+	suite.Require().False(k.HasClass(suite.ctx, classID))
+
+	suite.Require().NoError(k.CreateOrUpdateClass(suite.ctx, classID, "uri", "data"))
+	contract, found := k.GetContractByClass(suite.ctx, classID)
+	suite.Require().True(found)
+	mapped, found := k.GetClassByContract(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(classID, mapped)
+
+	// update the class metadata without deploying another contract
+	suite.Require().NoError(k.CreateOrUpdateClass(suite.ctx, classID, "uri2", ""))
+	again, _ := k.GetContractByClass(suite.ctx, classID)
+	suite.Require().Equal(contract, again)
+	class, found := k.GetClass(suite.ctx, classID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ClassInfo{ID: classID, URI: "uri2"}, class)
+
+	// mint a token with a decimal id and a token with any other id
+	suite.Require().NoError(k.Mint(suite.ctx, classID, "42", "uri42", "data42", alice))
+	suite.Require().NoError(k.Mint(suite.ctx, classID, "kitty", "", "", alice))
+	suite.Require().Error(k.Mint(suite.ctx, classID, "42", "", "", bob))
+	suite.Require().Equal(alice, k.GetOwner(suite.ctx, classID, "42"))
+	suite.Require().Equal(alice, k.GetOwner(suite.ctx, classID, "kitty"))
+	suite.Require().Nil(k.GetOwner(suite.ctx, classID, "43"))
+
+	nft, found := k.GetNFT(suite.ctx, classID, "42")
+	suite.Require().True(found)
+	suite.Require().Equal(types.TokenInfo{ClassID: classID, ID: "42", URI: "uri42", Data: "data42"}, nft)
+	_, found = k.GetNFT(suite.ctx, classID, "43")
+	suite.Require().False(found)
+
+	tokenID, found := k.GetTokenIDByEvmID(suite.ctx, contract, types.EvmTokenID("kitty").Bytes())
+	suite.Require().True(found)
+	suite.Require().Equal("kitty", tokenID)
+
+	// transfer on behalf of the owner and update the token data
+	suite.Require().NoError(k.Transfer(suite.ctx, classID, "42", "new data", bob))
+	suite.Require().Equal(bob, k.GetOwner(suite.ctx, classID, "42"))
+	nft, _ = k.GetNFT(suite.ctx, classID, "42")
+	suite.Require().Equal("new data", nft.GetData())
+	suite.Require().Error(k.Transfer(suite.ctx, classID, "43", "", bob))
+
+	suite.Require().NoError(k.Burn(suite.ctx, classID, "kitty"))
+	suite.Require().Nil(k.GetOwner(suite.ctx, classID, "kitty"))
+	_, found = k.GetTokenIDByEvmID(suite.ctx, contract, types.EvmTokenID("kitty").Bytes())
+	suite.Require().False(found)
+
+	// export and import the genesis state
+	genesis := erc721.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.ClassMappings, 1)
+	suite.Require().Equal([]types.TokenInfo{{ClassID: classID, ID: "42", URI: "uri42", Data: "new data"}}, genesis.Tokens)
+
+	suite.SetupTest()
+	erc721.InitGenesis(suite.ctx, suite.app.Erc721Keeper, genesis)
+	suite.Require().Equal(genesis, erc721.ExportGenesis(suite.ctx, suite.app.Erc721Keeper))
+}
+
+func (suite *KeeperTestSuite) TestNativeERC721Class() {
+	k := suite.app.Erc721Keeper
+	alice, bob := newAddress(), newAddress()
+	aliceETH := common.BytesToAddress(alice.Bytes())
+
+	// deploy an erc721 contract owned by alice, the tokens are minted by alice as well
+	input, err := types.ModuleERC721Contract.ABI.Pack("", "punks", "PUNK")
+	suite.Require().NoError(err)
+	_, res, err := k.CallEvm(suite.ctx, aliceETH, nil, append(common.Hex2Bytes(types.ModuleERC721Contract.Bin), input...))
+	suite.Require().NoError(err)
+	contract := res.ContractAddress
+	input, err = types.ModuleERC721Contract.ABI.Pack(types.ContractMintMethod, aliceETH, big.NewInt(7), "uri7")
+	suite.Require().NoError(err)
+	_, _, err = k.CallEvm(suite.ctx, aliceETH, &contract, input)
+	suite.Require().NoError(err)
+
+	classID := contract.String()
+	suite.Require().True(k.HasClass(suite.ctx, classID))
+	class, found := k.GetClass(suite.ctx, classID)
+	suite.Require().True(found)
+	suite.Require().Equal(classID, class.GetID())
+	suite.Require().False(k.HasClass(suite.ctx, common.BytesToAddress(bob.Bytes()).String()))
+
+	suite.Require().Equal(alice, k.GetOwner(suite.ctx, classID, "7"))
+	suite.Require().Nil(k.GetOwner(suite.ctx, classID, "007"))
+	nft, found := k.GetNFT(suite.ctx, classID, "7")
+	suite.Require().True(found)
+	suite.Require().Equal("uri7", nft.GetURI())
+
+	// native tokens are only moved, never minted or burned by the module
+	suite.Require().Error(k.Mint(suite.ctx, classID, "8", "", "", bob))
+	suite.Require().Error(k.Burn(suite.ctx, classID, "7"))
+
+	suite.Require().NoError(k.Transfer(suite.ctx, classID, "7", "", bob))
+	suite.Require().Equal(bob, k.GetOwner(suite.ctx, classID, "7"))
+}
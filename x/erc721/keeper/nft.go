@@ -0,0 +1,291 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	nfttransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+var _ nfttransfertypes.NFTKeeper = Keeper{}
+
+// classKind describes which kind of contract holds the tokens of a class
+type classKind int
+
+const (
+	classUnknown classKind = iota
+	// classVoucher is a class received through ibc, held by a module erc721 contract
+	classVoucher
+	// classERC721 is a native class, held by an erc721 contract deployed by users
+	classERC721
+	// classCW721 is a native class, held by a cw721 contract deployed by users
+	classCW721
+)
+
+// classKindOf resolves the kind of the class and the address of the contract holding its tokens.
+// The id of a native class is the hex address of its contract.
+func (k Keeper) classKindOf(ctx sdk.Context, classID string) (classKind, common.Address) {
+	if types.IsVoucherClass(classID) {
+		contract, found := k.GetContractByClass(ctx, classID)
+		if !found {
+			return classUnknown, common.Address{}
+		}
+		return classVoucher, contract
+	}
+
+	if !common.IsHexAddress(classID) {
+		return classUnknown, common.Address{}
+	}
+	contract := common.HexToAddress(classID)
+	if k.wasmViewKeeper != nil && k.wasmViewKeeper.GetContractInfo(ctx, contract.Bytes()) != nil {
+		return classCW721, contract
+	}
+	if k.hasEvmCode(ctx, contract) {
+		return classERC721, contract
+	}
+	return classUnknown, common.Address{}
+}
+
+// CreateOrUpdateClass creates the voucher class and deploys its module erc721 contract if it
+// doesn't exist, or updates the class uri and data otherwise.
+func (k Keeper) CreateOrUpdateClass(ctx sdk.Context, classID, classURI, classData string) error {
+	if !types.IsVoucherClass(classID) {
+		return sdkerrors.Wrap(types.ErrNotVoucherClass, classID)
+	}
+
+	if _, found := k.GetContractByClass(ctx, classID); !found {
+		contract, err := k.DeployModuleERC721(ctx, classID)
+		if err != nil {
+			return err
+		}
+		if err := k.SetContractForClass(ctx, classID, contract); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeployModuleERC721,
+				sdk.NewAttribute(types.AttributeKeyClassID, classID),
+				sdk.NewAttribute(types.AttributeKeyContractAddr, contract.String()),
+			),
+		)
+	}
+
+	k.SetClassInfo(ctx, types.ClassInfo{ID: classID, URI: classURI, Data: classData})
+	return nil
+}
+
+// Mint mints a voucher token to the receiver by the module erc721 contract of the class
+func (k Keeper) Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	kind, contract := k.classKindOf(ctx, classID)
+	if kind != classVoucher {
+		return sdkerrors.Wrap(types.ErrNotVoucherClass, classID)
+	}
+
+	_, err := k.CallModuleERC721(ctx, contract, types.ContractMintMethod,
+		common.BytesToAddress(receiver.Bytes()), types.EvmTokenID(tokenID), tokenURI)
+	if err != nil {
+		return err
+	}
+
+	k.SetTokenInfo(ctx, contract, types.TokenInfo{ClassID: classID, ID: tokenID, URI: tokenURI, Data: tokenData})
+	return nil
+}
+
+// Burn burns a voucher token by the module erc721 contract of the class
+func (k Keeper) Burn(ctx sdk.Context, classID, tokenID string) error {
+	kind, contract := k.classKindOf(ctx, classID)
+	if kind != classVoucher {
+		return sdkerrors.Wrap(types.ErrNotVoucherClass, classID)
+	}
+
+	if _, err := k.CallModuleERC721(ctx, contract, types.ContractBurnMethod, types.EvmTokenID(tokenID)); err != nil {
+		return err
+	}
+
+	k.DeleteTokenInfo(ctx, contract, classID, tokenID)
+	return nil
+}
+
+// Transfer moves the token from its current owner to the receiver. The move is executed on
+// behalf of the owner, who is either the authenticated sender of an ibc transfer or an escrow
+// account of the ibc nft-transfer module.
+func (k Keeper) Transfer(ctx sdk.Context, classID, tokenID, tokenData string, receiver sdk.AccAddress) error {
+	kind, contract := k.classKindOf(ctx, classID)
+	owner := k.getOwner(ctx, kind, contract, tokenID)
+	if owner == nil {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "token %s of class %s", tokenID, classID)
+	}
+
+	switch kind {
+	case classVoucher, classERC721:
+		evmID, err := k.evmTokenID(kind, tokenID)
+		if err != nil {
+			return err
+		}
+		data, err := types.ModuleERC721Contract.ABI.Pack(types.ContractTransferFromMethod,
+			common.BytesToAddress(owner.Bytes()), common.BytesToAddress(receiver.Bytes()), evmID)
+		if err != nil {
+			return err
+		}
+		if _, _, err := k.callEvm(ctx, common.BytesToAddress(owner.Bytes()), &contract, data); err != nil {
+			return err
+		}
+	case classCW721:
+		if k.wasmKeeper == nil {
+			return sdkerrors.Wrap(types.ErrClassNotFound, "wasm keeper is not set")
+		}
+		msg, err := json.Marshal(map[string]interface{}{
+			"transfer_nft": map[string]string{
+				"recipient": sdk.AccToAWasmddress(receiver).String(),
+				"token_id":  tokenID,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := k.wasmKeeper.Execute(ctx, contract.Bytes(), sdk.AccToAWasmddress(owner), msg, sdk.Coins{}); err != nil {
+			return err
+		}
+	}
+
+	// only the metadata of voucher tokens is kept by the module
+	if kind == classVoucher && len(tokenData) != 0 {
+		if token, found := k.GetTokenInfo(ctx, classID, tokenID); found {
+			token.Data = tokenData
+			k.SetTokenInfo(ctx, contract, token)
+		}
+	}
+	return nil
+}
+
+// GetOwner returns the owner of the token, it returns nil if the token doesn't exist
+func (k Keeper) GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress {
+	kind, contract := k.classKindOf(ctx, classID)
+	return k.getOwner(ctx, kind, contract, tokenID)
+}
+
+func (k Keeper) getOwner(ctx sdk.Context, kind classKind, contract common.Address, tokenID string) sdk.AccAddress {
+	switch kind {
+	case classVoucher, classERC721:
+		evmID, err := k.evmTokenID(kind, tokenID)
+		if err != nil {
+			return nil
+		}
+		ret, err := k.callERC721View(ctx, contract, types.ContractOwnerOfMethod, evmID)
+		if err != nil || len(ret) != 1 {
+			return nil
+		}
+		owner, ok := ret[0].(common.Address)
+		if !ok {
+			return nil
+		}
+		return owner.Bytes()
+	case classCW721:
+		var res struct {
+			Owner string `json:"owner"`
+		}
+		if err := k.queryCW721(ctx, contract, "owner_of", tokenID, &res); err != nil {
+			return nil
+		}
+		owner, err := sdk.WasmAddressFromBech32(res.Owner)
+		if err != nil {
+			return nil
+		}
+		return sdk.WasmToAccAddress(owner)
+	default:
+		return nil
+	}
+}
+
+// HasClass returns true if the class is a mapped voucher class or a native erc721/cw721 contract
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	kind, _ := k.classKindOf(ctx, classID)
+	return kind != classUnknown
+}
+
+// GetClass returns the class, the uri and data of native classes are left empty
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (nfttransfertypes.Class, bool) {
+	switch kind, _ := k.classKindOf(ctx, classID); kind {
+	case classVoucher:
+		return k.GetClassInfo(ctx, classID)
+	case classERC721, classCW721:
+		return types.ClassInfo{ID: classID}, true
+	default:
+		return nil, false
+	}
+}
+
+// GetNFT returns the token if it exists
+func (k Keeper) GetNFT(ctx sdk.Context, classID, tokenID string) (nfttransfertypes.NFT, bool) {
+	kind, contract := k.classKindOf(ctx, classID)
+	if k.getOwner(ctx, kind, contract, tokenID) == nil {
+		return nil, false
+	}
+
+	token := types.TokenInfo{ClassID: classID, ID: tokenID}
+	switch kind {
+	case classVoucher:
+		return k.GetTokenInfo(ctx, classID, tokenID)
+	case classERC721:
+		// tokenURI is an optional extension of erc721
+		evmID, _ := k.evmTokenID(kind, tokenID)
+		if ret, err := k.callERC721View(ctx, contract, types.ContractTokenURIMethod, evmID); err == nil && len(ret) == 1 {
+			token.URI, _ = ret[0].(string)
+		}
+	case classCW721:
+		var res struct {
+			TokenURI string `json:"token_uri"`
+		}
+		if err := k.queryCW721(ctx, contract, "nft_info", tokenID, &res); err == nil {
+			token.URI = res.TokenURI
+		}
+	}
+	return token, true
+}
+
+// evmTokenID returns the id of the token inside the erc721 contract, the ids of native erc721
+// tokens must be decimal uint256 numbers.
+func (k Keeper) evmTokenID(kind classKind, tokenID string) (*big.Int, error) {
+	if kind == classVoucher {
+		return types.EvmTokenID(tokenID), nil
+	}
+	evmID, ok := types.ParseEvmTokenID(tokenID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTokenID, "%s is not a decimal uint256", tokenID)
+	}
+	return evmID, nil
+}
+
+// callERC721View calls a read only method of an erc721 contract
+func (k Keeper) callERC721View(ctx sdk.Context, contract common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := types.ModuleERC721Contract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := k.staticCallEvm(ctx, contract, data)
+	if err != nil {
+		return nil, err
+	}
+	return types.ModuleERC721Contract.ABI.Unpack(method, ret)
+}
+
+// queryCW721 runs a token query of a cw721 contract
+func (k Keeper) queryCW721(ctx sdk.Context, contract common.Address, query, tokenID string, res interface{}) error {
+	req, err := json.Marshal(map[string]interface{}{
+		query: map[string]string{"token_id": tokenID},
+	})
+	if err != nil {
+		return err
+	}
+	bz, err := k.wasmViewKeeper.QuerySmart(ctx, contract.Bytes(), req)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, res)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	ethcmm "github.com/ethereum/go-ethereum/common"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/common"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) < 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"Insufficient parameters, at least 1 parameter is required")
+		}
+
+		switch path[0] {
+		case types.QueryClassMapping:
+			return queryClassMapping(ctx, keeper)
+		case types.QueryContractByClass:
+			return queryContractByClass(ctx, req, keeper)
+		case types.QueryClassByContract:
+			return queryClassByContract(ctx, req, keeper)
+		case types.QueryTokenByEvmID:
+			return queryTokenByEvmID(ctx, req, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
+		}
+	}
+}
+
+func queryClassMapping(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	mappings := keeper.GetClassMappings(ctx)
+
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, mappings)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+	return res, nil
+}
+
+func queryContractByClass(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.ContractByClassRequest
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	contract, found := keeper.GetContractByClass(ctx, params.ClassID)
+	if !found {
+		return nil, fmt.Errorf("contract for the class %s is not found", params.ClassID)
+	}
+
+	return []byte(contract.String()), nil
+}
+
+func queryClassByContract(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.ClassByContractRequest
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	classID, found := keeper.GetClassByContract(ctx, ethcmm.HexToAddress(params.Contract))
+	if !found {
+		return nil, fmt.Errorf("class for contract %s is not found", params.Contract)
+	}
+
+	return []byte(classID), nil
+}
+
+func queryTokenByEvmID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.TokenByEvmIDRequest
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	evmID, ok := new(big.Int).SetString(params.EvmID, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid evm token id %s", params.EvmID)
+	}
+	contract := ethcmm.HexToAddress(params.Contract)
+	tokenID, found := keeper.GetTokenIDByEvmID(ctx, contract, evmID.Bytes())
+	if !found {
+		return nil, fmt.Errorf("token %s of contract %s is not found", params.EvmID, params.Contract)
+	}
+	classID, _ := keeper.GetClassByContract(ctx, contract)
+	token, _ := keeper.GetTokenInfo(ctx, classID, tokenID)
+
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, token)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+	return res, nil
+}
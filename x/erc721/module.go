@@ -0,0 +1,135 @@
+package erc721
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/module"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/upgrade"
+	"github.com/okx/okbchain/libs/ibc-go/modules/apps/common"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/erc721/keeper"
+	"github.com/okx/okbchain/x/erc721/types"
+)
+
+var _ module.AppModuleBasic = AppModuleBasic{}
+var _ module.AppModule = AppModule{}
+var _ upgrade.UpgradeModule = AppModule{}
+
+// AppModuleBasic struct
+type AppModuleBasic struct{}
+
+// Name for app module basic
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec registers types for module
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {}
+
+// DefaultGenesis is json default structure
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis is the validation check of the Genesis
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var genesisState types.GenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &genesisState); err != nil {
+		return err
+	}
+	return genesisState.Validate()
+}
+
+// RegisterRESTRoutes Registers rest routes
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+}
+
+// GetQueryCmd Gets the root query command of this module
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return nil
+}
+
+// GetTxCmd Gets the root tx command of this module
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return nil
+}
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the erc721 module.
+type AppModule struct {
+	*common.Venus8BaseUpgradeModule
+	AppModuleBasic
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule Object, its store is committed from the
+// venus8 upgrade on together with the nft-transfer store
+func NewAppModule(k Keeper) AppModule {
+	ret := AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         k,
+	}
+	ret.Venus8BaseUpgradeModule = common.NewVenus8BaseUpgradeModule(ret)
+	return ret
+}
+
+// RegisterTask returns no upgrade task, the erc721 store starts empty
+func (am AppModule) RegisterTask() upgrade.HeightTask {
+	return nil
+}
+
+// Name is module name
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants interface for registering invariants
+func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+// Route specifies path for transactions, the erc721 module has no messages
+// as tokens are only moved by the ibc nft-transfer module
+func (AppModule) Route() string {
+	return ""
+}
+
+// NewHandler sets up a new handler for module
+func (AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+// QuerierRoute sets up path for queries
+func (AppModule) QuerierRoute() string {
+	return types.ModuleName
+}
+
+// NewQuerierHandler sets up new querier handler for module
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(am.keeper)
+}
+
+// BeginBlock function for module at start of each block
+func (AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
+
+// EndBlock function for module at end of block
+func (AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+// InitGenesis instantiates the genesis state
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	return InitGenesis(ctx, am.keeper, genesisState)
+}
+
+// ExportGenesis exports the genesis state to be used by daemon
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	return types.ModuleCdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the erc721 module's codec
+var ModuleCdc = codec.New()
+
+func init() {
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
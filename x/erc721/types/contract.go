@@ -0,0 +1,67 @@
+package types
+
+import (
+	_ "embed"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+)
+
+// CompiledContract contains compiled bytecode and abi
+type CompiledContract struct {
+	ABI abi.ABI
+	Bin string
+}
+
+var (
+	IbcNftEvmModuleETHAddr  common.Address
+	IbcNftEvmModuleBechAddr sdk.AccAddress
+
+	// ModuleERC721Contract is the compiled okbc erc721 contract
+	ModuleERC721Contract CompiledContract
+
+	//go:embed contracts/erc721.json
+	moduleERC721ContractJson []byte
+)
+
+const (
+	IbcNftEvmModuleName = "ibc-nft-evm"
+
+	ContractMintMethod         = "mint_by_okbc_module"
+	ContractBurnMethod         = "burn_by_okbc_module"
+	ContractOwnerOfMethod      = "ownerOf"
+	ContractTokenURIMethod     = "tokenURI"
+	ContractTransferFromMethod = "transferFrom"
+)
+
+func init() {
+	IbcNftEvmModuleBechAddr = authtypes.NewModuleAddress(IbcNftEvmModuleName)
+	IbcNftEvmModuleETHAddr = common.BytesToAddress(IbcNftEvmModuleBechAddr.Bytes())
+
+	if err := json.Unmarshal(moduleERC721ContractJson, &ModuleERC721Contract); err != nil {
+		panic(err)
+	}
+}
+
+// EvmTokenID returns the uint256 id of a token inside its ERC-721 contract. A token id which is
+// already the decimal form of an uint256 is kept as is, any other id is hashed into one.
+func EvmTokenID(tokenID string) *big.Int {
+	if id, ok := ParseEvmTokenID(tokenID); ok {
+		return id
+	}
+	return new(big.Int).SetBytes(crypto.Keccak256([]byte(tokenID)))
+}
+
+// ParseEvmTokenID parses the canonical decimal form of an uint256 token id.
+func ParseEvmTokenID(tokenID string) (*big.Int, bool) {
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok || id.Sign() < 0 || id.BitLen() > 256 || id.String() != tokenID {
+		return nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+)
+
+var (
+	// ErrChainConfigNotFound returns an error if the chain config cannot be found on the store.
+	ErrChainConfigNotFound = sdkerrors.Register(DefaultCodespace, 1, "chain configuration not found")
+	// ErrClassNotFound returns an error if the class is neither a voucher nor a known contract
+	ErrClassNotFound = sdkerrors.Register(DefaultCodespace, 2, "class not found")
+	// ErrTokenNotFound returns an error if the token does not exist in its class
+	ErrTokenNotFound = sdkerrors.Register(DefaultCodespace, 3, "token not found")
+	// ErrInvalidTokenID returns an error if the token id can not be used by an erc721 contract
+	ErrInvalidTokenID = sdkerrors.Register(DefaultCodespace, 4, "invalid token id")
+	// ErrRegisteredContract returns an error if the contract is mapped to another class already
+	ErrRegisteredContract = sdkerrors.Register(DefaultCodespace, 5, "the contract is already registered")
+	// ErrNotVoucherClass returns an error if a voucher operation is applied to a native class
+	ErrNotVoucherClass = sdkerrors.Register(DefaultCodespace, 6, "class is not an ibc voucher class")
+)
@@ -0,0 +1,10 @@
+package types
+
+const (
+	EventTypeDeployModuleERC721 = "deploy_erc721_contract"
+	EventTypeCallModuleERC721   = "call_erc721_contract"
+
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyContractAddr   = "contract_address"
+	AttributeKeyContractMethod = "contract_method"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ClassMapping defines a mapping between an ibc voucher class and its module erc721 contract
+type ClassMapping struct {
+	Class    ClassInfo `json:"class"`
+	Contract string    `json:"contract"`
+}
+
+// GenesisState defines the erc721 module genesis state
+type GenesisState struct {
+	ClassMappings []ClassMapping `json:"class_mappings"`
+	Tokens        []TokenInfo    `json:"tokens"`
+}
+
+// DefaultGenesisState sets default erc721 genesis state with no class mappings
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	classes := make(map[string]bool, len(gs.ClassMappings))
+	for _, m := range gs.ClassMappings {
+		if !IsVoucherClass(m.Class.ID) {
+			return fmt.Errorf("invalid voucher class %s", m.Class.ID)
+		}
+		if !common.IsHexAddress(m.Contract) {
+			return fmt.Errorf("invalid contract address %s of class %s", m.Contract, m.Class.ID)
+		}
+		if classes[m.Class.ID] {
+			return fmt.Errorf("duplicated class mapping %s", m.Class.ID)
+		}
+		classes[m.Class.ID] = true
+	}
+	for _, t := range gs.Tokens {
+		if !classes[t.ClassID] {
+			return fmt.Errorf("class %s of token %s is not mapped", t.ClassID, t.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "erc721"
+	// StoreKey key for the class to contract mappings and the voucher token records.
+	StoreKey = ModuleName
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+
+	QueryClassMapping    = "class-mapping"
+	QueryContractByClass = "contract-by-class"
+	QueryClassByContract = "class-by-contract"
+	QueryTokenByEvmID    = "token-by-evm-id"
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixContractToClass = []byte{0x01}
+	KeyPrefixClassToContract = []byte{0x02}
+	KeyPrefixClass           = []byte{0x03}
+	KeyPrefixToken           = []byte{0x04}
+	KeyPrefixEvmIDToToken    = []byte{0x05}
+)
+
+// ContractToClassKey defines the store key for contract to class reverse index
+func ContractToClassKey(contract []byte) []byte {
+	return append(KeyPrefixContractToClass, contract...)
+}
+
+// ClassToContractKey defines the store key for class to contract mapping
+func ClassToContractKey(classID string) []byte {
+	return append(KeyPrefixClassToContract, classID...)
+}
+
+// ClassKey defines the store key for the metadata of a voucher class
+func ClassKey(classID string) []byte {
+	return append(KeyPrefixClass, classID...)
+}
+
+// TokenKeyPrefix defines the store key prefix for the tokens of a voucher class
+func TokenKeyPrefix(classID string) []byte {
+	return append(append(KeyPrefixToken, sdk.Uint64ToBigEndian(uint64(len(classID)))...), classID...)
+}
+
+// TokenKey defines the store key for the metadata of a voucher token
+func TokenKey(classID, tokenID string) []byte {
+	return append(TokenKeyPrefix(classID), tokenID...)
+}
+
+// EvmIDToTokenKey defines the store key for the evm token id to token id reverse index
+func EvmIDToTokenKey(contract []byte, evmID []byte) []byte {
+	return append(append(KeyPrefixEvmIDToToken, contract...), evmID...)
+}
@@ -0,0 +1,14 @@
+package types
+
+type ContractByClassRequest struct {
+	ClassID string `json:"class_id,omitempty"`
+}
+
+type ClassByContractRequest struct {
+	Contract string `json:"contract,omitempty"`
+}
+
+type TokenByEvmIDRequest struct {
+	Contract string `json:"contract,omitempty"`
+	EvmID    string `json:"evm_id,omitempty"`
+}
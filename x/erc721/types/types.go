@@ -0,0 +1,37 @@
+package types
+
+import (
+	"strings"
+
+	nfttransfertypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/nft-transfer/types"
+)
+
+// IsVoucherClass returns true if the class id is the hashed id of a class received through ibc,
+// such classes are mapped to module erc721 contracts.
+func IsVoucherClass(classID string) bool {
+	return strings.HasPrefix(classID, nfttransfertypes.ClassPrefix+"/") && nfttransfertypes.ValidateIBCClassID(classID) == nil
+}
+
+// ClassInfo defines the metadata of a non fungible token class
+type ClassInfo struct {
+	ID   string `json:"id"`
+	URI  string `json:"uri,omitempty"`
+	Data string `json:"data,omitempty"`
+}
+
+func (c ClassInfo) GetID() string   { return c.ID }
+func (c ClassInfo) GetURI() string  { return c.URI }
+func (c ClassInfo) GetData() string { return c.Data }
+
+// TokenInfo defines the metadata of a non fungible token
+type TokenInfo struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+	URI     string `json:"uri,omitempty"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (t TokenInfo) GetClassID() string { return t.ClassID }
+func (t TokenInfo) GetID() string      { return t.ID }
+func (t TokenInfo) GetURI() string     { return t.URI }
+func (t TokenInfo) GetData() string    { return t.Data }
@@ -21,6 +21,7 @@ var (
 	NewSimulateKeeper    = keeper.NewSimulateKeeper
 	NewLogProcessEvmHook = keeper.NewLogProcessEvmHook
 	NewMultiEvmHooks     = keeper.NewMultiEvmHooks
+	NewPrecompileHooks   = keeper.NewPrecompileHooks
 )
 
 //nolint
@@ -28,6 +29,7 @@ type (
 	Keeper        = keeper.Keeper
 	GenesisState  = types.GenesisState
 	EvmLogHandler = types.EvmLogHandler
+	Precompile    = types.Precompile
 )
 
 func WithMoreDeocder(cdc *codec.Codec, cc sdk.TxDecoder) sdk.TxDecoder {
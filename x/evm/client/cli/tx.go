@@ -15,7 +15,9 @@ import (
 	evmutils "github.com/okx/okbchain/x/evm/client/utils"
 	"github.com/okx/okbchain/x/evm/types"
 	"github.com/okx/okbchain/x/gov"
+	govcli "github.com/okx/okbchain/x/gov/client/cli"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetCmdManageContractDeploymentWhitelistProposal implements a command handler for submitting a manage contract deployment
@@ -83,7 +85,7 @@ Where proposal.json contains:
 // GetCmdManageContractBlockedListProposal implements a command handler for submitting a manage contract blocked list
 // proposal transaction
 func GetCmdManageContractBlockedListProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "update-contract-blocked-list [proposal-file]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit an update contract blocked list proposal",
@@ -137,15 +139,19 @@ Where proposal.json contains:
 			}
 
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			msg.IsExpedited = viper.GetBool(govcli.FlagExpedited)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Bool(govcli.FlagExpedited, false, "submit an expedited proposal to take effect in a shorter voting period")
+	return cmd
 }
 
 // GetCmdManageContractMethodBlockedListProposal implements a command handler for submitting a manage contract blocked list
 // proposal transaction
 func GetCmdManageContractMethodBlockedListProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "update-contract-method-blocked-list [proposal-file]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit an update contract method blocked list proposal",
@@ -223,9 +229,13 @@ Where proposal.json contains:
 			}
 
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			msg.IsExpedited = viper.GetBool(govcli.FlagExpedited)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Bool(govcli.FlagExpedited, false, "submit an expedited proposal to take effect in a shorter voting period")
+	return cmd
 }
 
 // GetCmdManageSysContractAddressProposal implements a command handler for submitting a manage system contract address
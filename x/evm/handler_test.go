@@ -2,6 +2,7 @@ package evm_test
 
 import (
 	"crypto/ecdsa"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
@@ -233,6 +234,81 @@ func (suite *EvmTestSuite) TestHandlerLogs() {
 	suite.Require().Equal(logs, resultData.Logs)
 }
 
+func (suite *EvmTestSuite) TestHandlerTraceNativeTracers() {
+	// the same contract with TestHandlerLogs, which emits a log in constructor
+	bytecode := common.FromHex("0x6080604052348015600f57600080fd5b5060117f775a94827b8fd9b519d36cd827093c664f93347070a554f65e4a6f56cd73889860405160405180910390a2603580604b6000396000f3fe6080604052600080fdfea165627a7a723058206cab665f0f557620554bb45adf266708d2bd349b8a4314bdff205ee8440e3c240029")
+
+	testCases := []struct {
+		msg    string
+		config string
+		check  func(res map[string]interface{})
+	}{
+		{
+			"callTracer",
+			`{"tracer":"callTracer"}`,
+			func(res map[string]interface{}) {
+				suite.Require().Equal("CREATE", res["type"])
+				suite.Require().NotEmpty(res["gasUsed"])
+			},
+		},
+		{
+			"prestateTracer",
+			`{"tracer":"prestateTracer"}`,
+			func(res map[string]interface{}) {
+				suite.Require().NotEmpty(res)
+			},
+		},
+		{
+			"prestateTracer diff mode",
+			`{"tracer":"prestateTracer","tracerConfig":{"diffMode":true}}`,
+			func(res map[string]interface{}) {
+				suite.Require().Contains(res, "pre")
+				suite.Require().Contains(res, "post")
+			},
+		},
+		{
+			"4byteTracer",
+			`{"tracer":"4byteTracer"}`,
+			func(res map[string]interface{}) {
+				// the selector-size of the outer calldata
+				suite.Require().Contains(res, "0x60806040-124")
+			},
+		},
+		{
+			"accessListTracer",
+			`{"tracer":"accessListTracer"}`,
+			func(res map[string]interface{}) {
+				// the constructor touches no other account or slot
+				suite.Require().Equal([]interface{}{}, res["accessList"])
+				suite.Require().NotEmpty(res["gasUsed"])
+				suite.Require().NotContains(res, "error")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			priv, err := ethsecp256k1.GenerateKey()
+			suite.Require().NoError(err, "failed to create key")
+			tx := types.NewMsgEthereumTx(1, nil, big.NewInt(0), uint64(100000), big.NewInt(1000000), bytecode)
+			err = tx.Sign(big.NewInt(3), priv.ToECDSA())
+			suite.Require().NoError(err)
+
+			suite.ctx.SetIsTraceTxLog(true)
+			suite.ctx.SetTraceTxLogConfig([]byte(tc.config))
+			suite.ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+			result, err := suite.handler(suite.ctx, tx)
+			suite.Require().NoError(err)
+
+			var res map[string]interface{}
+			suite.Require().NoError(json.Unmarshal(result.Data, &res), string(result.Data))
+			tc.check(res)
+		})
+	}
+}
+
 func (suite *EvmTestSuite) TestDeployAndCallContract() {
 	// Test contract:
 	//http://remix.ethereum.org/#optimize=false&evmVersion=istanbul&version=soljson-v0.5.15+commit.6a57276f.js
@@ -625,7 +701,7 @@ func (suite *EvmTestSuite) TestSimulateConflict() {
 	result, err = suite.handler(suite.ctx, tx)
 	suite.Require().NotNil(result)
 	suite.Require().Nil(err)
-	var expectedGas uint64 = 22336
+	var expectedGas uint64 = 22363
 	suite.Require().EqualValues(expectedGas, suite.ctx.GasMeter().GasConsumed())
 }
 
@@ -6,6 +6,7 @@ import (
 	ethermint "github.com/okx/okbchain/app/types"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	authexported "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/exported"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/evm/types"
 )
 
@@ -38,6 +39,9 @@ func (k Keeper) BalanceInvariant() sdk.Invariant {
 			}
 
 			accountBalance := ethAccount.GetCoins().AmountOf(sdk.DefaultBondDenom)
+			if tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+				accountBalance = ethAccount.SpendableBalance(sdk.DefaultBondDenom, ctx.BlockTime())
+			}
 			evmBalance := csdb.GetBalance(ethAccount.EthAddress())
 
 			if evmBalance.Cmp(accountBalance.BigInt()) != 0 {
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/evm/types"
+)
+
+// NewPrecompileHooks returns the hooks which run the precompiles called by evm contracts. All the precompiles are
+// called at types.PrecompileAddress, the method of the call data tells the precompile to run. The calls of the
+// methods no precompile handles, and all the calls before venus8, are passed to next.
+func NewPrecompileHooks(next vm.CallToWasmByPrecompile, precompiles ...types.Precompile) vm.CallToWasmByPrecompile {
+	precompileMap := make(map[string]types.Precompile)
+	for _, p := range precompiles {
+		for _, method := range p.ABI().Methods {
+			if _, ok := precompileMap[string(method.ID)]; ok {
+				panic(fmt.Sprintf("precompile method %s is registered twice", method.Sig))
+			}
+			precompileMap[string(method.ID)] = p
+		}
+	}
+
+	return func(ctx vm.OKContext, caller, to common.Address, value *big.Int, input []byte, remainGas uint64) ([]byte, uint64, error) {
+		if len(input) >= 4 {
+			if p, ok := precompileMap[string(input[:4])]; ok && precompileEnabled(ctx) {
+				return runPrecompile(ctx, p, caller, value, input, remainGas)
+			}
+		}
+		return next(ctx, caller, to, value, input, remainGas)
+	}
+}
+
+// precompileEnabled returns true if the precompiles are enabled at the height of the context
+func precompileEnabled(ctx vm.OKContext) bool {
+	sdkCtx, ok := ctx.(*sdk.Context)
+	return ok && tmtypes.HigherThanVenus8(sdkCtx.BlockHeight())
+}
+
+// runPrecompile runs the precompile in a cached context with the remaining gas of the evm call, the changes are
+// committed to the journal of the statedb only if the precompile succeeds
+func runPrecompile(ctx vm.OKContext, p types.Precompile, caller common.Address, value *big.Int, input []byte,
+	remainGas uint64) (result []byte, leftGas uint64, err error) {
+	sdkCtx, ok := ctx.(*sdk.Context)
+	if !ok {
+		return nil, 0, errors.New("precompile use context is not type of sdk.Context")
+	}
+	if value.Sign() != 0 {
+		return nil, 0, errors.New("precompile can not be send token")
+	}
+	csdb, ok := ctx.GetEVMStateDB().(*types.CommitStateDB)
+	if !ok {
+		return nil, 0, errors.New("precompile context's statedb is not *types.CommitStateDB")
+	}
+
+	method, err := p.ABI().MethodById(input[:4])
+	if err != nil {
+		return nil, 0, err
+	}
+	gasCost := p.RequiredGas(method)
+	if remainGas < gasCost {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, 0, fmt.Errorf("precompile unpack %s input failed: %s", method.Name, err)
+	}
+
+	// push the dirty evm state to ctx, so that the native module call sees the balances of the evm accounts
+	csdb.ProtectStateDBEnvironment(*sdkCtx)
+	subCtx, commit := sdkCtx.CacheContextWithMultiSnapshotRWSet()
+	gasMeter := sdk.NewGasMeter(remainGas - gasCost)
+	subCtx.SetGasMeter(gasMeter)
+
+	// catch out of gas panic and just charge the entire remaining gas
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			result, leftGas, err = nil, 0, vm.ErrOutOfGas
+		}
+	}()
+
+	result, err = p.Run(subCtx, caller, method, args)
+	if err != nil {
+		return nil, 0, err
+	}
+	sdkCtx.EventManager().EmitEvents(subCtx.EventManager().Events())
+
+	// update the changes to parent ctx and add cmchange to journal for reverting snapshot in the future
+	csdb.CMChangeCommit(commit)
+	return result, gasMeter.Limit() - gasMeter.GasConsumed(), nil
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/evm/keeper"
+	"github.com/okx/okbchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrecompileABIJson = `[{"inputs":[{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"test","outputs":[{"internalType":"bool","name":"success","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+type testPrecompile struct {
+	abi *types.ABI
+}
+
+func (p testPrecompile) ABI() types.ABI                        { return *p.abi }
+func (p testPrecompile) RequiredGas(method *abi.Method) uint64 { return 100 }
+func (p testPrecompile) Run(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}) ([]byte, error) {
+	return method.Outputs.Pack(true)
+}
+
+func TestNewPrecompileHooks(t *testing.T) {
+	testABI, err := types.NewABI(testPrecompileABIJson)
+	require.NoError(t, err)
+	p := testPrecompile{abi: testABI}
+
+	errNext := errors.New("next hooks called")
+	next := func(ctx vm.OKContext, caller, to common.Address, value *big.Int, input []byte, remainGas uint64) ([]byte, uint64, error) {
+		return nil, remainGas, errNext
+	}
+
+	// the methods no precompile handles are passed to next
+	hooks := keeper.NewPrecompileHooks(next, p)
+	_, _, err = hooks(nil, common.Address{}, types.PrecompileAddress, big.NewInt(0), []byte{0x1, 0x2, 0x3, 0x4}, 1000)
+	require.Equal(t, errNext, err)
+	_, _, err = hooks(nil, common.Address{}, types.PrecompileAddress, big.NewInt(0), nil, 1000)
+	require.Equal(t, errNext, err)
+
+	// the precompile methods are passed to next before venus8
+	input, err := testABI.Pack("test", big.NewInt(1))
+	require.NoError(t, err)
+	ctx := sdk.NewContext(nil, abci.Header{Height: 10}, false, nil)
+	_, _, err = hooks(&ctx, common.Address{}, types.PrecompileAddress, big.NewInt(0), input, 1000)
+	require.Equal(t, errNext, err)
+
+	// the precompile methods are run by the precompile from venus8 on
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(9)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	_, _, err = hooks(&ctx, common.Address{}, types.PrecompileAddress, big.NewInt(0), input, 1000)
+	require.Error(t, err)
+	require.NotEqual(t, errNext, err)
+
+	// a method can't be handled by two precompiles
+	require.Panics(t, func() {
+		keeper.NewPrecompileHooks(next, p, p)
+	})
+}
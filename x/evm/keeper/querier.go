@@ -315,7 +315,7 @@ func resolveEthAccount(ctx sdk.Context, k Keeper, addr ethcmn.Address) (*types.Q
 	}
 
 	// get balance
-	balance := ethAccount.Balance(sdk.DefaultBondDenom).BigInt()
+	balance := ethAccount.SpendableBalance(sdk.DefaultBondDenom, ctx.BlockTime()).BigInt()
 	if balance == nil {
 		balance = sdk.ZeroInt().BigInt()
 	}
@@ -0,0 +1,144 @@
+package native
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+)
+
+// accessListTracer accumulates the accounts and storage slots touched by a tx
+// into an access list, the sender, the recipient and the precompiles are excluded.
+// It reports the gas used by the tx as well, so that eth_createAccessList can
+// be served by tracing the call.
+//
+// Example:
+//
+//	> debug.traceCall({from: ..., to: ..., data: ...}, "latest", {tracer: "accessListTracer"})
+//	{
+//	  accessList: [{address: "0x...", storageKeys: ["0x..."]}],
+//	  gasUsed: "0x5208"
+//	}
+type accessListTracer struct {
+	env       *vm.EVM
+	config    accessListTracerConfig
+	tracer    *logger.AccessListTracer
+	gasLimit  uint64
+	gasUsed   uint64
+	err       error
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+type accessListTracerConfig struct {
+	// AccessList is the access list the result starts from
+	AccessList types.AccessList `json:"accessList"`
+}
+
+type accessListResult struct {
+	AccessList types.AccessList `json:"accessList"`
+	GasUsed    hexutil.Uint64   `json:"gasUsed"`
+	Error      string           `json:"error,omitempty"`
+}
+
+// newAccessListTracer returns a native go tracer which generates the access list of a tx.
+func newAccessListTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config accessListTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &accessListTracer{config: config}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *accessListTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil)
+	t.tracer = logger.NewAccessListTracer(t.config.AccessList, from, to, vm.ActivePrecompiles(rules))
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *accessListTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	t.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *accessListTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *accessListTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *accessListTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *accessListTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.err = err
+}
+
+func (t *accessListTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+func (t *accessListTracer) CaptureTxEnd(restGas uint64) {
+	t.gasUsed = t.gasLimit - restGas
+}
+
+// GetResult returns the json-encoded access list sorted by address and storage key,
+// along with the gas used by the tx.
+func (t *accessListTracer) GetResult() (json.RawMessage, error) {
+	result := accessListResult{
+		AccessList: types.AccessList{},
+		GasUsed:    hexutil.Uint64(t.gasUsed),
+	}
+	if t.tracer != nil {
+		result.AccessList = sortAccessList(t.tracer.AccessList())
+	}
+	if t.err != nil {
+		result.Error = t.err.Error()
+	}
+	res, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *accessListTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+func sortAccessList(acl types.AccessList) types.AccessList {
+	for _, tuple := range acl {
+		keys := tuple.StorageKeys
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i][:], keys[j][:]) < 0
+		})
+	}
+	sort.Slice(acl, func(i, j int) bool {
+		return bytes.Compare(acl[i].Address[:], acl[j].Address[:]) < 0
+	})
+	return acl
+}
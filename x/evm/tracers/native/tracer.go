@@ -0,0 +1,90 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package native is a collection of the built-in evm tracers written in go,
+// which can be selected by name in the TraceConfig.Tracer and are much faster
+// than the javascript tracers.
+//
+// The callTracer and the prestateTracer extend the ones of go-ethereum with the
+// revert reason and the diff mode, the 4byteTracer is served by go-ethereum.
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	// register the go-ethereum native tracers, which are looked up after the ones
+	// here, as the init of this package runs later and puts its lookup in front
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+)
+
+const (
+	CallTracerName       = "callTracer"
+	PrestateTracerName   = "prestateTracer"
+	FourByteTracerName   = "4byteTracer"
+	AccessListTracerName = "accessListTracer"
+)
+
+// init registers the native tracers as a lookup for tracers.
+func init() {
+	tracers.RegisterLookup(false, lookup)
+}
+
+// ctorFn is the constructor signature of a native tracer.
+type ctorFn = func(*tracers.Context, json.RawMessage) (tracers.Tracer, error)
+
+var ctors = map[string]ctorFn{
+	CallTracerName:       newCallTracer,
+	PrestateTracerName:   newPrestateTracer,
+	AccessListTracerName: newAccessListTracer,
+}
+
+// lookup returns a tracer, if one can be matched to the given name.
+func lookup(name string, ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	if ctor, ok := ctors[name]; ok {
+		return ctor(ctx, cfg)
+	}
+	return nil, errors.New("no tracer found")
+}
+
+// IsNativeTracer returns whether the name is one of the native tracers.
+func IsNativeTracer(name string) bool {
+	_, ok := ctors[name]
+	return ok || name == FourByteTracerName
+}
+
+func bytesToHex(s []byte) string {
+	return "0x" + common.Bytes2Hex(s)
+}
+
+func bigToHex(n *big.Int) string {
+	if n == nil {
+		return ""
+	}
+	return "0x" + n.Text(16)
+}
+
+func uintToHex(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func addrToHex(a common.Address) string {
+	return "0x" + common.Bytes2Hex(a.Bytes())
+}
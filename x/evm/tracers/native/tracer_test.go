@@ -0,0 +1,142 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupPrecedence(t *testing.T) {
+	// the tracers here are looked up before the go-ethereum ones with the same names
+	tracer, err := tracers.New(CallTracerName, &tracers.Context{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &callTracer{}, tracer)
+
+	tracer, err = tracers.New(PrestateTracerName, &tracers.Context{}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &prestateTracer{}, tracer)
+
+	// the 4byteTracer is served by go-ethereum
+	_, err = tracers.New(FourByteTracerName, &tracers.Context{}, nil)
+	require.NoError(t, err)
+	require.True(t, IsNativeTracer(FourByteTracerName))
+}
+
+var (
+	testFrom  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testTo    = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	testOther = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+func newTestEVM(t *testing.T) (*vm.EVM, *ethstate.StateDB) {
+	statedb, err := ethstate.New(common.Hash{}, ethstate.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	txCtx := vm.TxContext{Origin: testFrom, GasPrice: big.NewInt(1)}
+	return vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, txCtx, statedb, params.TestChainConfig, vm.Config{}), statedb
+}
+
+func packRevert(t *testing.T, reason string) []byte {
+	typ, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	data, err := abi.Arguments{{Type: typ}}.Pack(reason)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], data...)
+}
+
+func runCallTracer(t *testing.T, cfg json.RawMessage) callFrame {
+	tracer, err := tracers.New(CallTracerName, &tracers.Context{}, cfg)
+	require.NoError(t, err)
+	env, _ := newTestEVM(t)
+
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(env, testFrom, testTo, false, []byte{0x01}, 79000, big.NewInt(10))
+	tracer.CaptureEnter(vm.CALL, testTo, testOther, []byte{0x02}, 50000, big.NewInt(1))
+	tracer.CaptureEnter(vm.STATICCALL, testOther, testTo, []byte{0x03}, 30000, nil)
+	tracer.CaptureExit([]byte{0x04}, 1000, nil)
+	tracer.CaptureExit(packRevert(t, "not allowed"), 5000, vm.ErrExecutionReverted)
+	tracer.CaptureEnd([]byte{0x05}, 30000, 0, nil)
+	tracer.CaptureTxEnd(40000)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	var frame callFrame
+	require.NoError(t, json.Unmarshal(res, &frame))
+	return frame
+}
+
+func TestCallTracer(t *testing.T) {
+	frame := runCallTracer(t, nil)
+	// the gas used of the top call includes the intrinsic gas
+	require.Equal(t, uintToHex(60000), frame.GasUsed)
+	require.Equal(t, "0x05", frame.Output)
+	require.Empty(t, frame.Error)
+
+	// the nested frames are collected in the order they are entered
+	require.Len(t, frame.Calls, 1)
+	call := frame.Calls[0]
+	require.Equal(t, vm.CALL.String(), call.Type)
+	require.Equal(t, addrToHex(testOther), call.To)
+	require.Equal(t, uintToHex(5000), call.GasUsed)
+	require.Equal(t, vm.ErrExecutionReverted.Error(), call.Error)
+	require.Equal(t, "not allowed", call.RevertReason)
+	require.Len(t, call.Calls, 1)
+	require.Equal(t, vm.STATICCALL.String(), call.Calls[0].Type)
+	require.Equal(t, "0x04", call.Calls[0].Output)
+	require.Empty(t, call.Calls[0].Calls)
+}
+
+func TestCallTracerOnlyTopCall(t *testing.T) {
+	frame := runCallTracer(t, json.RawMessage(`{"onlyTopCall":true}`))
+	require.Equal(t, uintToHex(60000), frame.GasUsed)
+	require.Equal(t, "0x05", frame.Output)
+	require.Empty(t, frame.Calls)
+}
+
+func TestPrestateTracerDiffMode(t *testing.T) {
+	slot, value := common.HexToHash("0x01"), common.HexToHash("0x02")
+	env, statedb := newTestEVM(t)
+	statedb.SetBalance(testFrom, big.NewInt(1000000))
+	statedb.SetNonce(testFrom, 1)
+	statedb.SetCode(testTo, []byte{0x60, 0x00})
+	statedb.SetState(testTo, slot, value)
+
+	tracer, err := tracers.New(PrestateTracerName, &tracers.Context{}, json.RawMessage(`{"diffMode":true}`))
+	require.NoError(t, err)
+	tracer.CaptureTxStart(21000)
+	tracer.CaptureStart(env, testFrom, testTo, false, nil, 21000, big.NewInt(0))
+	tracer.(*prestateTracer).lookupStorage(testTo, slot)
+
+	// the tx bumps the nonce of the sender and clears the slot
+	statedb.SetNonce(testFrom, 2)
+	statedb.SetState(testTo, slot, common.Hash{})
+	tracer.CaptureEnd(nil, 0, 0, nil)
+	tracer.CaptureTxEnd(0)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	var diff struct {
+		Pre  map[common.Address]map[string]interface{} `json:"pre"`
+		Post map[common.Address]map[string]interface{} `json:"post"`
+	}
+	require.NoError(t, json.Unmarshal(res, &diff))
+
+	// the untouched coinbase is left out of both sides
+	require.Len(t, diff.Pre, 2)
+	require.Equal(t, map[string]interface{}{slot.Hex(): value.Hex()}, diff.Pre[testTo]["storage"])
+	require.Nil(t, diff.Post[testTo]["storage"])
+	// the gas bought is added back to the pre balance of the sender, the bumped nonce is taken off
+	require.Equal(t, "0xf9448", diff.Pre[testFrom]["balance"])
+	require.Nil(t, diff.Pre[testFrom]["nonce"])
+	require.Equal(t, "0xf4240", diff.Post[testFrom]["balance"])
+	require.Equal(t, float64(2), diff.Post[testFrom]["nonce"])
+}
@@ -45,6 +45,11 @@ type ChainConfig struct {
 
 	YoloV2Block sdk.Int `json:"yoloV2_block" yaml:"yoloV2_block"` // YOLO v1: https://github.com/ethereum/EIPs/pull/2657 (Ephemeral testnet)
 	EWASMBlock  sdk.Int `json:"ewasm_block" yaml:"ewasm_block"`   // EWASM switch block (< 0 no fork, 0 = already activated)
+
+	// NOTE: uninitialized Berlin and London blocks (i.e chain configs stored before they were added) are
+	// considered as no fork.
+	BerlinBlock sdk.Int `json:"berlin_block" yaml:"berlin_block"` // Berlin switch block (< 0 no fork, 0 = already on berlin)
+	LondonBlock sdk.Int `json:"london_block" yaml:"london_block"` // London switch block (< 0 no fork, 0 = already on london)
 }
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
@@ -64,6 +69,8 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		PetersburgBlock:     getBlockValue(cc.PetersburgBlock),
 		IstanbulBlock:       getBlockValue(cc.IstanbulBlock),
 		MuirGlacierBlock:    getBlockValue(cc.MuirGlacierBlock),
+		BerlinBlock:         getBlockValue(cc.BerlinBlock),
+		LondonBlock:         getBlockValue(cc.LondonBlock),
 	}
 }
 
@@ -72,6 +79,11 @@ func (cc ChainConfig) IsIstanbul() bool {
 	return getBlockValue(cc.IstanbulBlock) != nil
 }
 
+// IsBerlin returns whether the Berlin version is enabled at the block number.
+func (cc ChainConfig) IsBerlin(num *big.Int) bool {
+	return cc.EthereumConfig(nil).IsBerlin(num)
+}
+
 // IsHomestead returns whether the Homestead version is enabled.
 func (cc ChainConfig) IsHomestead() bool {
 	return getBlockValue(cc.HomesteadBlock) != nil
@@ -100,11 +112,13 @@ func DefaultChainConfig() ChainConfig {
 		MuirGlacierBlock:    sdk.ZeroInt(),
 		YoloV2Block:         sdk.NewInt(-1),
 		EWASMBlock:          sdk.NewInt(-1),
+		BerlinBlock:         sdk.NewInt(-1),
+		LondonBlock:         sdk.NewInt(-1),
 	}
 }
 
 func getBlockValue(block sdk.Int) *big.Int {
-	if block.IsNegative() {
+	if block.IsNil() || block.IsNegative() {
 		return nil
 	}
 
@@ -153,6 +167,15 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.EWASMBlock); err != nil {
 		return sdkerrors.Wrap(err, "eWASMBlock")
 	}
+	if err := validateOptionalBlock(cc.BerlinBlock); err != nil {
+		return sdkerrors.Wrap(err, "berlinBlock")
+	}
+	if err := validateOptionalBlock(cc.LondonBlock); err != nil {
+		return sdkerrors.Wrap(err, "londonBlock")
+	}
+	if getBlockValue(cc.LondonBlock) != nil && getBlockValue(cc.BerlinBlock) == nil {
+		return sdkerrors.Wrap(ErrInvalidChainConfig, "london fork cannot be enabled without the berlin fork")
+	}
 
 	return nil
 }
@@ -168,14 +191,15 @@ func (config *ChainConfig) UnmarshalFromAmino(cdc *amino.Codec, data []byte) err
 			break
 		}
 
-		pos, aminoType, err := amino.ParseProtoPosAndTypeMustOneByte(data[0])
+		// the field keys of london_block and later are longer than one byte
+		key, n, err := amino.DecodeUvarint(data)
 		if err != nil {
 			return err
 		}
-		data = data[1:]
+		pos, aminoType := int(key>>3), amino.Typ3(key&0x07)
+		data = data[n:]
 
 		if aminoType == amino.Typ3_ByteLength {
-			var n int
 			dataLen, n, err = amino.DecodeUvarint(data)
 			if err != nil {
 				return err
@@ -253,10 +277,28 @@ func (config *ChainConfig) UnmarshalFromAmino(cdc *amino.Codec, data []byte) err
 			if err != nil {
 				return err
 			}
+		case 15:
+			err = config.BerlinBlock.UnmarshalFromAmino(cdc, subData)
+			if err != nil {
+				return err
+			}
+		case 16:
+			err = config.LondonBlock.UnmarshalFromAmino(cdc, subData)
+			if err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
 	}
+
+	// the chain configs stored before the berlin and london blocks were added don't encode them
+	if config.BerlinBlock.IsNil() {
+		config.BerlinBlock = sdk.NewInt(-1)
+	}
+	if config.LondonBlock.IsNil() {
+		config.LondonBlock = sdk.NewInt(-1)
+	}
 	return nil
 }
 
@@ -284,3 +326,10 @@ func validateBlock(block sdk.Int) error {
 
 	return nil
 }
+
+func validateOptionalBlock(block sdk.Int) error {
+	if block.IsNil() {
+		return nil
+	}
+	return validateBlock(block)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -38,6 +39,8 @@ func TestChainConfigValidate(t *testing.T) {
 				MuirGlacierBlock:    sdk.OneInt(),
 				YoloV2Block:         sdk.OneInt(),
 				EWASMBlock:          sdk.OneInt(),
+				BerlinBlock:         sdk.OneInt(),
+				LondonBlock:         sdk.OneInt(),
 			},
 			false,
 		},
@@ -215,6 +218,27 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid BerlinBlock",
+			ChainConfig{
+				HomesteadBlock:      sdk.OneInt(),
+				DAOForkBlock:        sdk.OneInt(),
+				EIP150Block:         sdk.OneInt(),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         sdk.OneInt(),
+				EIP158Block:         sdk.OneInt(),
+				ByzantiumBlock:      sdk.OneInt(),
+				ConstantinopleBlock: sdk.OneInt(),
+				PetersburgBlock:     sdk.OneInt(),
+				IstanbulBlock:       sdk.OneInt(),
+				MuirGlacierBlock:    sdk.OneInt(),
+				YoloV2Block:         sdk.OneInt(),
+				EWASMBlock:          sdk.OneInt(),
+				BerlinBlock:         sdk.NewInt(-1),
+				LondonBlock:         sdk.OneInt(),
+			},
+			true,
+		},
 		{
 			"invalid hash",
 			ChainConfig{
@@ -262,6 +286,8 @@ istanbul_block: "0"
 muir_glacier_block: "0"
 yoloV2_block: "-1"
 ewasm_block: "-1"
+berlin_block: "-1"
+london_block: "-1"
 `
 	require.Equal(t, configStr, DefaultChainConfig().String())
 }
@@ -288,6 +314,8 @@ func TestChainConfigAmino(t *testing.T) {
 			sdk.NewInt(9),
 			sdk.NewInt(10),
 			sdk.NewInt(11),
+			sdk.NewInt(12),
+			sdk.NewInt(13),
 		},
 		{
 			HomesteadBlock:      sdk.NewInt(math.MaxInt64),
@@ -302,6 +330,8 @@ func TestChainConfigAmino(t *testing.T) {
 			MuirGlacierBlock:    sdk.NewInt(math.MaxInt64),
 			YoloV2Block:         sdk.NewInt(math.MaxInt64),
 			EWASMBlock:          sdk.NewInt(math.MaxInt64),
+			BerlinBlock:         sdk.NewInt(math.MaxInt64),
+			LondonBlock:         sdk.NewInt(math.MaxInt64),
 		},
 		{
 			HomesteadBlock:      sdk.NewInt(math.MinInt64),
@@ -316,6 +346,8 @@ func TestChainConfigAmino(t *testing.T) {
 			MuirGlacierBlock:    sdk.NewInt(math.MinInt64),
 			YoloV2Block:         sdk.NewInt(math.MinInt64),
 			EWASMBlock:          sdk.NewInt(math.MinInt64),
+			BerlinBlock:         sdk.NewInt(math.MinInt64),
+			LondonBlock:         sdk.NewInt(math.MinInt64),
 		},
 	}
 
@@ -342,3 +374,28 @@ func TestChainConfigAmino(t *testing.T) {
 		require.EqualValues(t, expectValue, actualValue)
 	}
 }
+
+func TestChainConfigAminoWithoutBerlinAndLondon(t *testing.T) {
+	cdc := amino.NewCodec()
+	RegisterCodec(cdc)
+
+	config := DefaultChainConfig()
+	config.BerlinBlock = sdk.ZeroInt()
+	config.LondonBlock = sdk.ZeroInt()
+	data, err := cdc.MarshalBinaryBare(config)
+	require.NoError(t, err)
+
+	// drop the berlin_block and london_block fields, as in the chain configs stored before they were added,
+	// the berlin_block field is the 15th one with the encoded "0" value
+	end := bytes.LastIndex(data, []byte{15<<3 | byte(amino.Typ3_ByteLength), 1, '0'})
+	require.True(t, end > 4)
+
+	var actual ChainConfig
+	require.NoError(t, actual.UnmarshalFromAmino(cdc, data[4:end]))
+	require.Equal(t, DefaultChainConfig().String(), actual.String())
+	require.Nil(t, actual.EthereumConfig(nil).BerlinBlock)
+	require.Nil(t, actual.EthereumConfig(nil).LondonBlock)
+
+	require.NoError(t, actual.UnmarshalFromAmino(cdc, data[4:]))
+	require.Equal(t, config.String(), actual.String())
+}
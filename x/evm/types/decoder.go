@@ -57,6 +57,12 @@ func TxDecoder(cdc codec.CdcAbstraction) sdk.TxDecoder {
 			if tx, err = f(cdc, txBytes); err == nil {
 				tx.SetRaw(txBytes)
 				tx.SetTxHash(types.Tx(txBytes).Hash())
+				// the typed evmtx(evmDecoder) is only accepted after venus8
+				if index == 0 && height > IGNORE_HEIGHT_CHECKING && !types.HigherThanVenus8(height) {
+					if ethTx, ok := tx.(*MsgEthereumTx); ok && ethTx.Data.Type != LegacyTxType {
+						return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "typed eth tx is not supported before venus8")
+					}
+				}
 				// index=0 means it is a evmtx(evmDecoder) ,we wont verify again
 				// height > IGNORE_HEIGHT_CHECKING means it is a query request
 				if index > 0 && height > IGNORE_HEIGHT_CHECKING {
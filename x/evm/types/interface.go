@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
@@ -22,3 +23,17 @@ type EvmLogHandler interface {
 	// Handle Process the log
 	Handle(ctx sdk.Context, contract common.Address, data []byte) error
 }
+
+// PrecompileAddress is the address of the cm bridge contract, which all the precompiles are called at
+var PrecompileAddress = common.BytesToAddress([]byte{0x01, 0x00})
+
+// Precompile defines the interface for a precompile which executes the native module calls of evm contracts
+type Precompile interface {
+	// ABI Return the abi of the methods the precompile handles
+	ABI() ABI
+	// RequiredGas Return the gas charged before the method is run, the gas consumed by the native module call
+	// is charged on top of it
+	RequiredGas(method *abi.Method) uint64
+	// Run Execute the method called by caller with the unpacked args, and return the packed outputs
+	Run(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}) ([]byte, error)
+}
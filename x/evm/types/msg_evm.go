@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/okx/okbchain/app/types"
@@ -28,6 +29,7 @@ var (
 
 var big2 = big.NewInt(2)
 var big8 = big.NewInt(8)
+var big27 = big.NewInt(27)
 var DefaultDeployContractFnSignature = ethcmn.Hex2Bytes("0000000000000000000000000000000000000000000000000000000000000001")
 var DefaultSendCoinFnSignature = ethcmn.Hex2Bytes("0000000000000000000000000000000000000000000000000000000000000010")
 var emptyEthAddr = ethcmn.Address{}
@@ -181,6 +183,10 @@ func (msg *MsgEthereumTx) ValidateBasic() error {
 		return sdkerrors.Wrapf(types.ErrInvalidValue, "gas price cannot be non positive %s", msg.Data.Price)
 	}
 
+	if err := msg.validateTypedTx(); err != nil {
+		return err
+	}
+
 	// Amount can be 0
 	if msg.Data.Amount.Sign() == -1 {
 		return sdkerrors.Wrapf(types.ErrInvalidValue, "amount cannot be negative %s", msg.Data.Amount)
@@ -237,6 +243,10 @@ var rlpHashDataPool = &sync.Pool{
 // RLPSignBytes returns the RLP hash of an Ethereum transaction message with a
 // given chainID used for signing.
 func (msg *MsgEthereumTx) RLPSignBytes(chainID *big.Int) (h ethcmn.Hash) {
+	if msg.Data.Type != LegacyTxType {
+		return ethtypes.NewLondonSigner(chainID).Hash(msg.toEthTx())
+	}
+
 	rlpData := rlpHashDataPool.Get().(*rlpHashData)
 	rlpData.GasLimit = msg.Data.GasLimit
 	rlpData.Payload = msg.Data.Payload
@@ -271,18 +281,35 @@ func (msg *MsgEthereumTx) HomesteadSignHash() ethcmn.Hash {
 }
 
 // EncodeRLP implements the rlp.Encoder interface.
+// A typed transaction is encoded as an rlp string of its EIP-2718 envelope.
 func (msg *MsgEthereumTx) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &msg.Data)
+	if msg.Data.Type == LegacyTxType {
+		return rlp.Encode(w, &msg.Data)
+	}
+	bz, err := msg.toEthTx().MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, bz)
 }
 
 // DecodeRLP implements the rlp.Decoder interface.
 func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
-	_, _, err := s.Kind()
+	kind, _, err := s.Kind()
 	if err != nil {
 		// return error if stream is too large
 		return err
 	}
 
+	if kind != rlp.List {
+		// it's an EIP-2718 typed tx envelope
+		bz, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		return msg.decodeTyped(bz)
+	}
+
 	if err := s.Decode(&msg.Data); err != nil {
 		return err
 	}
@@ -295,6 +322,13 @@ func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
 // EIP155 standard. It mutates the transaction as it populates the V, R, S
 // fields of the Transaction's Signature.
 func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
+	if msg.Data.Type != LegacyTxType {
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Sign() == 0 {
+			msg.Data.ChainID = new(big.Int).Set(chainID)
+		} else if msg.Data.ChainID.Cmp(chainID) != 0 {
+			return ethtypes.ErrInvalidChainId
+		}
+	}
 	txHash := msg.RLPSignBytes(chainID)
 
 	sig, err := ethcrypto.Sign(txHash[:], priv)
@@ -311,7 +345,10 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 
 	var v *big.Int
 
-	if chainID.Sign() == 0 {
+	if msg.Data.Type != LegacyTxType {
+		// typed txs use 0 and 1 as their recovery id
+		v = big.NewInt(int64(sig[64]))
+	} else if chainID.Sign() == 0 {
 		v = new(big.Int).SetBytes([]byte{sig[64] + 27})
 	} else {
 		v = big.NewInt(int64(sig[64] + 35))
@@ -335,7 +372,16 @@ var sigBigNumPool = &sync.Pool{
 func (msg *MsgEthereumTx) firstVerifySig(chainID *big.Int) (ethcmn.Address, error) {
 	var V *big.Int
 	var sigHash ethcmn.Hash
-	if isProtectedV(msg.Data.V) {
+	if msg.Data.Type != LegacyTxType {
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Cmp(chainID) != 0 {
+			return emptyEthAddr, ethtypes.ErrInvalidChainId
+		}
+		// typed txs use 0 and 1 as their recovery id, add 27 to become
+		// equivalent to unprotected Homestead signatures
+		V = new(big.Int).Add(msg.Data.V, big27)
+
+		sigHash = msg.RLPSignBytes(chainID)
+	} else if isProtectedV(msg.Data.V) {
 		// do not allow recovery for transactions with an unprotected chainID
 		if chainID.Sign() == 0 {
 			return emptyEthAddr, errors.New("chainID cannot be zero")
@@ -403,6 +449,9 @@ func (msg *MsgEthereumTx) GetGas() uint64 {
 
 // Protected says whether the transaction is replay-protected.
 func (msg *MsgEthereumTx) Protected() bool {
+	if msg.Data.Type != LegacyTxType {
+		return true
+	}
 	return isProtectedV(msg.Data.V)
 }
 
@@ -423,6 +472,12 @@ func (msg *MsgEthereumTx) CalcFee(fee *big.Int) *big.Int {
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (msg *MsgEthereumTx) ChainID() *big.Int {
+	if msg.Data.Type != LegacyTxType {
+		if msg.Data.ChainID == nil {
+			return new(big.Int)
+		}
+		return msg.Data.ChainID
+	}
 	return deriveChainID(msg.Data.V)
 }
 
@@ -0,0 +1,198 @@
+package types
+
+import (
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/okx/okbchain/app/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// NewMsgEthereumTxAccessList returns a reference to a new EIP-2930 access list transaction message.
+func NewMsgEthereumTxAccessList(
+	chainID *big.Int, nonce uint64, to *ethcmn.Address, amount *big.Int,
+	gasLimit uint64, gasPrice *big.Int, payload []byte, accesses ethtypes.AccessList,
+) *MsgEthereumTx {
+	msg := newMsgEthereumTx(nonce, to, amount, gasLimit, gasPrice, payload)
+	msg.Data.Type = AccessListTxType
+	msg.Data.ChainID = copyBigInt(chainID)
+	msg.Data.Accesses = copyAccessList(accesses)
+	return msg
+}
+
+// NewMsgEthereumTxDynamicFee returns a reference to a new EIP-1559 dynamic fee transaction message.
+// The gas price of the message is the fee cap, as there is no base fee to burn.
+func NewMsgEthereumTxDynamicFee(
+	chainID *big.Int, nonce uint64, to *ethcmn.Address, amount *big.Int,
+	gasLimit uint64, gasTipCap, gasFeeCap *big.Int, payload []byte, accesses ethtypes.AccessList,
+) *MsgEthereumTx {
+	msg := newMsgEthereumTx(nonce, to, amount, gasLimit, gasFeeCap, payload)
+	msg.Data.Type = DynamicFeeTxType
+	msg.Data.ChainID = copyBigInt(chainID)
+	msg.Data.GasTipCap = copyBigInt(gasTipCap)
+	msg.Data.GasFeeCap = copyBigInt(gasFeeCap)
+	msg.Data.Accesses = copyAccessList(accesses)
+	return msg
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+// For legacy transactions, it returns the RLP encoding. For EIP-2718 typed
+// transactions, it returns the type and payload.
+func (msg *MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if msg.Data.Type == LegacyTxType {
+		return rlp.EncodeToBytes(&msg.Data)
+	}
+	return msg.toEthTx().MarshalBinary()
+}
+
+// UnmarshalBinary decodes the canonical encoding of transactions.
+// It supports legacy RLP transactions and EIP-2718 typed transactions.
+func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] > 0x7f {
+		// it's a legacy transaction
+		return rlp.DecodeBytes(b, &msg.Data)
+	}
+	return msg.decodeTyped(b)
+}
+
+// decodeTyped decodes an EIP-2718 typed tx envelope into the message.
+func (msg *MsgEthereumTx) decodeTyped(b []byte) error {
+	var tx ethtypes.Transaction
+	if err := tx.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	if tx.Type() == LegacyTxType {
+		return ethtypes.ErrInvalidTxType
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	msg.Data = TxData{
+		AccountNonce: tx.Nonce(),
+		// the gas price of a dynamic fee tx is its fee cap
+		Price:     tx.GasPrice(),
+		GasLimit:  tx.Gas(),
+		Recipient: tx.To(),
+		Amount:    tx.Value(),
+		Payload:   tx.Data(),
+		V:         v,
+		R:         r,
+		S:         s,
+		Type:      tx.Type(),
+		ChainID:   tx.ChainId(),
+	}
+	if len(tx.AccessList()) != 0 {
+		msg.Data.Accesses = tx.AccessList()
+	}
+	if tx.Type() == DynamicFeeTxType {
+		msg.Data.GasTipCap = tx.GasTipCap()
+		msg.Data.GasFeeCap = tx.GasFeeCap()
+	}
+	return nil
+}
+
+// toEthTx converts the message to a go-ethereum transaction.
+func (msg *MsgEthereumTx) toEthTx() *ethtypes.Transaction {
+	var inner ethtypes.TxData
+	switch msg.Data.Type {
+	case AccessListTxType:
+		inner = &ethtypes.AccessListTx{
+			ChainID:    msg.Data.ChainID,
+			Nonce:      msg.Data.AccountNonce,
+			GasPrice:   msg.Data.Price,
+			Gas:        msg.Data.GasLimit,
+			To:         msg.Data.Recipient,
+			Value:      msg.Data.Amount,
+			Data:       msg.Data.Payload,
+			AccessList: msg.Data.Accesses,
+			V:          msg.Data.V,
+			R:          msg.Data.R,
+			S:          msg.Data.S,
+		}
+	case DynamicFeeTxType:
+		inner = &ethtypes.DynamicFeeTx{
+			ChainID:    msg.Data.ChainID,
+			Nonce:      msg.Data.AccountNonce,
+			GasTipCap:  msg.Data.GasTipCap,
+			GasFeeCap:  msg.Data.GasFeeCap,
+			Gas:        msg.Data.GasLimit,
+			To:         msg.Data.Recipient,
+			Value:      msg.Data.Amount,
+			Data:       msg.Data.Payload,
+			AccessList: msg.Data.Accesses,
+			V:          msg.Data.V,
+			R:          msg.Data.R,
+			S:          msg.Data.S,
+		}
+	default:
+		inner = &ethtypes.LegacyTx{
+			Nonce:    msg.Data.AccountNonce,
+			GasPrice: msg.Data.Price,
+			Gas:      msg.Data.GasLimit,
+			To:       msg.Data.Recipient,
+			Value:    msg.Data.Amount,
+			Data:     msg.Data.Payload,
+			V:        msg.Data.V,
+			R:        msg.Data.R,
+			S:        msg.Data.S,
+		}
+	}
+	return ethtypes.NewTx(inner)
+}
+
+// AccessList returns the EIP-2930 access list of the transaction, it is nil for legacy transactions.
+func (msg *MsgEthereumTx) AccessList() ethtypes.AccessList {
+	return msg.Data.Accesses
+}
+
+// TxType returns the EIP-2718 type of the transaction.
+func (msg *MsgEthereumTx) TxType() uint8 {
+	return msg.Data.Type
+}
+
+func (msg *MsgEthereumTx) validateTypedTx() error {
+	switch msg.Data.Type {
+	case LegacyTxType:
+		return nil
+	case AccessListTxType:
+	case DynamicFeeTxType:
+		if msg.Data.GasTipCap == nil || msg.Data.GasTipCap.Sign() < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "max priority fee per gas cannot be negative %s", msg.Data.GasTipCap)
+		}
+		if msg.Data.GasFeeCap == nil || msg.Data.GasFeeCap.Cmp(msg.Data.GasTipCap) < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "max priority fee per gas higher than max fee per gas, tip: %s, fee cap: %s",
+				msg.Data.GasTipCap, msg.Data.GasFeeCap)
+		}
+		if msg.Data.Price.Cmp(msg.Data.GasFeeCap) != 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "gas price %s must be equal to max fee per gas %s", msg.Data.Price, msg.Data.GasFeeCap)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidValue, "unsupported tx type %d", msg.Data.Type)
+	}
+	if msg.Data.ChainID == nil || msg.Data.ChainID.Sign() <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidValue, "chain id of typed tx must be positive %s", msg.Data.ChainID)
+	}
+	return nil
+}
+
+func copyBigInt(i *big.Int) *big.Int {
+	if i == nil {
+		return nil
+	}
+	return new(big.Int).Set(i)
+}
+
+func copyAccessList(al ethtypes.AccessList) ethtypes.AccessList {
+	if len(al) == 0 {
+		return nil
+	}
+	cpy := make(ethtypes.AccessList, len(al))
+	for i, tuple := range al {
+		cpy[i] = ethtypes.AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]ethcmn.Hash{}, tuple.StorageKeys...),
+		}
+	}
+	return cpy
+}
@@ -551,3 +551,111 @@ func TestRlpPointerEncode(t *testing.T) {
 
 	require.Equal(t, h1, h2)
 }
+
+func TestMsgEthereumTxTyped(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := ethcmn.BytesToAddress([]byte("test_address"))
+	accesses := ethtypes.AccessList{{Address: to, StorageKeys: []ethcmn.Hash{ethcmn.BigToHash(big.NewInt(1))}}}
+
+	testCases := []struct {
+		name  string
+		ethTx ethtypes.TxData
+	}{
+		{
+			"access list tx",
+			&ethtypes.AccessListTx{ChainID: chainID, Nonce: 1, GasPrice: big.NewInt(10), Gas: 100000, To: &to,
+				Value: big.NewInt(1), Data: []byte("test"), AccessList: accesses},
+		},
+		{
+			"dynamic fee tx",
+			&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000, To: &to,
+				Value: big.NewInt(1), Data: []byte("test"), AccessList: accesses},
+		},
+		{
+			"dynamic fee contract creation without access list",
+			&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 100000,
+				Value: big.NewInt(0), Data: []byte("test")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ethTx, err := ethtypes.SignNewTx(priv.ToECDSA(), ethtypes.NewLondonSigner(chainID), tc.ethTx)
+			require.NoError(t, err)
+			raw, err := ethTx.MarshalBinary()
+			require.NoError(t, err)
+
+			// decode the envelope signed by go-ethereum
+			var msg MsgEthereumTx
+			require.NoError(t, authtypes.EthereumTxDecode(raw, &msg))
+			require.Equal(t, ethTx.Type(), msg.Data.Type)
+			require.Equal(t, ethTx.GasPrice(), msg.Data.Price)
+			require.True(t, msg.Protected())
+			require.Equal(t, chainID, msg.ChainID())
+			require.NoError(t, msg.ValidateBasic())
+			// the typed tx is hashed as go-ethereum
+			require.Equal(t, ethTx.Hash().Bytes(), tmtypes.Tx(raw).Hash())
+
+			// sign hash and signature are the same as go-ethereum
+			require.Equal(t, ethtypes.NewLondonSigner(chainID).Hash(ethTx), msg.RLPSignBytes(chainID))
+			sender, err := msg.firstVerifySig(chainID)
+			require.NoError(t, err)
+			require.Equal(t, from, sender)
+			_, err = msg.firstVerifySig(big.NewInt(4))
+			require.Error(t, err)
+
+			// re-encode
+			bz, err := authtypes.EthereumTxEncode(&msg)
+			require.NoError(t, err)
+			require.Equal(t, raw, bz)
+
+			// rlp wrapped envelope
+			bz, err = rlp.EncodeToBytes(&msg)
+			require.NoError(t, err)
+			var msg2 MsgEthereumTx
+			require.NoError(t, rlp.DecodeBytes(bz, &msg2))
+			require.Equal(t, msg.Data, msg2.Data)
+
+			// amino
+			bz, err = ModuleCdc.MarshalBinaryBare(&msg)
+			require.NoError(t, err)
+			var msg3 MsgEthereumTx
+			require.NoError(t, ModuleCdc.UnmarshalBinaryBare(bz, &msg3))
+			require.Equal(t, msg.Data, msg3.Data)
+			var msg4 MsgEthereumTx
+			v, err := ModuleCdc.UnmarshalBinaryBareWithRegisteredUnmarshaller(bz, &msg4)
+			require.NoError(t, err)
+			require.Equal(t, msg.Data, v.(*MsgEthereumTx).Data)
+
+			// sign by the msg itself
+			msg5 := msg
+			msg5.Data.V, msg5.Data.R, msg5.Data.S = nil, nil, nil
+			require.NoError(t, msg5.Sign(chainID, priv.ToECDSA()))
+			require.Equal(t, msg.Data, msg5.Data)
+		})
+	}
+}
+
+func TestMsgEthereumTxTypedValidation(t *testing.T) {
+	chainID := big.NewInt(3)
+	to := ethcmn.BytesToAddress([]byte("test_address"))
+
+	msg := NewMsgEthereumTxDynamicFee(chainID, 0, &to, big.NewInt(1), 100000, big.NewInt(2), big.NewInt(1), nil, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = NewMsgEthereumTxDynamicFee(chainID, 0, &to, big.NewInt(1), 100000, big.NewInt(1), big.NewInt(2), nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, big.NewInt(200000), msg.Fee())
+
+	msg = NewMsgEthereumTxAccessList(nil, 0, &to, big.NewInt(1), 100000, big.NewInt(1), nil, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = NewMsgEthereumTxAccessList(chainID, 0, &to, big.NewInt(1), 100000, big.NewInt(1), nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+
+	// the chain id in a typed tx must match the signer
+	priv, _ := ethsecp256k1.GenerateKey()
+	require.Error(t, msg.Sign(big.NewInt(4), priv.ToECDSA()))
+}
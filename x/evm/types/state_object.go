@@ -306,6 +306,16 @@ func (so *stateObject) Balance() *big.Int {
 	return balance
 }
 
+// SpendableBalance returns the state object's current balance which is not locked
+// by the vesting schedule of the account.
+func (so *stateObject) SpendableBalance() *big.Int {
+	balance := so.account.SpendableBalance(sdk.DefaultBondDenom, so.stateDB.ctx.BlockTime()).BigInt()
+	if balance == nil {
+		return zeroBalance
+	}
+	return balance
+}
+
 // CodeHash returns the state object's code hash.
 func (so *stateObject) CodeHash() []byte {
 	if so.account == nil || len(so.account.CodeHash) == 0 {
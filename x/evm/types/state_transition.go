@@ -30,6 +30,7 @@ type StateTransition struct {
 	Recipient    *common.Address
 	Amount       *big.Int
 	Payload      []byte
+	AccessList   ethtypes.AccessList
 
 	ChainID    *big.Int
 	Csdb       *CommitStateDB
@@ -99,6 +100,7 @@ func (st *StateTransition) newEVM(
 		Time:        big.NewInt(ctx.BlockTime().Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		GasLimit:    gasLimit,
+		BaseFee:     big.NewInt(0), // unused. Only required by the BASEFEE opcode after london
 	}
 	ctx.SetEVMStateDB(st.Csdb)
 	txCtx := vm.TxContext{
@@ -148,7 +150,12 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		}
 	}()
 
-	cost, err := core.IntrinsicGas(st.Payload, []ethtypes.AccessTuple{}, contractCreation, config.IsHomestead(), config.IsIstanbul())
+	// the access list is only charged after berlin, when it's warmed up
+	var accessList ethtypes.AccessList
+	if config.IsBerlin(big.NewInt(ctx.BlockHeight())) {
+		accessList = st.AccessList
+	}
+	cost, err := core.IntrinsicGas(st.Payload, accessList, contractCreation, config.IsHomestead(), config.IsIstanbul())
 	if err != nil {
 		return exeRes, resData, sdkerrors.Wrap(err, "invalid intrinsic gas for transaction"), innerTxs, erc20Contracts
 	}
@@ -220,9 +227,18 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	// Set nonce of sender account before evm state transition for usage in generating Create address
 	csdb.SetNonce(st.Sender, st.AccountNonce)
 
+	// warm up the sender, the recipient, the precompiles and the tx access list (EIP-2929, EIP-2930)
+	if rules := evm.ChainConfig().Rules(evm.Context.BlockNumber, false); rules.IsBerlin {
+		csdb.PrepareAccessList(st.Sender, st.Recipient, vm.ActivePrecompiles(rules), st.AccessList)
+	}
+
 	//add InnerTx
 	callTx := innertx.AddDefaultInnerTx(evm, innertx.CosmosDepth, senderStr, "", "", "", st.Amount, nil, st.Payload)
 
+	if st.TraceTxLog {
+		tracer.CaptureTxStart(st.GasLimit)
+	}
+
 	// create contract or execute call
 	switch contractCreation {
 	case true:
@@ -315,6 +331,10 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		innertx.UpdateDefaultInnerTx(callTx, recipientStr, innertx.CosmosCallType, innertx.EvmCallName, gasConsumed, 0)
 	}
 
+	if st.TraceTxLog {
+		tracer.CaptureTxEnd(leftOverGas)
+	}
+
 	innerTxs, erc20Contracts = innertx.ParseInnerTxAndContract(evm, err != nil)
 
 	defer func() {
@@ -420,6 +440,53 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	return
 }
 
+// StaticCall executes a read-only message call to the recipient against the current state. Any
+// attempt to modify the state makes the call fail. It returns the call's return data and the gas
+// it consumed, which never exceeds the gas limit of the state transition.
+func (st StateTransition) StaticCall(ctx sdk.Context, config ChainConfig) (ret []byte, gasConsumed uint64, err error) {
+	if st.Recipient == nil {
+		return nil, 0, errors.New("static call requires a recipient")
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			// blocked contracts are reported by a panic of the contract verifier
+			rType, ok := e.(ErrContractBlockedVerify)
+			if !ok {
+				panic(e)
+			}
+			ret, gasConsumed, err = nil, st.GasLimit, ErrCallBlockedContract(rType.Descriptor)
+		}
+	}()
+
+	// the caller accounts for the consumed evm gas, so store access must not be charged twice
+	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	csdb := st.Csdb.WithContext(ctx)
+	params := csdb.GetParams()
+	vmConfig := vm.Config{
+		ExtraEips:        params.ExtraEIPs,
+		ContractVerifier: NewContractVerifier(params),
+	}
+
+	evm := st.newEVM(ctx, csdb, st.GasLimit, big.NewInt(0), &config, vmConfig)
+	ret, leftOverGas, err := evm.StaticCall(vm.AccountRef(st.Sender), *st.Recipient, st.Payload, st.GasLimit)
+
+	gasConsumed = st.GasLimit - leftOverGas
+	if !csdb.GuFactor.IsNegative() {
+		gasConsumed = csdb.GuFactor.MulInt(sdk.NewIntFromUint64(gasConsumed)).TruncateInt().Uint64()
+	}
+	if gasConsumed > st.GasLimit {
+		gasConsumed = st.GasLimit
+		if err == nil {
+			err = vm.ErrOutOfGas
+		}
+	}
+	if err != nil {
+		return nil, gasConsumed, newRevertError(ret, err)
+	}
+	return ret, gasConsumed, nil
+}
+
 func newRevertError(data []byte, e error) error {
 	var resultError []string
 	if data == nil || e.Error() != vm.ErrExecutionReverted.Error() {
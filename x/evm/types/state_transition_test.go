@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
 	ethermint "github.com/okx/okbchain/app/types"
@@ -519,3 +520,55 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 	suite.Require().Equal(sdk.NewDec(4930).BigInt(), fromBalance)
 	suite.Require().Equal(sdk.NewDec(60).BigInt(), toBalance)
 }
+
+func (suite *StateDBTestSuite) TestTransitionDbAccessList() {
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	recipient := ethcmn.BytesToAddress([]byte("recipient"))
+	accessed := ethcmn.BytesToAddress([]byte("accessed"))
+	slot := ethcmn.BigToHash(big.NewInt(1))
+	precompile := ethcmn.BytesToAddress([]byte{1})
+
+	berlinConfig := types.DefaultChainConfig()
+	berlinConfig.BerlinBlock = sdk.ZeroInt()
+
+	for _, tc := range []struct {
+		name        string
+		config      types.ChainConfig
+		expected    bool
+		expectedGas uint64
+	}{
+		// the access list is charged only when it's warmed up
+		{"before berlin", types.DefaultChainConfig(), false, 21000},
+		{"after berlin", berlinConfig, true, 21000 + 2400 + 1900},
+	} {
+		ctx := suite.ctx
+		ctx.SetGasMeter(sdk.NewGasMeter(100000))
+		csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), ctx)
+		st := types.StateTransition{
+			AccountNonce: csdb.GetNonce(suite.address),
+			Price:        sdk.NewDec(10).BigInt(),
+			GasLimit:     100000,
+			Recipient:    &recipient,
+			Amount:       sdk.NewDec(1).BigInt(),
+			ChainID:      big.NewInt(1),
+			Csdb:         csdb,
+			TxHash:       &ethcmn.Hash{},
+			Sender:       suite.address,
+			Simulate:     suite.ctx.IsCheckTx(),
+			AccessList:   ethtypes.AccessList{{Address: accessed, StorageKeys: []ethcmn.Hash{slot}}},
+		}
+
+		_, _, err, _, _ := st.TransitionDb(ctx, tc.config)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expectedGas, ctx.GasMeter().GasConsumed(), tc.name)
+		suite.Require().Equal(tc.expected, csdb.AddressInAccessList(suite.address), tc.name)
+		suite.Require().Equal(tc.expected, csdb.AddressInAccessList(recipient), tc.name)
+		suite.Require().Equal(tc.expected, csdb.AddressInAccessList(precompile), tc.name)
+		addrOk, slotOk := csdb.SlotInAccessList(accessed, slot)
+		suite.Require().Equal(tc.expected, addrOk && slotOk, tc.name)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/okx/okbchain/libs/cosmos-sdk/store/types"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 )
 
 var (
@@ -586,7 +587,7 @@ func (csdb *CommitStateDB) PrepareAccessList(sender ethcmn.Address, dest *ethcmn
 	}
 
 	csdb.AddAddressToAccessList(sender)
-	if csdb != nil {
+	if dest != nil {
 		csdb.AddAddressToAccessList(*dest)
 		// If it's a create-tx, the destination will be added inside evm.create
 	}
@@ -652,7 +653,8 @@ func (csdb *CommitStateDB) GetParams() Params {
 }
 
 // GetBalance retrieves the balance from the given address or 0 if object not
-// found.
+// found. From venus8 on it is the spendable balance, i.e. the balance not locked
+// by vesting.
 func (csdb *CommitStateDB) GetBalance(addr ethcmn.Address) *big.Int {
 	if !csdb.ctx.IsCheckTx() {
 		funcName := "GetBalance"
@@ -662,6 +664,9 @@ func (csdb *CommitStateDB) GetBalance(addr ethcmn.Address) *big.Int {
 
 	so := csdb.getStateObject(addr)
 	if so != nil {
+		if tmtypes.HigherThanVenus8(csdb.ctx.BlockHeight()) {
+			return so.SpendableBalance()
+		}
 		return so.Balance()
 	}
 
@@ -13,11 +13,16 @@ import (
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	json "github.com/json-iterator/go"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+
+	// register the native tracers: callTracer, prestateTracer, 4byteTracer and accessListTracer
+	_ "github.com/okx/okbchain/x/evm/tracers/native"
 )
 
 type TraceConfig struct {
-	// custom javascript tracer
+	// custom javascript tracer or the name of a native tracer
 	Tracer string `json:"tracer"`
+	// config for the native tracer, e.g. {"onlyTopCall": true} or {"diffMode": true}
+	TracerConfig json2.RawMessage `json:"tracerConfig,omitempty"`
 	// disable stack capture
 	DisableStack bool `json:"disableStack"`
 	// disable storage capture
@@ -30,6 +35,13 @@ type TraceConfig struct {
 	DisableReturnData bool `json:"disableReturnData"`
 }
 
+// TraceCallConfig is the config for debug_traceCall, the state overrides
+// are applied before the call is traced.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverrides `json:"stateOverrides"`
+}
+
 func GetTracerResult(tracer tracers.Tracer, result *core.ExecutionResult) ([]byte, error) {
 	var (
 		res []byte
@@ -128,7 +140,7 @@ func defaultTracerConfig() *TraceConfig {
 }
 func TestTracerConfig(traceConfig *TraceConfig) error {
 	if traceConfig.Tracer != "" {
-		_, err := tracers.New(traceConfig.Tracer, &tracers.Context{}, nil)
+		_, err := tracers.New(traceConfig.Tracer, &tracers.Context{}, traceConfig.TracerConfig)
 		if err != nil {
 			return err
 		}
@@ -159,11 +171,11 @@ func newTracer(ctx sdk.Context, txHash *common.Hash) (tracer tracers.Tracer) {
 			}
 			return logger.NewStructLogger(&logConfig)
 		}
-		// Json-based tracer
+		// Native or json-based tracer
 		tCtx := &tracers.Context{
 			TxHash: *txHash,
 		}
-		tracer, err = tracers.New(traceConfig.Tracer, tCtx, nil)
+		tracer, err = tracers.New(traceConfig.Tracer, tCtx, traceConfig.TracerConfig)
 		if err != nil {
 			return NewNoOpTracer()
 		}
@@ -10,6 +10,15 @@ import (
 	"github.com/okx/okbchain/app/utils"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// transaction types, keep sync with go-ethereum
+const (
+	LegacyTxType     = ethtypes.LegacyTxType
+	AccessListTxType = ethtypes.AccessListTxType
+	DynamicFeeTxType = ethtypes.DynamicFeeTxType
 )
 
 // TxData implements the Ethereum transaction data structure. It is used
@@ -29,6 +38,14 @@ type TxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// typed transaction (EIP-2718) values, they are never rlp encoded with the legacy fields.
+	// Price of a dynamic fee tx is set to GasFeeCap, as there is no base fee.
+	Type      uint8               `json:"type" rlp:"-"`
+	ChainID   *big.Int            `json:"chainId" rlp:"-"`
+	GasTipCap *big.Int            `json:"maxPriorityFeePerGas" rlp:"-"`
+	GasFeeCap *big.Int            `json:"maxFeePerGas" rlp:"-"`
+	Accesses  ethtypes.AccessList `json:"accessList" rlp:"-"`
 }
 
 // encodableTxData implements the Ethereum transaction data structure. It is used
@@ -48,6 +65,13 @@ type encodableTxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// typed transaction values, the access list is rlp encoded
+	Type      uint64 `json:"type"`
+	ChainID   string `json:"chainId"`
+	GasTipCap string `json:"maxPriorityFeePerGas"`
+	GasFeeCap string `json:"maxFeePerGas"`
+	Accesses  []byte `json:"accessList"`
 }
 
 func (tx *encodableTxData) UnmarshalFromAmino(_ *amino.Codec, data []byte) error {
@@ -120,6 +144,22 @@ func (tx *encodableTxData) UnmarshalFromAmino(_ *amino.Codec, data []byte) error
 			}
 			tx.Hash = new(ethcmn.Hash)
 			copy(tx.Hash[:], subData)
+		case 11:
+			var n int
+			tx.Type, n, err = amino.DecodeUvarint(data)
+			if err != nil {
+				return err
+			}
+			dataLen = uint64(n)
+		case 12:
+			tx.ChainID = string(subData)
+		case 13:
+			tx.GasTipCap = string(subData)
+		case 14:
+			tx.GasFeeCap = string(subData)
+		case 15:
+			tx.Accesses = make([]byte, dataLen)
+			copy(tx.Accesses, subData)
 		default:
 			return fmt.Errorf("unexpect feild num %d", pos)
 		}
@@ -128,6 +168,10 @@ func (tx *encodableTxData) UnmarshalFromAmino(_ *amino.Codec, data []byte) error
 }
 
 func (td TxData) String() string {
+	if td.Type != LegacyTxType {
+		return fmt.Sprintf("type=%d chainID=%s nonce=%d tip=%s feeCap=%s price=%s gasLimit=%d recipient=%v amount=%s data=0x%x accessList=%d v=%s r=%s s=%s",
+			td.Type, td.ChainID, td.AccountNonce, td.GasTipCap, td.GasFeeCap, td.Price, td.GasLimit, td.Recipient, td.Amount, td.Payload, len(td.Accesses), td.V, td.R, td.S)
+	}
 	if td.Recipient != nil {
 		return fmt.Sprintf("nonce=%d price=%s gasLimit=%d recipient=%s amount=%s data=0x%x v=%s r=%s s=%s",
 			td.AccountNonce, td.Price, td.GasLimit, td.Recipient.Hex(), td.Amount, td.Payload, td.V, td.R, td.S)
@@ -176,6 +220,9 @@ func (td TxData) MarshalAmino() ([]byte, error) {
 		S:            s,
 		Hash:         td.Hash,
 	}
+	if err := td.marshalTypedFields(&e); err != nil {
+		return nil, err
+	}
 
 	return ModuleCdc.MarshalBinaryBare(e)
 }
@@ -249,7 +296,7 @@ func (td *TxData) UnmarshalAmino(data []byte) error {
 		td.S = s
 	}
 
-	return nil
+	return td.unmarshalTypedFields(&e)
 }
 
 func (td *TxData) unmarshalFromAmino(cdc *amino.Codec, data []byte) error {
@@ -319,6 +366,65 @@ func (td *TxData) unmarshalFromAmino(cdc *amino.Codec, data []byte) error {
 		td.S = s
 	}
 
+	return td.unmarshalTypedFields(&e)
+}
+
+// marshalTypedFields sets the typed transaction values to e, they are left empty for
+// legacy transactions to keep the encoding unchanged.
+func (td TxData) marshalTypedFields(e *encodableTxData) (err error) {
+	if td.Type == LegacyTxType {
+		return nil
+	}
+	e.Type = uint64(td.Type)
+	if td.ChainID != nil {
+		if e.ChainID, err = utils.MarshalBigInt(td.ChainID); err != nil {
+			return err
+		}
+	}
+	if td.GasTipCap != nil {
+		if e.GasTipCap, err = utils.MarshalBigInt(td.GasTipCap); err != nil {
+			return err
+		}
+	}
+	if td.GasFeeCap != nil {
+		if e.GasFeeCap, err = utils.MarshalBigInt(td.GasFeeCap); err != nil {
+			return err
+		}
+	}
+	if len(td.Accesses) != 0 {
+		if e.Accesses, err = rlp.EncodeToBytes(td.Accesses); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (td *TxData) unmarshalTypedFields(e *encodableTxData) (err error) {
+	if e.Type > DynamicFeeTxType {
+		return fmt.Errorf("unsupported tx type %d", e.Type)
+	}
+	td.Type = uint8(e.Type)
+	td.ChainID, td.GasTipCap, td.GasFeeCap, td.Accesses = nil, nil, nil, nil
+	if e.ChainID != "" {
+		if td.ChainID, err = utils.UnmarshalBigInt(e.ChainID); err != nil {
+			return err
+		}
+	}
+	if e.GasTipCap != "" {
+		if td.GasTipCap, err = utils.UnmarshalBigInt(e.GasTipCap); err != nil {
+			return err
+		}
+	}
+	if e.GasFeeCap != "" {
+		if td.GasFeeCap, err = utils.UnmarshalBigInt(e.GasFeeCap); err != nil {
+			return err
+		}
+	}
+	if len(e.Accesses) != 0 {
+		if err = rlp.DecodeBytes(e.Accesses, &td.Accesses); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -12,9 +12,12 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
 	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
 	"github.com/okx/okbchain/libs/tendermint/global"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -164,6 +167,36 @@ func TestTxDecoder(t *testing.T) {
 	}
 }
 
+func TestTxDecoderTypedTx(t *testing.T) {
+	to := ethcmn.BytesToAddress([]byte("test_address"))
+	msg := NewMsgEthereumTxAccessList(big.NewInt(3), 0, &to, big.NewInt(1), 100000, big.NewInt(1), nil, nil)
+	priv, _ := ethsecp256k1.GenerateKey()
+	require.NoError(t, msg.Sign(big.NewInt(3), priv.ToECDSA()))
+	txBytes, err := authtypes.EthereumTxEncode(msg)
+	require.NoError(t, err)
+
+	cdc := codec.New()
+	cdc.RegisterInterface((*sdk.Tx)(nil), nil)
+	RegisterCodec(cdc)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+
+	for _, c := range []struct {
+		venus8Height int64
+		curHeight    int64
+		expectPass   bool
+	}{
+		{0, 1000, false},
+		{1000, 999, false},
+		{1000, 1000, false},
+		{1000, 1001, true},
+		{1000, IGNORE_HEIGHT_CHECKING, true},
+	} {
+		tmtypes.UnittestOnlySetMilestoneVenus8Height(c.venus8Height)
+		_, err = TxDecoder(cdc)(txBytes, c.curHeight)
+		require.Equal(t, c.expectPass, err == nil, c)
+	}
+}
+
 func TestEthLogAmino(t *testing.T) {
 	tests := []ethtypes.Log{
 		{},
@@ -0,0 +1,139 @@
+package watcher
+
+import (
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	"github.com/okx/okbchain/x/common/monitor"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "watcher"
+
+	// MetricsCacheLabel distinguishes the caches of the watcher
+	MetricsCacheLabel = "cache"
+	codeCacheLabel    = "code"
+)
+
+var (
+	watcherMetrics     *Metrics
+	initWatcherMetrics sync.Once
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Height of the latest block written to the watch db.
+	Height metrics.Gauge
+	// Height of the latest block committed by consensus.
+	CommittedHeight metrics.Gauge
+	// Number of blocks the watch db lags behind consensus.
+	LagBlocks metrics.Gauge
+	// Time spent writing the data of a block to the watch db, in seconds.
+	CommitLatency metrics.Histogram
+	// Number of messages written to the watch db per block.
+	CommitBatchSize metrics.Histogram
+	// Number of commit jobs waiting to be written to the watch db.
+	PendingJobs metrics.Gauge
+	// Number of cache hits, labeled by cache.
+	CacheHits metrics.Counter
+	// Number of cache misses, labeled by cache.
+	CacheMisses metrics.Counter
+	// Number of entries in a cache, labeled by cache.
+	CacheSize metrics.Gauge
+}
+
+// GetMetrics returns the Metrics of the watcher, built using the Prometheus
+// client library if the watcher is enabled. Otherwise, it returns no-op
+// Metrics.
+func GetMetrics() *Metrics {
+	initWatcherMetrics.Do(func() {
+		if IsWatcherEnabled() {
+			watcherMetrics = PrometheusMetrics(monitor.XNameSpace)
+		} else {
+			watcherMetrics = NopMetrics()
+		}
+	})
+	return watcherMetrics
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		Height: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "height",
+			Help:      "Height of the latest block written to the watch db.",
+		}, nil),
+		CommittedHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "committed_height",
+			Help:      "Height of the latest block committed by consensus.",
+		}, nil),
+		LagBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lag_blocks",
+			Help:      "Number of blocks the watch db lags behind consensus.",
+		}, nil),
+		CommitLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_latency_seconds",
+			Help:      "Time spent writing the data of a block to the watch db.",
+			Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.2, 0.5, 1, 3, 5},
+		}, nil),
+		CommitBatchSize: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_batch_size",
+			Help:      "Number of messages written to the watch db per block.",
+			Buckets:   stdprometheus.ExponentialBuckets(1, 4, 10),
+		}, nil),
+		PendingJobs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pending_jobs",
+			Help:      "Number of commit jobs waiting to be written to the watch db.",
+		}, nil),
+		CacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of cache hits of the watcher.",
+		}, []string{MetricsCacheLabel}),
+		CacheMisses: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_misses",
+			Help:      "Number of cache misses of the watcher.",
+		}, []string{MetricsCacheLabel}),
+		CacheSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_size",
+			Help:      "Number of entries in a cache of the watcher.",
+		}, []string{MetricsCacheLabel}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Height:          discard.NewGauge(),
+		CommittedHeight: discard.NewGauge(),
+		LagBlocks:       discard.NewGauge(),
+		CommitLatency:   discard.NewHistogram(),
+		CommitBatchSize: discard.NewHistogram(),
+		PendingJobs:     discard.NewGauge(),
+		CacheHits:       discard.NewCounter(),
+		CacheMisses:     discard.NewCounter(),
+		CacheSize:       discard.NewGauge(),
+	}
+}
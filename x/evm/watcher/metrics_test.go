@@ -0,0 +1,32 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+type testGauge struct{ value float64 }
+
+func (g *testGauge) With(...string) metrics.Gauge { return g }
+func (g *testGauge) Set(value float64)            { g.value = value }
+func (g *testGauge) Add(delta float64)            { g.value += delta }
+
+func TestSetWrittenHeight(t *testing.T) {
+	height, lag := &testGauge{}, &testGauge{}
+	metrics := NopMetrics()
+	metrics.Height, metrics.LagBlocks = height, lag
+	w := &Watcher{metrics: metrics}
+
+	// the watch db is two blocks behind consensus
+	w.committedHeight = 12
+	w.setWrittenHeight(10)
+	require.Equal(t, float64(10), height.value)
+	require.Equal(t, float64(2), lag.value)
+
+	// the watch db caught up
+	w.setWrittenHeight(12)
+	require.Equal(t, float64(12), height.value)
+	require.Equal(t, float64(0), lag.value)
+}
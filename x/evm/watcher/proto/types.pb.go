@@ -37,6 +37,11 @@ type Transaction struct {
 	V                string `protobuf:"bytes,12,opt,name=V,proto3" json:"V,omitempty"`
 	R                string `protobuf:"bytes,13,opt,name=R,proto3" json:"R,omitempty"`
 	S                string `protobuf:"bytes,14,opt,name=S,proto3" json:"S,omitempty"`
+	Type             uint64 `protobuf:"varint,15,opt,name=Type,proto3" json:"Type,omitempty"`
+	ChainID          string `protobuf:"bytes,16,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	AccessList       []byte `protobuf:"bytes,17,opt,name=AccessList,proto3" json:"AccessList,omitempty"`
+	GasFeeCap        string `protobuf:"bytes,18,opt,name=GasFeeCap,proto3" json:"GasFeeCap,omitempty"`
+	GasTipCap        string `protobuf:"bytes,19,opt,name=GasTipCap,proto3" json:"GasTipCap,omitempty"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -170,6 +175,41 @@ func (m *Transaction) GetS() string {
 	return ""
 }
 
+func (m *Transaction) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Transaction) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Transaction) GetAccessList() []byte {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *Transaction) GetGasFeeCap() string {
+	if m != nil {
+		return m.GasFeeCap
+	}
+	return ""
+}
+
+func (m *Transaction) GetGasTipCap() string {
+	if m != nil {
+		return m.GasTipCap
+	}
+	return ""
+}
+
 type Log struct {
 	Address     []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	Topics      [][]byte `protobuf:"bytes,2,rep,name=Topics,proto3" json:"Topics,omitempty"`
@@ -291,6 +331,7 @@ type TransactionReceipt struct {
 	TransactionIndex  uint64 `protobuf:"varint,10,opt,name=TransactionIndex,proto3" json:"TransactionIndex,omitempty"`
 	From              string `protobuf:"bytes,11,opt,name=From,proto3" json:"From,omitempty"`
 	To                []byte `protobuf:"bytes,12,opt,name=To,proto3" json:"To,omitempty"`
+	Type              uint64 `protobuf:"varint,13,opt,name=Type,proto3" json:"Type,omitempty"`
 }
 
 func (m *TransactionReceipt) Reset()         { *m = TransactionReceipt{} }
@@ -410,6 +451,13 @@ func (m *TransactionReceipt) GetTo() []byte {
 	return nil
 }
 
+func (m *TransactionReceipt) GetType() uint64 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Transaction)(nil), "x.evm.watcher.proto.Transaction")
 	proto.RegisterType((*Log)(nil), "x.evm.watcher.proto.Log")
@@ -419,43 +467,47 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x8e, 0xd3, 0x3e,
-	0x10, 0xc7, 0x37, 0x4d, 0xfa, 0xcf, 0xed, 0x6f, 0x7f, 0x8b, 0x41, 0xc8, 0x42, 0x28, 0x8a, 0x7a,
-	0x8a, 0x60, 0x95, 0x48, 0xf0, 0x04, 0x74, 0x81, 0xb2, 0x52, 0xb5, 0x42, 0x6e, 0xe9, 0x81, 0x9b,
-	0x9b, 0x5a, 0x6d, 0xb4, 0x4d, 0x5c, 0xc5, 0x4e, 0x09, 0x6f, 0xc1, 0x43, 0xf0, 0x30, 0x48, 0x5c,
-	0xf6, 0xc8, 0x11, 0xb5, 0x67, 0xde, 0x01, 0x79, 0x9c, 0xb4, 0xdd, 0x16, 0x0e, 0x9c, 0x9a, 0xef,
-	0xb7, 0x9e, 0xf1, 0xcc, 0xc7, 0x33, 0xa8, 0xa3, 0x3e, 0xaf, 0xb8, 0x0c, 0x56, 0x99, 0x50, 0x02,
-	0x3f, 0x2c, 0x02, 0xbe, 0x4e, 0x82, 0x4f, 0x4c, 0x45, 0x0b, 0x9e, 0x19, 0xb3, 0xf7, 0xbd, 0x86,
-	0x3a, 0xe3, 0x8c, 0xa5, 0x92, 0x45, 0x2a, 0x16, 0x29, 0x7e, 0x8a, 0xda, 0xfd, 0xa5, 0x88, 0x6e,
-	0xdf, 0x31, 0xb9, 0x20, 0x96, 0x67, 0xf9, 0x5d, 0xba, 0x37, 0xb0, 0x87, 0x3a, 0x20, 0x6e, 0xf2,
-	0x64, 0xca, 0x33, 0x52, 0xf3, 0x2c, 0xbf, 0x4d, 0x0f, 0x2d, 0x8c, 0x91, 0xf3, 0x36, 0x13, 0x09,
-	0xb1, 0x21, 0x14, 0xbe, 0xf1, 0x05, 0xb2, 0x07, 0x4c, 0x12, 0xc7, 0xb3, 0x7c, 0x87, 0xea, 0x4f,
-	0xfc, 0x04, 0xb5, 0x06, 0x4c, 0xbe, 0xcf, 0xe2, 0x88, 0x93, 0x3a, 0x24, 0xd9, 0x69, 0x9d, 0x01,
-	0x2e, 0x6f, 0x98, 0x0c, 0x70, 0xef, 0x23, 0x54, 0xbf, 0x4e, 0x57, 0xb9, 0x22, 0x4d, 0x30, 0x8d,
-	0xd0, 0xee, 0x8d, 0x48, 0x23, 0x4e, 0x5a, 0x90, 0xd9, 0x08, 0x7c, 0x8e, 0x6a, 0x63, 0x41, 0xda,
-	0x70, 0xb0, 0x36, 0x16, 0xf8, 0x19, 0xba, 0x38, 0x68, 0xf0, 0x3a, 0x9d, 0xf1, 0x82, 0x20, 0x08,
-	0x38, 0xf1, 0x75, 0xc6, 0x09, 0x5b, 0xe6, 0x9c, 0x74, 0xa0, 0x28, 0x23, 0x70, 0x17, 0x59, 0x13,
-	0xd2, 0x05, 0xc7, 0x9a, 0x68, 0x45, 0xc9, 0x7f, 0x46, 0x51, 0xad, 0x46, 0xe4, 0xdc, 0xa8, 0x51,
-	0xef, 0x97, 0x85, 0xec, 0xa1, 0x98, 0x63, 0x82, 0x9a, 0xaf, 0x66, 0xb3, 0x8c, 0x4b, 0x59, 0x32,
-	0xac, 0x24, 0x7e, 0x8c, 0x1a, 0x63, 0xb1, 0x8a, 0x23, 0x49, 0x6a, 0x9e, 0xed, 0x77, 0x69, 0xa9,
-	0x74, 0xd7, 0xaf, 0x99, 0x62, 0x15, 0x37, 0xfd, 0x7d, 0x4c, 0xdb, 0xf0, 0xbb, 0x47, 0x5b, 0x67,
-	0x2b, 0x80, 0x56, 0x1d, 0xe2, 0x4a, 0xa5, 0xef, 0x1f, 0x17, 0xa6, 0xd5, 0x06, 0x44, 0x55, 0xf2,
-	0xfe, 0xfb, 0x36, 0x8f, 0xdf, 0x17, 0x38, 0xeb, 0xa8, 0x92, 0xa8, 0x89, 0x21, 0xa8, 0x49, 0x79,
-	0x22, 0xd6, 0x7c, 0x06, 0x58, 0x5b, 0xb4, 0x92, 0xbd, 0xaf, 0x36, 0xc2, 0x07, 0x10, 0x29, 0x8f,
-	0x78, 0xbc, 0x52, 0xba, 0xac, 0x91, 0x62, 0x2a, 0x37, 0xdd, 0x3b, 0xb4, 0x54, 0xf8, 0x12, 0x3d,
-	0xb8, 0xca, 0x93, 0x7c, 0xc9, 0x54, 0xbc, 0xe6, 0x03, 0x26, 0x3f, 0x48, 0x3e, 0x83, 0x21, 0x72,
-	0xe8, 0xe9, 0x1f, 0xba, 0xd4, 0xa1, 0x98, 0xcb, 0xfe, 0x52, 0xec, 0xe6, 0x69, 0x6f, 0xe0, 0x4b,
-	0xe4, 0x68, 0x41, 0x1c, 0xcf, 0xf6, 0x3b, 0x2f, 0x48, 0xf0, 0x87, 0xe1, 0x0e, 0x86, 0x62, 0x4e,
-	0xe1, 0x14, 0xf6, 0xd1, 0xff, 0x07, 0x75, 0xee, 0x88, 0xb5, 0xe9, 0xb1, 0xad, 0x4f, 0x5e, 0x89,
-	0x54, 0x65, 0x2c, 0x52, 0xd5, 0x13, 0x9a, 0x49, 0x3c, 0xb6, 0x35, 0x96, 0xaa, 0x87, 0xa6, 0x81,
-	0x7c, 0x50, 0xf9, 0x1e, 0x72, 0x0b, 0xee, 0xf9, 0xfb, 0x12, 0xb5, 0x4f, 0x9f, 0xf5, 0x5f, 0x46,
-	0xb6, 0x5a, 0x38, 0x33, 0xb1, 0x66, 0xe1, 0xcc, 0x0a, 0x74, 0xab, 0x15, 0xe8, 0xbf, 0xf9, 0xb6,
-	0x71, 0xad, 0xbb, 0x8d, 0x6b, 0xfd, 0xdc, 0xb8, 0xd6, 0x97, 0xad, 0x7b, 0x76, 0xb7, 0x75, 0xcf,
-	0x7e, 0x6c, 0xdd, 0xb3, 0x8f, 0xcf, 0xe7, 0xb1, 0x5a, 0xe4, 0xd3, 0x20, 0x12, 0x49, 0x28, 0x6e,
-	0x79, 0x11, 0xf2, 0x22, 0x5a, 0xb0, 0x38, 0x0d, 0x8b, 0x90, 0xaf, 0x93, 0xb0, 0xc4, 0x19, 0x02,
-	0xce, 0x69, 0x03, 0x7e, 0x5e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa1, 0xa3, 0xb0, 0x56,
-	0x04, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6e, 0xdb, 0x3c,
+	0x10, 0x8d, 0x2c, 0xc5, 0x3f, 0xb4, 0xf3, 0xc7, 0x7c, 0xf8, 0x40, 0x14, 0x85, 0x60, 0x64, 0x65,
+	0xb4, 0x81, 0x05, 0xb4, 0x27, 0x48, 0x9c, 0xc6, 0x0d, 0x60, 0x04, 0x05, 0xa3, 0x66, 0xd1, 0x1d,
+	0x43, 0x13, 0xb6, 0x10, 0x4b, 0x14, 0x44, 0xca, 0x55, 0x6e, 0xd1, 0xe3, 0xf4, 0x08, 0x5d, 0x66,
+	0x53, 0xa0, 0xcb, 0x22, 0x59, 0xf7, 0x0e, 0x05, 0x87, 0x92, 0xad, 0x38, 0xed, 0xa2, 0x2b, 0xcd,
+	0x7b, 0xe2, 0x0c, 0x87, 0xf3, 0xe6, 0xa1, 0xae, 0xbe, 0x4b, 0x85, 0x1a, 0xa6, 0x99, 0xd4, 0x12,
+	0x1f, 0x16, 0x43, 0xb1, 0x8c, 0x87, 0x9f, 0x99, 0xe6, 0x73, 0x91, 0x59, 0xf2, 0xe8, 0xbb, 0x8b,
+	0xba, 0x61, 0xc6, 0x12, 0xc5, 0xb8, 0x8e, 0x64, 0x82, 0x5f, 0xa2, 0xce, 0xe9, 0x42, 0xf2, 0xdb,
+	0xf7, 0x4c, 0xcd, 0x89, 0xd3, 0x77, 0x06, 0x3d, 0xba, 0x26, 0x70, 0x1f, 0x75, 0x01, 0x5c, 0xe6,
+	0xf1, 0x8d, 0xc8, 0x48, 0xa3, 0xef, 0x0c, 0x3a, 0xb4, 0x4e, 0x61, 0x8c, 0xbc, 0xf3, 0x4c, 0xc6,
+	0xc4, 0x85, 0x54, 0x88, 0xf1, 0x3e, 0x72, 0xc7, 0x4c, 0x11, 0xaf, 0xef, 0x0c, 0x3c, 0x6a, 0x42,
+	0xfc, 0x02, 0xb5, 0xc7, 0x4c, 0x7d, 0xc8, 0x22, 0x2e, 0xc8, 0x36, 0x14, 0x59, 0x61, 0x53, 0x01,
+	0x2e, 0x6f, 0xda, 0x0a, 0x70, 0xef, 0x7f, 0x68, 0xfb, 0x22, 0x49, 0x73, 0x4d, 0x5a, 0x40, 0x5a,
+	0x60, 0xd8, 0x4b, 0x99, 0x70, 0x41, 0xda, 0x50, 0xd9, 0x02, 0xbc, 0x8b, 0x1a, 0xa1, 0x24, 0x1d,
+	0x38, 0xd8, 0x08, 0x25, 0x7e, 0x85, 0xf6, 0x6b, 0x0f, 0xbc, 0x48, 0xa6, 0xa2, 0x20, 0x08, 0x12,
+	0x9e, 0xf1, 0xa6, 0xe2, 0x35, 0x5b, 0xe4, 0x82, 0x74, 0xa1, 0x29, 0x0b, 0x70, 0x0f, 0x39, 0xd7,
+	0xa4, 0x07, 0x8c, 0x73, 0x6d, 0x10, 0x25, 0x3b, 0x16, 0x51, 0x83, 0xae, 0xc8, 0xae, 0x45, 0x57,
+	0xa6, 0xf7, 0xf0, 0x2e, 0x15, 0x64, 0x0f, 0xea, 0x43, 0x8c, 0x09, 0x6a, 0x8d, 0xe6, 0x2c, 0x4a,
+	0x2e, 0xce, 0xc8, 0x3e, 0x9c, 0xab, 0x20, 0xf6, 0x11, 0x3a, 0xe1, 0x5c, 0x28, 0x35, 0x89, 0x94,
+	0x26, 0x07, 0xd0, 0x71, 0x8d, 0x31, 0x5a, 0x8c, 0x99, 0x3a, 0x17, 0x62, 0xc4, 0x52, 0x82, 0x21,
+	0x77, 0x4d, 0x94, 0x7f, 0xc3, 0x28, 0x35, 0x7f, 0x0f, 0x57, 0x7f, 0x2d, 0x71, 0xf4, 0xcb, 0x41,
+	0xee, 0x44, 0xce, 0xcc, 0xed, 0x27, 0xd3, 0x69, 0x26, 0x94, 0x2a, 0xd5, 0xac, 0x20, 0xfe, 0x1f,
+	0x35, 0x43, 0x99, 0x46, 0x5c, 0x91, 0x46, 0xdf, 0x1d, 0xf4, 0x68, 0x89, 0xcc, 0x1b, 0xce, 0x98,
+	0x66, 0x95, 0x82, 0x26, 0xde, 0xd4, 0xdd, 0x2a, 0xf9, 0x44, 0x77, 0x53, 0xad, 0x00, 0xdd, 0xb6,
+	0x21, 0xaf, 0x44, 0xe6, 0xfe, 0xb0, 0xb0, 0x43, 0x6f, 0x42, 0x56, 0x05, 0x9f, 0x6e, 0x5a, 0x6b,
+	0x73, 0xd3, 0x40, 0x71, 0x93, 0x55, 0x6a, 0x6b, 0x73, 0x08, 0x6a, 0x51, 0x11, 0xcb, 0xa5, 0x98,
+	0x82, 0xc0, 0x6d, 0x5a, 0xc1, 0xa3, 0xaf, 0x2e, 0xc2, 0x35, 0x39, 0xa9, 0xe0, 0x22, 0x4a, 0xb5,
+	0x69, 0xeb, 0x4a, 0x33, 0x9d, 0xdb, 0xd7, 0x7b, 0xb4, 0x44, 0xf8, 0x18, 0x1d, 0x8c, 0xf2, 0x38,
+	0x5f, 0x30, 0x1d, 0x2d, 0xc5, 0x98, 0xa9, 0x8f, 0x4a, 0x4c, 0x61, 0x9d, 0x3d, 0xfa, 0xfc, 0x87,
+	0x69, 0x75, 0x22, 0x67, 0xea, 0x74, 0x21, 0x57, 0x9b, 0xbd, 0x26, 0xf0, 0x31, 0xf2, 0x0c, 0x20,
+	0x5e, 0xdf, 0x1d, 0x74, 0xdf, 0x90, 0xe1, 0x1f, 0x6c, 0x36, 0x9c, 0xc8, 0x19, 0x85, 0x53, 0x78,
+	0x80, 0xf6, 0x6a, 0x7d, 0xae, 0x26, 0xd6, 0xa1, 0x9b, 0xb4, 0x39, 0x39, 0x92, 0x89, 0xce, 0x18,
+	0xd7, 0x95, 0x84, 0xd6, 0x13, 0x9b, 0xb4, 0x19, 0x4b, 0xf5, 0x86, 0x96, 0x1d, 0x72, 0xad, 0xf3,
+	0xf5, 0x90, 0xdb, 0x76, 0x49, 0xfe, 0x6a, 0xe7, 0xce, 0x73, 0x59, 0xff, 0xc5, 0x3c, 0x95, 0xf5,
+	0xad, 0x77, 0x20, 0x2e, 0xcd, 0xd8, 0x5b, 0x99, 0xb1, 0x32, 0xc8, 0xce, 0xda, 0x20, 0xa7, 0xef,
+	0xbe, 0x3d, 0xf8, 0xce, 0xfd, 0x83, 0xef, 0xfc, 0x7c, 0xf0, 0x9d, 0x2f, 0x8f, 0xfe, 0xd6, 0xfd,
+	0xa3, 0xbf, 0xf5, 0xe3, 0xd1, 0xdf, 0xfa, 0xf4, 0x7a, 0x16, 0xe9, 0x79, 0x7e, 0x33, 0xe4, 0x32,
+	0x0e, 0xe4, 0xad, 0x28, 0x02, 0x51, 0x70, 0xe3, 0x9d, 0xa0, 0x08, 0xc4, 0x32, 0x0e, 0xca, 0x11,
+	0x07, 0x30, 0xe2, 0x9b, 0x26, 0x7c, 0xde, 0xfe, 0x1e, 0x00, 0x59, 0xce, 0x68, 0x69, 0xf4, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Transaction) Marshal() (dAtA []byte, err error) {
@@ -478,6 +530,47 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasTipCap) > 0 {
+		i -= len(m.GasTipCap)
+		copy(dAtA[i:], m.GasTipCap)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GasTipCap)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.GasFeeCap) > 0 {
+		i -= len(m.GasFeeCap)
+		copy(dAtA[i:], m.GasFeeCap)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GasFeeCap)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.AccessList) > 0 {
+		i -= len(m.AccessList)
+		copy(dAtA[i:], m.AccessList)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AccessList)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.S) > 0 {
 		i -= len(m.S)
 		copy(dAtA[i:], m.S)
@@ -678,6 +771,11 @@ func (m *TransactionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.To) > 0 {
 		i -= len(m.To)
 		copy(dAtA[i:], m.To)
@@ -832,6 +930,25 @@ func (m *Transaction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AccessList)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GasFeeCap)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GasTipCap)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -929,6 +1046,9 @@ func (m *TransactionReceipt) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
 	return n
 }
 
@@ -1386,6 +1506,155 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			}
 			m.S = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccessList == nil {
+				m.AccessList = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasFeeCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasTipCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2058,6 +2327,25 @@ func (m *TransactionReceipt) Unmarshal(dAtA []byte) error {
 				m.To = []byte{}
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
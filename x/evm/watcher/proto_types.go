@@ -1,9 +1,12 @@
 package watcher
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	prototypes "github.com/okx/okbchain/x/evm/watcher/proto"
 )
 
@@ -12,7 +15,7 @@ func transactionToProto(tr *Transaction) *prototypes.Transaction {
 	if tr.To != nil {
 		to = tr.To.Bytes()
 	}
-	return &prototypes.Transaction{
+	protoTx := &prototypes.Transaction{
 		BlockHash:        tr.BlockHash.Bytes(),
 		BlockNumber:      tr.BlockNumber.String(),
 		From:             tr.From.Bytes(),
@@ -27,10 +30,24 @@ func transactionToProto(tr *Transaction) *prototypes.Transaction {
 		V:                tr.V.String(),
 		R:                tr.R.String(),
 		S:                tr.S.String(),
+		Type:             uint64(tr.Type),
+	}
+	if tr.ChainID != nil {
+		protoTx.ChainID = tr.ChainID.String()
+	}
+	if tr.Accesses != nil {
+		protoTx.AccessList, _ = rlp.EncodeToBytes(tr.Accesses)
 	}
+	if tr.GasFeeCap != nil {
+		protoTx.GasFeeCap = tr.GasFeeCap.String()
+	}
+	if tr.GasTipCap != nil {
+		protoTx.GasTipCap = tr.GasTipCap.String()
+	}
+	return protoTx
 }
 
-func protoToTransaction(tr *prototypes.Transaction) *Transaction {
+func protoToTransaction(tr *prototypes.Transaction) (*Transaction, error) {
 	blockHash := common.BytesToHash(tr.BlockHash)
 	blockNum := hexutil.MustDecodeBig(tr.BlockNumber)
 	gasPrice := hexutil.MustDecodeBig(tr.GasPrice)
@@ -44,7 +61,7 @@ func protoToTransaction(tr *prototypes.Transaction) *Transaction {
 	v := hexutil.MustDecodeBig(tr.V)
 	r := hexutil.MustDecodeBig(tr.R)
 	s := hexutil.MustDecodeBig(tr.S)
-	return &Transaction{
+	rpcTx := &Transaction{
 		BlockHash:        &blockHash,
 		BlockNumber:      (*hexutil.Big)(blockNum),
 		From:             common.BytesToAddress(tr.From),
@@ -59,7 +76,28 @@ func protoToTransaction(tr *prototypes.Transaction) *Transaction {
 		V:                (*hexutil.Big)(v),
 		R:                (*hexutil.Big)(r),
 		S:                (*hexutil.Big)(s),
+		Type:             hexutil.Uint64(tr.Type),
+	}
+	if tr.Type == ethtypes.LegacyTxType {
+		return rpcTx, nil
+	}
+	if len(tr.ChainID) > 0 {
+		rpcTx.ChainID = (*hexutil.Big)(hexutil.MustDecodeBig(tr.ChainID))
+	}
+	accesses := ethtypes.AccessList{}
+	if len(tr.AccessList) > 0 {
+		if err := rlp.DecodeBytes(tr.AccessList, &accesses); err != nil {
+			return nil, fmt.Errorf("failed to decode the access list of tx %s: %w", rpcTx.Hash, err)
+		}
+	}
+	rpcTx.Accesses = &accesses
+	if len(tr.GasFeeCap) > 0 {
+		rpcTx.GasFeeCap = (*hexutil.Big)(hexutil.MustDecodeBig(tr.GasFeeCap))
+	}
+	if len(tr.GasTipCap) > 0 {
+		rpcTx.GasTipCap = (*hexutil.Big)(hexutil.MustDecodeBig(tr.GasTipCap))
 	}
+	return rpcTx, nil
 }
 
 func receiptToProto(tr *TransactionReceipt) *prototypes.TransactionReceipt {
@@ -102,6 +140,7 @@ func receiptToProto(tr *TransactionReceipt) *prototypes.TransactionReceipt {
 		TransactionIndex:  uint64(tr.TransactionIndex),
 		From:              tr.From,
 		To:                to,
+		Type:              uint64(tr.Type),
 	}
 }
 
@@ -147,5 +186,6 @@ func protoToReceipt(tr *prototypes.TransactionReceipt) *TransactionReceipt {
 		TransactionIndex:  hexutil.Uint64(tr.TransactionIndex),
 		From:              tr.From,
 		To:                to,
+		Type:              hexutil.Uint64(tr.Type),
 	}
 }
@@ -0,0 +1,78 @@
+package watcher
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/evm/types"
+	prototypes "github.com/okx/okbchain/x/evm/watcher/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedTransactionProto(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	to := common.BytesToAddress([]byte("test_address"))
+	accesses := ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{common.BigToHash(big.NewInt(1))}}}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgEthereumTx
+		fields []string
+	}{
+		{"legacy tx", types.NewMsgEthereumTx(0, &to, big.NewInt(1), 100000, big.NewInt(1), nil), nil},
+		{"access list tx", types.NewMsgEthereumTxAccessList(chainID, 1, &to, big.NewInt(1), 100000, big.NewInt(1), nil, accesses),
+			[]string{"chainId", "accessList"}},
+		{"dynamic fee tx", types.NewMsgEthereumTxDynamicFee(chainID, 2, &to, big.NewInt(1), 100000, big.NewInt(1), big.NewInt(2), nil, nil),
+			[]string{"chainId", "accessList", "maxFeePerGas", "maxPriorityFeePerGas"}},
+	}
+	typedFields := []string{"chainId", "accessList", "maxFeePerGas", "maxPriorityFeePerGas"}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.msg.Sign(chainID, priv.ToECDSA()))
+			raw, err := tc.msg.MarshalBinary()
+			require.NoError(t, err)
+			txHash := common.BytesToHash(tmtypes.Tx(raw).Hash())
+
+			tr := newTransaction(tc.msg, txHash, common.BigToHash(big.NewInt(1)), 1, 0)
+			buf := tr.GetValue()
+			require.NotEmpty(t, buf)
+
+			var protoTx prototypes.Transaction
+			require.NoError(t, proto.Unmarshal([]byte(buf), &protoTx))
+			rpcTx, err := protoToTransaction(&protoTx)
+			require.NoError(t, err)
+			require.Equal(t, uint64(tc.msg.Data.Type), uint64(rpcTx.Type))
+
+			bz, err := json.Marshal(rpcTx)
+			require.NoError(t, err)
+			var fields map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(bz, &fields))
+			require.Contains(t, fields, "type")
+			expected := make(map[string]bool)
+			for _, f := range tc.fields {
+				expected[f] = true
+			}
+			for _, f := range typedFields {
+				_, ok := fields[f]
+				require.Equal(t, expected[f], ok, f)
+			}
+			if tc.msg.Data.Type != types.LegacyTxType {
+				require.Equal(t, tc.msg.Data.ChainID, rpcTx.ChainID.ToInt())
+				require.Equal(t, len(tc.msg.Data.Accesses), len(*rpcTx.Accesses))
+			}
+
+			// a broken access list fails the typed tx
+			protoTx.AccessList = []byte{0xff}
+			_, err = protoToTransaction(&protoTx)
+			require.Equal(t, tc.msg.Data.Type != types.LegacyTxType, err != nil)
+		})
+	}
+}
@@ -49,9 +49,10 @@ func putHashPrefixKey(key []byte) {
 }
 
 type Querier struct {
-	store *WatchStore
-	sw    bool
-	lru   *lru.Cache
+	store   *WatchStore
+	sw      bool
+	lru     *lru.Cache
+	metrics *Metrics
 }
 
 func (q Querier) enabled() bool {
@@ -67,7 +68,7 @@ func NewQuerier() *Querier {
 	if e != nil {
 		panic(errors.New("Failed to init LRU Cause " + e.Error()))
 	}
-	return &Querier{store: InstanceOfWatchStore(), sw: IsWatcherEnabled(), lru: lru}
+	return &Querier{store: InstanceOfWatchStore(), sw: IsWatcherEnabled(), lru: lru, metrics: GetMetrics()}
 }
 
 func (q Querier) GetTransactionReceipt(hash common.Hash) (*TransactionReceipt, error) {
@@ -230,9 +231,11 @@ func (q Querier) GetCodeByHash(codeHash []byte) ([]byte, error) {
 	if ok {
 		data, ok := cacheCode.([]byte)
 		if ok {
+			q.metrics.CacheHits.With(MetricsCacheLabel, codeCacheLabel).Add(1)
 			return data, nil
 		}
 	}
+	q.metrics.CacheMisses.With(MetricsCacheLabel, codeCacheLabel).Add(1)
 	code, e := q.store.Get(append(prefixCodeHash, codeHash...))
 	if e != nil {
 		return nil, e
@@ -241,6 +244,7 @@ func (q Querier) GetCodeByHash(codeHash []byte) ([]byte, error) {
 		return nil, errNotFound
 	}
 	q.lru.Add(common.BytesToHash(codeHash), code)
+	q.metrics.CacheSize.With(MetricsCacheLabel, codeCacheLabel).Set(float64(q.lru.Len()))
 	return code, nil
 }
 
@@ -285,8 +289,7 @@ func (q Querier) GetTransactionByHash(hash common.Hash) (*Transaction, error) {
 	if err != nil {
 		return nil, err
 	}
-	tx := protoToTransaction(&protoTx)
-	return tx, nil
+	return protoToTransaction(&protoTx)
 }
 
 func (q Querier) GetTransactionByBlockNumberAndIndex(number uint64, idx uint) (*Transaction, error) {
@@ -484,6 +484,7 @@ type TransactionReceipt struct {
 	tx                    *types.MsgEthereumTx
 	From                  string          `json:"from"`
 	To                    *common.Address `json:"to"`
+	Type                  hexutil.Uint64  `json:"type"`
 }
 
 func (tr *TransactionReceipt) GetValue() string {
@@ -538,6 +539,7 @@ func newTransactionReceipt(status uint32, tx *types.MsgEthereumTx, txHash, block
 		originBlockHash:       blockHash,
 		BlockNumber:           hexutil.Uint64(height),
 		TransactionIndex:      hexutil.Uint64(txIndex),
+		Type:                  hexutil.Uint64(tx.Data.Type),
 		tx:                    tx,
 	}
 }
@@ -569,20 +571,26 @@ func (m MsgBlock) GetType() uint32 {
 
 // Transaction represents a transaction returned to RPC clients.
 type Transaction struct {
-	BlockHash         *common.Hash    `json:"blockHash"`
-	BlockNumber       *hexutil.Big    `json:"blockNumber"`
-	From              common.Address  `json:"from"`
-	Gas               hexutil.Uint64  `json:"gas"`
-	GasPrice          *hexutil.Big    `json:"gasPrice"`
-	Hash              common.Hash     `json:"hash"`
-	Input             hexutil.Bytes   `json:"input"`
-	Nonce             hexutil.Uint64  `json:"nonce"`
-	To                *common.Address `json:"to"`
-	TransactionIndex  *hexutil.Uint64 `json:"transactionIndex"`
-	Value             *hexutil.Big    `json:"value"`
-	V                 *hexutil.Big    `json:"v"`
-	R                 *hexutil.Big    `json:"r"`
-	S                 *hexutil.Big    `json:"s"`
+	BlockHash        *common.Hash    `json:"blockHash"`
+	BlockNumber      *hexutil.Big    `json:"blockNumber"`
+	From             common.Address  `json:"from"`
+	Gas              hexutil.Uint64  `json:"gas"`
+	GasPrice         *hexutil.Big    `json:"gasPrice"`
+	Hash             common.Hash     `json:"hash"`
+	Input            hexutil.Bytes   `json:"input"`
+	Nonce            hexutil.Uint64  `json:"nonce"`
+	To               *common.Address `json:"to"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+	Value            *hexutil.Big    `json:"value"`
+	V                *hexutil.Big    `json:"v"`
+	R                *hexutil.Big    `json:"r"`
+	S                *hexutil.Big    `json:"s"`
+	Type             hexutil.Uint64  `json:"type"`
+	// values of the EIP-2718 typed txs
+	ChainID           *hexutil.Big         `json:"chainId,omitempty"`
+	Accesses          *ethtypes.AccessList `json:"accessList,omitempty"`
+	GasFeeCap         *hexutil.Big         `json:"maxFeePerGas,omitempty"`
+	GasTipCap         *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
 	tx                *types.MsgEthereumTx
 	originBlockHash   *common.Hash
 	originBlockNumber uint64
@@ -606,6 +614,7 @@ func (tr *Transaction) GetValue() string {
 	tr.V = (*hexutil.Big)(tr.tx.Data.V)
 	tr.R = (*hexutil.Big)(tr.tx.Data.R)
 	tr.S = (*hexutil.Big)(tr.tx.Data.S)
+	tr.SetTypedTxFields(tr.tx)
 
 	if *tr.originBlockHash != (common.Hash{}) {
 		tr.BlockHash = tr.originBlockHash
@@ -621,6 +630,24 @@ func (tr *Transaction) GetValue() string {
 	return string(buf)
 }
 
+// SetTypedTxFields sets the type and the EIP-2718 typed tx values of tx to the rpc transaction.
+func (tr *Transaction) SetTypedTxFields(tx *types.MsgEthereumTx) {
+	tr.Type = hexutil.Uint64(tx.Data.Type)
+	if tx.Data.Type == types.LegacyTxType {
+		return
+	}
+	tr.ChainID = (*hexutil.Big)(tx.Data.ChainID)
+	accesses := tx.Data.Accesses
+	if accesses == nil {
+		accesses = ethtypes.AccessList{}
+	}
+	tr.Accesses = &accesses
+	if tx.Data.Type == types.DynamicFeeTxType {
+		tr.GasFeeCap = (*hexutil.Big)(tx.Data.GasFeeCap)
+		tr.GasTipCap = (*hexutil.Big)(tx.Data.GasTipCap)
+	}
+}
+
 func NewBlock(height uint64, blockBloom ethtypes.Bloom, header abci.Header, gasLimit uint64,
 	gasUsed *big.Int, txs interface{}) (types.Block, common.Hash) {
 	timestamp := header.Time.Unix()
@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	jsoniter "github.com/json-iterator/go"
@@ -49,6 +51,9 @@ type Watcher struct {
 	filterMap     map[string]struct{}
 	InfuraKeeper  InfuraKeeper
 	delAccountMtx sync.Mutex
+	metrics       *Metrics
+	// height of the latest block committed by consensus, read by jobRoutine
+	committedHeight uint64
 }
 
 var (
@@ -94,6 +99,7 @@ func NewWatcher(logger log.Logger) *Watcher {
 		checkWd:        viper.GetBool(FlagCheckWd),
 		filterMap:      make(map[string]struct{}),
 		eraseKeyFilter: make(map[string][]byte),
+		metrics:        GetMetrics(),
 	}
 }
 
@@ -348,15 +354,33 @@ func (w *Watcher) Commit() {
 	}
 	//hold it in temp
 	batch := w.batch
+	height := w.height
+	atomic.StoreUint64(&w.committedHeight, height)
+	w.metrics.CommittedHeight.Set(float64(height))
 	// No need to write db when upload delta is enabled.
 	if tmtypes.UploadDelta {
 		return
 	}
 	w.dispatchJob(func() {
+		start := time.Now()
 		w.commitBatch(batch)
+		w.metrics.CommitLatency.Observe(time.Since(start).Seconds())
+		w.metrics.CommitBatchSize.Observe(float64(len(batch)))
+		w.setWrittenHeight(height)
 	})
 }
 
+// setWrittenHeight records that the data of the block at height has been
+// written to the watch db, and how far it is behind consensus.
+func (w *Watcher) setWrittenHeight(height uint64) {
+	w.metrics.Height.Set(float64(height))
+	lag := uint64(0)
+	if committed := atomic.LoadUint64(&w.committedHeight); committed > height {
+		lag = committed - height
+	}
+	w.metrics.LagBlocks.Set(float64(lag))
+}
+
 func (w *Watcher) CommitWatchData(data WatchData) {
 	if data.Size() == 0 {
 		return
@@ -627,6 +651,7 @@ func (w *Watcher) jobRoutine() {
 	w.lazyInitialization()
 	for job := range w.jobChan {
 		job()
+		w.metrics.PendingJobs.Set(float64(len(w.jobChan)))
 	}
 	w.jobDone.Done()
 }
@@ -652,6 +677,7 @@ func (w *Watcher) dispatchJob(f func()) {
 	// why: something wrong happened: such as db panic(disk maybe is full)(it should be the only reason)
 	//								  ApplyWatchData were executed every 4 seoncds(block schedual)
 	w.jobChan <- f
+	w.metrics.PendingJobs.Set(float64(len(w.jobChan)))
 }
 
 func (w *Watcher) Height() uint64 {
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryAllowance(queryRoute, cdc),
+		GetCmdQueryAllowances(queryRoute, cdc),
+		GetCmdQueryAllowancesByGranter(queryRoute, cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryAllowance implements a command to return the fee allowance
+// granted by a granter to a grantee
+func GetCmdQueryAllowance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [granter] [grantee]",
+		Short: "Query the fee allowance granted by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee allowance granted by a granter to a grantee.
+
+Example:
+$ %s query %s allowance ex1... ex1...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid granter address %w", err)
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAllowanceParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAllowance), bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.Grant
+			cdc.MustUnmarshalJSON(res, &grant)
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryAllowances implements a command to return the fee allowances
+// granted to a grantee
+func GetCmdQueryAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances [grantee]",
+		Short: "Query the fee allowances granted to a grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			params := types.NewQueryAccountAllowancesParams(grantee)
+			return queryGrants(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAllowances), params)
+		},
+	}
+}
+
+// GetCmdQueryAllowancesByGranter implements a command to return the fee
+// allowances granted by a granter
+func GetCmdQueryAllowancesByGranter(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances-by-granter [granter]",
+		Short: "Query the fee allowances granted by a granter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid granter address %w", err)
+			}
+
+			params := types.NewQueryAccountAllowancesParams(granter)
+			return queryGrants(cliCtx, cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAllowancesByGranter), params)
+		},
+	}
+}
+
+func queryGrants(cliCtx context.CLIContext, cdc *codec.Codec, route string, params interface{}) error {
+	data, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	bz, _, err := cliCtx.QueryWithData(route, data)
+	if err != nil {
+		return err
+	}
+
+	var grants []types.Grant
+	cdc.MustUnmarshalJSON(bz, &grants)
+	return cliCtx.PrintOutput(grants)
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/flags"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+const (
+	flagSpendLimit      = "spend-limit"
+	flagExpiration      = "expiration"
+	flagPeriod          = "period"
+	flagPeriodLimit     = "period-limit"
+	flagAllowedMessages = "allowed-messages"
+)
+
+// GetTxCmd returns a root CLI command handler for certain modules/feegrant
+// transaction commands.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "feegrant subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.PostCommands(
+		GetCmdGrantAllowance(cdc),
+		GetCmdRevokeAllowance(cdc),
+	)...)
+	return cmd
+}
+
+// GetCmdGrantAllowance returns a CLI command handler for granting a fee
+// allowance to a grantee
+func GetCmdGrantAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant a fee allowance to the grantee to pay its tx fees from your account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant a fee allowance to the grantee to pay its tx fees from your account.
+The fees are paid from the allowance when the grantee signs a tx with --%s set to your address.
+The allowance is limited to --%s in total, and to --%s in every --%s if a period is given.
+It can be restricted to the msg types given by --%s, as "<route>/<type>".
+
+Example:
+$ %s tx %s grant ex1... --%s 10%s --%s 2025-01-01T00:00:00Z --from mykey
+$ %s tx %s grant ex1... --%s 1h --%s 1%s --%s token/send,distribution/withdraw_delegator_reward --from mykey
+`,
+				flags.FlagFeeAccount, flagSpendLimit, flagPeriodLimit, flagPeriod, flagAllowedMessages,
+				version.ClientName, types.ModuleName, flagSpendLimit, sdk.DefaultBondDenom, flagExpiration,
+				version.ClientName, types.ModuleName, flagPeriod, flagPeriodLimit, sdk.DefaultBondDenom, flagAllowedMessages,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			spendLimit, err := sdk.ParseDecCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return fmt.Errorf("invalid spend limit %w", err)
+			}
+			var expiration time.Time
+			if expirationStr := viper.GetString(flagExpiration); expirationStr != "" {
+				if expiration, err = time.Parse(time.RFC3339, expirationStr); err != nil {
+					return fmt.Errorf("invalid expiration %w", err)
+				}
+			}
+			basic := types.NewBasicAllowance(spendLimit, expiration)
+
+			var allowance types.FeeAllowanceI = basic
+			if period := viper.GetDuration(flagPeriod); period != 0 {
+				periodLimit, err := sdk.ParseDecCoins(viper.GetString(flagPeriodLimit))
+				if err != nil {
+					return fmt.Errorf("invalid period limit %w", err)
+				}
+				allowance = types.NewPeriodicAllowance(*basic, period, periodLimit, time.Now().UTC())
+			}
+
+			if allowedMessages := viper.GetStringSlice(flagAllowedMessages); len(allowedMessages) > 0 {
+				allowance = types.NewAllowedMsgAllowance(allowance, allowedMessages)
+			}
+
+			msg := types.NewMsgGrantAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "The total fees the grantee may spend. Unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "The expiration time of the allowance, in RFC3339 format. Never expires if empty")
+	cmd.Flags().Duration(flagPeriod, 0, "The period after which the period limit is reset, e.g. 24h")
+	cmd.Flags().String(flagPeriodLimit, "", "The fees the grantee may spend in every period")
+	cmd.Flags().StringSlice(flagAllowedMessages, []string{}, "The msg types the allowance is restricted to, as <route>/<type>")
+	return cmd
+}
+
+// GetCmdRevokeAllowance returns a CLI command handler for revoking the fee
+// allowance of a grantee
+func GetCmdRevokeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance granted to the grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted to the grantee.
+
+Example:
+$ %s tx %s revoke ex1... --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address %w", err)
+			}
+
+			msg := types.NewMsgRevokeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
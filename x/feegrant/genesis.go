@@ -0,0 +1,26 @@
+package feegrant
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+
+	"github.com/okx/okbchain/x/feegrant/keeper"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, grant := range data.Allowances {
+		// expired allowances are dropped
+		if expiration := grant.Allowance.ExpiresAt(); !expiration.IsZero() && !expiration.After(ctx.BlockTime()) {
+			continue
+		}
+		if err := k.GrantAllowance(ctx, grant.Granter, grant.Grantee, grant.Allowance); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetGrants(ctx, types.FeeAllowanceKey, nil))
+}
@@ -0,0 +1,57 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/feegrant/keeper"
+	"github.com/okx/okbchain/x/feegrant/types"
+	"github.com/okx/okbchain/x/feegrant/typesadapter"
+)
+
+// NewHandler defines the feegrant module handler instance. It handles both the
+// amino msgs and their protobuf counterparts.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+			errMsg := fmt.Sprintf("feegrant is not supported at height %d", ctx.BlockHeight())
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgGrantAllowance:
+			return handleMsgGrantAllowance(ctx, msg, k)
+		case types.MsgRevokeAllowance:
+			return handleMsgRevokeAllowance(ctx, msg, k)
+		case *typesadapter.MsgGrantAllowance:
+			res, err := msgServer.GrantAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *typesadapter.MsgRevokeAllowance:
+			res, err := msgServer.RevokeAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+// handleMsgGrantAllowance grants a fee allowance to the grantee
+func handleMsgGrantAllowance(ctx sdk.Context, msg types.MsgGrantAllowance, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.GrantAllowance(ctx, msg.Granter, msg.Grantee, msg.Allowance); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRevokeAllowance revokes the fee allowance of the grantee
+func handleMsgRevokeAllowance(ctx sdk.Context, msg types.MsgRevokeAllowance, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.RevokeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okx/okbchain/libs/tendermint/libs/log"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+var _ authtypes.FeegrantKeeper = Keeper{}
+
+// Keeper of the feegrant module stores the fee allowances granted by granters
+// to grantees, and uses them when a tx fee is paid by a fee granter.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *codec.Codec
+	ak       types.AccountKeeper
+}
+
+// NewKeeper creates new instances of the feegrant Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		ak:       ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantAllowance grants grantee an allowance to pay its tx fees from granter's
+// account. The grantee account is created if it doesn't exist yet, so that it
+// can sign txs without holding any coin.
+func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowance types.FeeAllowanceI) error {
+	if _, found := k.GetGrant(ctx, granter, grantee); found {
+		return sdkerrors.Wrapf(types.ErrFeeAllowanceExists, "granter %s, grantee %s", granter, grantee)
+	}
+	if expiration := allowance.ExpiresAt(); !expiration.IsZero() && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrFeeLimitExpired, "expiration %s, block time %s", expiration, ctx.BlockTime())
+	}
+
+	if k.ak.GetAccount(ctx, grantee) == nil {
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, grantee))
+	}
+
+	k.setGrant(ctx, types.NewGrant(granter, grantee, allowance))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFeeGrant,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+	))
+	return nil
+}
+
+// RevokeAllowance revokes the fee allowance granted to grantee by granter
+func (k Keeper) RevokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	if _, found := k.GetGrant(ctx, granter, grantee); !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetFeeAllowanceKey(granter, grantee))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeFeeGrant,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+	))
+	return nil
+}
+
+// GetGrant returns the fee allowance granted to grantee by granter, expired
+// or not.
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.Grant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, grant types.Grant) {
+	ctx.KVStore(k.storeKey).Set(types.GetFeeAllowanceKey(grant.Granter, grant.Grantee), k.cdc.MustMarshalBinaryBare(grant))
+}
+
+// IterateGrants iterates over the fee allowances with the given key prefix,
+// until cb returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, prefix []byte, cb func(grant types.Grant) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetGrants returns the fee allowances with the given key prefix, filtered by
+// filter if it is not nil.
+func (k Keeper) GetGrants(ctx sdk.Context, prefix []byte, filter func(grant types.Grant) bool) []types.Grant {
+	grants := []types.Grant{}
+	k.IterateGrants(ctx, prefix, func(grant types.Grant) bool {
+		if filter == nil || filter(grant) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+	return grants
+}
+
+// UseGrantedFees lets granter pay fee for a tx of grantee carrying msgs, if
+// granter has granted grantee an allowance accepting it. The allowance is
+// updated, or deleted once used up or expired. It implements the
+// FeegrantKeeper expected by the auth ante handler.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "fee grants are not supported at height %d", ctx.BlockHeight())
+	}
+
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", granter, grantee)
+	}
+
+	remove, err := grant.Allowance.Accept(ctx, fee, msgs)
+	if remove {
+		ctx.KVStore(k.storeKey).Delete(types.GetFeeAllowanceKey(granter, grantee))
+	}
+	if err != nil {
+		return err
+	}
+	if !remove {
+		k.setGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUseFeeGrant,
+		sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+	))
+	return nil
+}
+
+// RefundGrantedFees credits back to the allowance granted to grantee by granter
+// the part of the fee paid by granter which has been refunded after the
+// execution of the tx. An allowance removed since it was used up, or expired,
+// is not restored.
+func (k Keeper) RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) {
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	if !found {
+		return
+	}
+	grant.Allowance.Refund(refund)
+	k.setGrant(ctx, grant)
+}
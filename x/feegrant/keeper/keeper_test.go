@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/okx/okbchain/app"
+	"github.com/okx/okbchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/feegrant/keeper"
+	"github.com/okx/okbchain/x/feegrant/types"
+	tokentypes "github.com/okx/okbchain/x/token/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	granter = sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+	grantee = sdk.AccAddress(ethsecp256k1.GenerateAddress().Bytes())
+)
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.OKBChainApp
+
+	querier sdk.Querier
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	checkTx := false
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+
+	suite.app = app.Setup(checkTx)
+	suite.ctx = suite.app.NewContext(checkTx, abci.Header{
+		Height:  2,
+		ChainID: "ethermint-3",
+		Time:    time.Now().UTC(),
+	})
+	suite.querier = keeper.NewQuerier(suite.app.FeegrantKeeper)
+}
+
+func okb(amount int64) sdk.SysCoins {
+	return sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(amount))
+}
+
+func sendMsgs() []sdk.Msg {
+	return []sdk.Msg{tokentypes.NewMsgTokenSend(grantee, granter, okb(1))}
+}
+
+func (suite *KeeperTestSuite) TestGrantAndRevokeAllowance() {
+	k := suite.app.FeegrantKeeper
+
+	past := suite.ctx.BlockTime().Add(-time.Hour)
+	err := k.GrantAllowance(suite.ctx, granter, grantee, types.NewBasicAllowance(okb(10), past))
+	suite.Require().True(types.ErrFeeLimitExpired.Is(err))
+
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(suite.ctx, grantee))
+	err = k.GrantAllowance(suite.ctx, granter, grantee, types.NewBasicAllowance(okb(10), time.Time{}))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(suite.ctx, grantee))
+
+	grant, found := k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().True(found)
+	suite.Require().Equal(okb(10), grant.Allowance.(*types.BasicAllowance).SpendLimit)
+
+	err = k.GrantAllowance(suite.ctx, granter, grantee, types.NewBasicAllowance(okb(5), time.Time{}))
+	suite.Require().True(types.ErrFeeAllowanceExists.Is(err))
+
+	suite.Require().NoError(k.RevokeAllowance(suite.ctx, granter, grantee))
+	_, found = k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().False(found)
+
+	err = k.RevokeAllowance(suite.ctx, granter, grantee)
+	suite.Require().True(types.ErrNoAllowance.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesBasicAllowance() {
+	k := suite.app.FeegrantKeeper
+
+	err := k.UseGrantedFees(suite.ctx, granter, grantee, okb(1), sendMsgs())
+	suite.Require().True(types.ErrNoAllowance.Is(err))
+
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	err = k.GrantAllowance(suite.ctx, granter, grantee, types.NewBasicAllowance(okb(10), expiration))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, okb(4), sendMsgs()))
+	grant, found := k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().True(found)
+	suite.Require().Equal(okb(6), grant.Allowance.(*types.BasicAllowance).SpendLimit)
+
+	// exceeding the remaining spend limit is rejected
+	err = k.UseGrantedFees(suite.ctx, granter, grantee, okb(7), sendMsgs())
+	suite.Require().True(types.ErrFeeLimitExceeded.Is(err))
+
+	// an expired allowance is rejected and removed
+	ctx := suite.ctx.WithBlockTime(expiration)
+	ctx, _ = ctx.CacheContext()
+	err = k.UseGrantedFees(ctx, granter, grantee, okb(1), sendMsgs())
+	suite.Require().True(types.ErrFeeLimitExpired.Is(err))
+	_, found = k.GetGrant(ctx, granter, grantee)
+	suite.Require().False(found)
+
+	// using up the whole spend limit removes the allowance
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, okb(6), sendMsgs()))
+	_, found = k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesPeriodicAllowance() {
+	k := suite.app.FeegrantKeeper
+
+	start := suite.ctx.BlockTime()
+	allowance := types.NewPeriodicAllowance(*types.NewBasicAllowance(okb(10), time.Time{}), time.Hour, okb(3), start)
+	suite.Require().NoError(k.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, okb(2), sendMsgs()))
+	err := k.UseGrantedFees(suite.ctx, granter, grantee, okb(2), sendMsgs())
+	suite.Require().True(types.ErrFeeLimitExceeded.Is(err))
+
+	// the period limit is reset once the period is over
+	ctx := suite.ctx.WithBlockTime(start.Add(time.Hour))
+	suite.Require().NoError(k.UseGrantedFees(ctx, granter, grantee, okb(3), sendMsgs()))
+
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	suite.Require().True(found)
+	periodic := grant.Allowance.(*types.PeriodicAllowance)
+	suite.Require().Equal(okb(5), periodic.Basic.SpendLimit)
+	suite.Require().True(periodic.PeriodCanSpend.IsZero())
+	suite.Require().True(start.Add(2 * time.Hour).Equal(periodic.PeriodReset))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesPeriodicAllowanceResetPerDenom() {
+	k := suite.app.FeegrantKeeper
+
+	start := suite.ctx.BlockTime()
+	spendLimit := okb(10).Add(sdk.NewDecCoinFromDec("foo", sdk.NewDec(1)))
+	periodSpendLimit := okb(3).Add(sdk.NewDecCoinFromDec("foo", sdk.NewDec(3)))
+	allowance := types.NewPeriodicAllowance(*types.NewBasicAllowance(spendLimit, time.Time{}), time.Hour, periodSpendLimit, start)
+	suite.Require().NoError(k.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	// the new period can spend at most what is left of each denom
+	ctx := suite.ctx.WithBlockTime(start.Add(time.Hour))
+	suite.Require().NoError(k.UseGrantedFees(ctx, granter, grantee, okb(1), sendMsgs()))
+
+	grant, found := k.GetGrant(ctx, granter, grantee)
+	suite.Require().True(found)
+	periodic := grant.Allowance.(*types.PeriodicAllowance)
+	suite.Require().Equal(okb(2).Add(sdk.NewDecCoinFromDec("foo", sdk.NewDec(1))), periodic.PeriodCanSpend)
+}
+
+func (suite *KeeperTestSuite) TestRefundGrantedFees() {
+	k := suite.app.FeegrantKeeper
+
+	start := suite.ctx.BlockTime()
+	allowance := types.NewPeriodicAllowance(*types.NewBasicAllowance(okb(10), time.Time{}), time.Hour, okb(3), start)
+	suite.Require().NoError(k.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, okb(3), sendMsgs()))
+	k.RefundGrantedFees(suite.ctx, granter, grantee, okb(2))
+
+	grant, found := k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().True(found)
+	periodic := grant.Allowance.(*types.PeriodicAllowance)
+	suite.Require().Equal(okb(9), periodic.Basic.SpendLimit)
+	suite.Require().Equal(okb(2), periodic.PeriodCanSpend)
+
+	// what can be spent in the period never exceeds the period spend limit
+	k.RefundGrantedFees(suite.ctx, granter, grantee, okb(2))
+	grant, _ = k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().Equal(okb(3), grant.Allowance.(*types.PeriodicAllowance).PeriodCanSpend)
+
+	// a removed allowance is not restored
+	suite.Require().NoError(k.RevokeAllowance(suite.ctx, granter, grantee))
+	k.RefundGrantedFees(suite.ctx, granter, grantee, okb(2))
+	_, found = k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesBeforeVenus8() {
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	k := suite.app.FeegrantKeeper
+	suite.Require().NoError(k.GrantAllowance(suite.ctx, granter, grantee, types.NewBasicAllowance(okb(10), time.Time{})))
+
+	// the feegrant store is not committed before venus8
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	err := k.UseGrantedFees(suite.ctx, granter, grantee, okb(1), sendMsgs())
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesAllowedMsgAllowance() {
+	k := suite.app.FeegrantKeeper
+
+	sendType := types.MsgType(sendMsgs()[0])
+	allowance := types.NewAllowedMsgAllowance(types.NewBasicAllowance(okb(10), time.Time{}), []string{sendType})
+	suite.Require().NoError(k.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, okb(1), sendMsgs()))
+
+	revoke := types.NewMsgRevokeAllowance(grantee, granter)
+	err := k.UseGrantedFees(suite.ctx, granter, grantee, okb(1), []sdk.Msg{revoke})
+	suite.Require().True(types.ErrMessageNotAllowed.Is(err))
+
+	grant, found := k.GetGrant(suite.ctx, granter, grantee)
+	suite.Require().True(found)
+	basic := grant.Allowance.(*types.AllowedMsgAllowance).Allowance.(*types.BasicAllowance)
+	suite.Require().Equal(okb(9), basic.SpendLimit)
+}
+
+func (suite *KeeperTestSuite) TestQuerier() {
+	k := suite.app.FeegrantKeeper
+	err := k.GrantAllowance(suite.ctx, granter, grantee, types.NewBasicAllowance(okb(10), time.Time{}))
+	suite.Require().NoError(err)
+
+	cdc := suite.app.Codec()
+	req := abci.RequestQuery{
+		Data: cdc.MustMarshalJSON(types.NewQueryAllowanceParams(granter, grantee)),
+	}
+	res, err := suite.querier(suite.ctx, []string{types.QueryAllowance}, req)
+	suite.Require().NoError(err)
+
+	var grant types.Grant
+	suite.Require().NoError(cdc.UnmarshalJSON(res, &grant))
+	suite.Require().Equal(granter, grant.Granter)
+	suite.Require().Equal(grantee, grant.Grantee)
+
+	var grants []types.Grant
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountAllowancesParams(grantee))
+	res, err = suite.querier(suite.ctx, []string{types.QueryAllowances}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(cdc.UnmarshalJSON(res, &grants))
+	suite.Require().Len(grants, 1)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountAllowancesParams(granter))
+	res, err = suite.querier(suite.ctx, []string{types.QueryAllowancesByGranter}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(cdc.UnmarshalJSON(res, &grants))
+	suite.Require().Len(grants, 1)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAllowanceParams(grantee, granter))
+	_, err = suite.querier(suite.ctx, []string{types.QueryAllowance}, req)
+	suite.Require().True(types.ErrNoAllowance.Is(err))
+
+	_, err = suite.querier(suite.ctx, []string{"unknown"}, req)
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+}
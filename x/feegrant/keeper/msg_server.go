@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/feegrant/typesadapter"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the feegrant MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) typesadapter.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ typesadapter.MsgServer = msgServer{}
+
+// GrantAllowance implements the MsgServer.GrantAllowance method.
+func (k msgServer) GrantAllowance(goCtx context.Context, msg *typesadapter.MsgGrantAllowance) (*typesadapter.MsgGrantAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	aminoMsg, err := msg.ToMsgGrantAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.GrantAllowance(ctx, aminoMsg.Granter, aminoMsg.Grantee, aminoMsg.Allowance); err != nil {
+		return nil, err
+	}
+	return &typesadapter.MsgGrantAllowanceResponse{}, nil
+}
+
+// RevokeAllowance implements the MsgServer.RevokeAllowance method.
+func (k msgServer) RevokeAllowance(goCtx context.Context, msg *typesadapter.MsgRevokeAllowance) (*typesadapter.MsgRevokeAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	aminoMsg, err := msg.ToMsgRevokeAllowance()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.RevokeAllowance(ctx, aminoMsg.Granter, aminoMsg.Grantee); err != nil {
+		return nil, err
+	}
+	return &typesadapter.MsgRevokeAllowanceResponse{}, nil
+}
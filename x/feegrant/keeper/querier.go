@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+// NewQuerier creates a querier for feegrant REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		if len(path) < 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"Insufficient parameters, at least 1 parameter is required")
+		}
+
+		switch path[0] {
+		case types.QueryAllowance:
+			return queryAllowance(ctx, req, keeper)
+		case types.QueryAllowances:
+			return queryAllowances(ctx, req, keeper)
+		case types.QueryAllowancesByGranter:
+			return queryAllowancesByGranter(ctx, req, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
+		}
+	}
+}
+
+// queryAllowance returns the fee allowance granted by a granter to a grantee
+func queryAllowance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAllowanceParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Granter.Empty() || params.Grantee.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter or grantee address")
+	}
+
+	grant, found := k.GetGrant(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoAllowance, "granter %s, grantee %s", params.Granter, params.Grantee)
+	}
+	return marshalJSON(k, grant)
+}
+
+// queryAllowances returns the fee allowances granted to a grantee
+func queryAllowances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccountAllowancesParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	return marshalJSON(k, k.GetGrants(ctx, types.GetFeeAllowancePrefixByGrantee(params.Address), nil))
+}
+
+// queryAllowancesByGranter returns the fee allowances granted by a granter.
+// The allowances are indexed by grantee, so all of them are iterated over.
+func queryAllowancesByGranter(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccountAllowancesParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+
+	grants := k.GetGrants(ctx, types.FeeAllowanceKey, func(grant types.Grant) bool {
+		return grant.Granter.Equals(params.Address)
+	})
+	return marshalJSON(k, grants)
+}
+
+func marshalJSON(k Keeper, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
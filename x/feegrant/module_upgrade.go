@@ -0,0 +1,90 @@
+package feegrant
+
+import (
+	store "github.com/okx/okbchain/libs/cosmos-sdk/store/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/upgrade"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+var (
+	_ upgrade.UpgradeModule = AppModule{}
+
+	defaultVersionFilter store.VersionFilter = func(h int64) func(cb func(name string, version int64)) {
+		if h < 0 {
+			return func(cb func(name string, version int64)) {}
+		}
+
+		return func(cb func(name string, version int64)) {
+			cb(types.StoreKey, tmtypes.GetVenus8Height())
+		}
+	}
+)
+
+// RegisterTask returns no upgrade task, the feegrant store starts empty.
+func (am AppModule) RegisterTask() upgrade.HeightTask {
+	return nil
+}
+
+func (am AppModule) CommitFilter() *store.StoreFilter {
+	var filter store.StoreFilter
+	// return false:
+	//    a. module name mismatch, no processing required
+	//    b. module names match and reach the upgrade height
+	// return true:
+	//    a. the upgrade height is 0, the module is disabled
+	//    b. not reach the upgrade height
+	filter = func(module string, h int64, s store.CommitKVStore) bool {
+		if module != types.StoreKey {
+			return false
+		}
+
+		if am.UpgradeHeight() == 0 {
+			return true
+		}
+
+		if h == tmtypes.GetVenus8Height() {
+			if s != nil {
+				s.SetUpgradeVersion(h)
+			}
+			return false
+		}
+
+		if tmtypes.HigherThanVenus8(h) {
+			return false
+		}
+
+		return true
+	}
+
+	return &filter
+}
+
+func (am AppModule) PruneFilter() *store.StoreFilter {
+	var filter store.StoreFilter
+	filter = func(module string, h int64, s store.CommitKVStore) bool {
+		if module != types.StoreKey {
+			return false
+		}
+
+		if am.UpgradeHeight() == 0 {
+			return true
+		}
+
+		if tmtypes.HigherThanVenus8(h) {
+			return false
+		}
+
+		return true
+	}
+	return &filter
+}
+
+func (am AppModule) VersionFilter() *store.VersionFilter {
+	return &defaultVersionFilter
+}
+
+// UpgradeHeight returns the venus8 height, the feegrant store is committed from then on.
+func (am AppModule) UpgradeHeight() int64 {
+	return tmtypes.GetVenus8Height()
+}
@@ -0,0 +1,287 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI = (*BasicAllowance)(nil)
+	_ FeeAllowanceI = (*PeriodicAllowance)(nil)
+	_ FeeAllowanceI = (*AllowedMsgAllowance)(nil)
+)
+
+// MsgType returns the type of msg that fee allowances may be restricted to, as
+// "<route>/<type>". The amino and the protobuf encodings of a msg share the
+// same route and type.
+func MsgType(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
+
+// FeeAllowanceI is the permission given by a granter to a grantee to pay the
+// fees of the grantee's txs from the granter's account
+type FeeAllowanceI interface {
+	// Accept determines whether the fee of a tx carrying msgs may be paid from
+	// the allowance, updating the allowance in place. If remove is true, the
+	// allowance is used up and must be deleted.
+	Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error)
+
+	// Refund credits back to the allowance the part of an accepted fee which
+	// has been refunded to the granter
+	Refund(refund sdk.Coins)
+
+	// ExpiresAt returns the expiration time of the allowance, or a zero time if
+	// it never expires
+	ExpiresAt() time.Time
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+}
+
+// BasicAllowance allows the grantee to spend up to SpendLimit coins in fees
+// until Expiration. An empty SpendLimit means no limit, and a zero Expiration
+// means the allowance never expires.
+type BasicAllowance struct {
+	SpendLimit sdk.SysCoins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time    `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicAllowance creates a new BasicAllowance instance
+func NewBasicAllowance(spendLimit sdk.SysCoins, expiration time.Time) *BasicAllowance {
+	return &BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept implements FeeAllowanceI. It lowers the spend limit by fee, and asks
+// for the allowance to be removed once the limit is used up or the allowance
+// is expired.
+func (a *BasicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bool, error) {
+	if a.isExpired(ctx.BlockTime()) {
+		return true, sdkerrors.Wrapf(ErrFeeLimitExpired, "expired at %s", a.Expiration)
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+	left, isNegative := a.SpendLimit.SafeSub(fee)
+	if isNegative {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "spend limit %s, fee %s", a.SpendLimit, fee)
+	}
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// Refund implements FeeAllowanceI
+func (a *BasicAllowance) Refund(refund sdk.Coins) {
+	if a.SpendLimit.Empty() {
+		return
+	}
+	a.SpendLimit = a.SpendLimit.Add(refund...)
+}
+
+// ExpiresAt implements FeeAllowanceI
+func (a *BasicAllowance) ExpiresAt() time.Time {
+	return a.Expiration
+}
+
+// ValidateBasic implements FeeAllowanceI
+func (a *BasicAllowance) ValidateBasic() error {
+	if !a.SpendLimit.Empty() && (!a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit %s", a.SpendLimit)
+	}
+	return nil
+}
+
+func (a *BasicAllowance) isExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+// PeriodicAllowance extends BasicAllowance with a limit on the fees spent in
+// every period. PeriodCanSpend is what is left to spend until PeriodReset, when
+// it is reset to PeriodSpendLimit, capped by what is left of the basic spend
+// limit.
+type PeriodicAllowance struct {
+	Basic            BasicAllowance `json:"basic" yaml:"basic"`
+	Period           time.Duration  `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.SysCoins   `json:"period_spend_limit" yaml:"period_spend_limit"`
+	PeriodCanSpend   sdk.SysCoins   `json:"period_can_spend" yaml:"period_can_spend"`
+	PeriodReset      time.Time      `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicAllowance creates a new PeriodicAllowance instance, whose first
+// period starts at start
+func NewPeriodicAllowance(basic BasicAllowance, period time.Duration, periodSpendLimit sdk.SysCoins, start time.Time) *PeriodicAllowance {
+	return &PeriodicAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      start.Add(period),
+	}
+}
+
+// Accept implements FeeAllowanceI. The fee is deducted from both the period
+// and the basic spend limits.
+func (a *PeriodicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bool, error) {
+	blockTime := ctx.BlockTime()
+	if a.Basic.isExpired(blockTime) {
+		return true, sdkerrors.Wrapf(ErrFeeLimitExpired, "expired at %s", a.Basic.Expiration)
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	var isNegative bool
+	a.PeriodCanSpend, isNegative = a.PeriodCanSpend.SafeSub(fee)
+	if isNegative {
+		return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "period limit")
+	}
+
+	if a.Basic.SpendLimit.Empty() {
+		return false, nil
+	}
+	a.Basic.SpendLimit, isNegative = a.Basic.SpendLimit.SafeSub(fee)
+	if isNegative {
+		return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "absolute limit")
+	}
+	return a.Basic.SpendLimit.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period if the current one is over at blockTime
+func (a *PeriodicAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	// what can be spent in the new period is capped, denom by denom, by the
+	// basic spend limit
+	if a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit.Intersect(a.Basic.SpendLimit)
+	}
+
+	// the period starts from the previous reset, unless a whole period has
+	// passed without any reset, in which case it starts now
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// Refund implements FeeAllowanceI. The refund is credited back to the basic
+// spend limit and to what can be spent in the period, which never exceeds the
+// period spend limit.
+func (a *PeriodicAllowance) Refund(refund sdk.Coins) {
+	a.Basic.Refund(refund)
+	a.PeriodCanSpend = a.PeriodCanSpend.Add(refund...).Intersect(a.PeriodSpendLimit)
+}
+
+// ExpiresAt implements FeeAllowanceI
+func (a *PeriodicAllowance) ExpiresAt() time.Time {
+	return a.Basic.ExpiresAt()
+}
+
+// ValidateBasic implements FeeAllowanceI
+func (a *PeriodicAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid period spend limit %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid period can spend %s", a.PeriodCanSpend)
+	}
+	if !a.Basic.SpendLimit.Empty() && !denomsSubsetOf(a.PeriodSpendLimit, a.Basic.SpendLimit) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit %s has denoms not in the spend limit %s", a.PeriodSpendLimit, a.Basic.SpendLimit)
+	}
+	if a.Period <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidDuration, "non-positive period %s", a.Period)
+	}
+	return nil
+}
+
+// AllowedMsgAllowance restricts Allowance to the txs whose msgs all have one of
+// the AllowedMessages types
+type AllowedMsgAllowance struct {
+	Allowance       FeeAllowanceI `json:"allowance" yaml:"allowance"`
+	AllowedMessages []string      `json:"allowed_messages" yaml:"allowed_messages"`
+}
+
+// NewAllowedMsgAllowance creates a new AllowedMsgAllowance instance
+func NewAllowedMsgAllowance(allowance FeeAllowanceI, allowedMessages []string) *AllowedMsgAllowance {
+	return &AllowedMsgAllowance{
+		Allowance:       allowance,
+		AllowedMessages: allowedMessages,
+	}
+}
+
+// Accept implements FeeAllowanceI
+func (a *AllowedMsgAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	for _, msg := range msgs {
+		if !a.isAllowed(MsgType(msg)) {
+			return false, sdkerrors.Wrapf(ErrMessageNotAllowed, "%s", MsgType(msg))
+		}
+	}
+	return a.Allowance.Accept(ctx, fee, msgs)
+}
+
+// Refund implements FeeAllowanceI
+func (a *AllowedMsgAllowance) Refund(refund sdk.Coins) {
+	a.Allowance.Refund(refund)
+}
+
+func (a *AllowedMsgAllowance) isAllowed(msgType string) bool {
+	for _, allowed := range a.AllowedMessages {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpiresAt implements FeeAllowanceI
+func (a *AllowedMsgAllowance) ExpiresAt() time.Time {
+	return a.Allowance.ExpiresAt()
+}
+
+// ValidateBasic implements FeeAllowanceI
+func (a *AllowedMsgAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedMessages) == 0 {
+		return ErrNoMessages
+	}
+	for _, msgType := range a.AllowedMessages {
+		if err := ValidateMsgType(msgType); err != nil {
+			return err
+		}
+	}
+	return a.Allowance.ValidateBasic()
+}
+
+// ValidateMsgType checks that msgType has the "<route>/<type>" form
+func ValidateMsgType(msgType string) error {
+	parts := strings.SplitN(msgType, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return sdkerrors.Wrapf(ErrMessageNotAllowed, "invalid msg type %q, expected <route>/<type>", msgType)
+	}
+	return nil
+}
+
+// denomsSubsetOf returns true if every denom of coins is a denom of coinsB
+func denomsSubsetOf(coins, coinsB sdk.SysCoins) bool {
+	for _, coin := range coins {
+		if coinsB.AmountOf(coin.Denom).IsZero() {
+			return false
+		}
+	}
+	return true
+}
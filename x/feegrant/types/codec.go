@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	"github.com/okx/okbchain/libs/system"
+)
+
+// ModuleCdc defines the feegrant module's codec
+var ModuleCdc = codec.New()
+
+const (
+	// Amino names
+	grantAllowanceName      = system.Chain + "/feegrant/MsgGrantAllowance"
+	revokeAllowanceName     = system.Chain + "/feegrant/MsgRevokeAllowance"
+	basicAllowanceName      = system.Chain + "/feegrant/BasicAllowance"
+	periodicAllowanceName   = system.Chain + "/feegrant/PeriodicAllowance"
+	allowedMsgAllowanceName = system.Chain + "/feegrant/AllowedMsgAllowance"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// feegrant module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgGrantAllowance{}, grantAllowanceName, nil)
+	cdc.RegisterConcrete(MsgRevokeAllowance{}, revokeAllowanceName, nil)
+
+	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
+	cdc.RegisterConcrete(&BasicAllowance{}, basicAllowanceName, nil)
+	cdc.RegisterConcrete(&PeriodicAllowance{}, periodicAllowanceName, nil)
+	cdc.RegisterConcrete(&AllowedMsgAllowance{}, allowedMsgAllowanceName, nil)
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+const DefaultCodespace string = ModuleName
+
+// errors
+var (
+	ErrFeeLimitExceeded   = sdkerrors.Register(DefaultCodespace, 2, "fee limit exceeded")
+	ErrFeeLimitExpired    = sdkerrors.Register(DefaultCodespace, 3, "fee allowance expired")
+	ErrInvalidDuration    = sdkerrors.Register(DefaultCodespace, 4, "invalid duration")
+	ErrNoAllowance        = sdkerrors.Register(DefaultCodespace, 5, "no allowance")
+	ErrNoMessages         = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
+	ErrMessageNotAllowed  = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	ErrFeeAllowanceExists = sdkerrors.Register(DefaultCodespace, 8, "fee allowance already exists")
+	ErrGranteeIsGranter   = sdkerrors.Register(DefaultCodespace, 9, "grantee and granter should be different")
+)
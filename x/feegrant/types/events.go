@@ -0,0 +1,11 @@
+package types
+
+// feegrant events
+const (
+	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeUseFeeGrant    = "use_feegrant"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+)
@@ -0,0 +1,31 @@
+package types
+
+// GenesisState defines the feegrant module's genesis state
+type GenesisState struct {
+	Allowances []Grant `json:"allowances" yaml:"allowances"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(allowances []Grant) GenesisState {
+	return GenesisState{
+		Allowances: allowances,
+	}
+}
+
+// DefaultGenesisState returns a genesis state without any fee allowance
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Allowances: []Grant{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, grant := range gs.Allowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// Grant is the fee allowance granted by a granter to a grantee
+type Grant struct {
+	Granter   sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance FeeAllowanceI  `json:"allowance" yaml:"allowance"`
+}
+
+// NewGrant creates a new Grant instance
+func NewGrant(granter, grantee sdk.AccAddress, allowance FeeAllowanceI) Grant {
+	return Grant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic does a simple validation check that
+// doesn't require access to any other information.
+func (g Grant) ValidateBasic() error {
+	if err := validateGranterGrantee(g.Granter, g.Grantee); err != nil {
+		return err
+	}
+	if g.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	return g.Allowance.ValidateBasic()
+}
+
+func (g Grant) String() string {
+	return fmt.Sprintf(`Granter:   %s
+Grantee:   %s
+Allowance: %v`, g.Granter, g.Grantee, g.Allowance)
+}
+
+func validateGranterGrantee(granter, grantee sdk.AccAddress) error {
+	if granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if granter.Equals(grantee) {
+		return ErrGranteeIsGranter
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "feegrant"
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+
+	QueryAllowance           = "allowance"
+	QueryAllowances          = "allowances"
+	QueryAllowancesByGranter = "allowances-by-granter"
+)
+
+// KVStore key prefixes
+var (
+	// FeeAllowanceKey is the prefix of the fee allowances, indexed by grantee
+	// and granter
+	FeeAllowanceKey = []byte{0x01}
+)
+
+// GetFeeAllowanceKey returns the KVStore key of the fee allowance granted by
+// granter to grantee: 0x01<grantee_len><grantee><granter_len><granter>
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	key := GetFeeAllowancePrefixByGrantee(grantee)
+	key = append(key, byte(len(granter)))
+	return append(key, granter...)
+}
+
+// GetFeeAllowancePrefixByGrantee returns the KVStore key prefix of all the fee
+// allowances granted to grantee.
+func GetFeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(FeeAllowanceKey)+1+len(grantee))
+	key = append(key, FeeAllowanceKey...)
+	key = append(key, byte(len(grantee)))
+	return append(key, grantee...)
+}
+
+// ParseFeeAllowanceKey returns the granter and the grantee encoded in a fee
+// allowance key.
+func ParseFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
+	key = key[len(FeeAllowanceKey):]
+	granteeLen := int(key[0])
+	grantee, key = key[1:1+granteeLen], key[1+granteeLen:]
+	granterLen := int(key[0])
+	return key[1 : 1+granterLen], grantee
+}
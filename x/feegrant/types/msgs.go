@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+)
+
+// feegrant msg types
+const (
+	TypeMsgGrantAllowance  = "grant_allowance"
+	TypeMsgRevokeAllowance = "revoke_allowance"
+)
+
+var (
+	_ sdk.Msg = MsgGrantAllowance{}
+	_ sdk.Msg = MsgRevokeAllowance{}
+)
+
+// MsgGrantAllowance grants the grantee an allowance to pay its tx fees from the
+// granter's account
+type MsgGrantAllowance struct {
+	Granter   sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance FeeAllowanceI  `json:"allowance" yaml:"allowance"`
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance
+func NewMsgGrantAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowanceI) MsgGrantAllowance {
+	return MsgGrantAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgGrantAllowance) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgGrantAllowance) Type() string { return TypeMsgGrantAllowance }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGrantAllowance) ValidateBasic() error {
+	return NewGrant(msg.Granter, msg.Grantee, msg.Allowance).ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGrantAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGrantAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeAllowance revokes the fee allowance granted by the granter to the
+// grantee
+type MsgRevokeAllowance struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance
+func NewMsgRevokeAllowance(granter, grantee sdk.AccAddress) MsgRevokeAllowance {
+	return MsgRevokeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRevokeAllowance) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRevokeAllowance) Type() string { return TypeMsgRevokeAllowance }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRevokeAllowance) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "invalid revoke allowance")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRevokeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+// QueryAllowanceParams defines the params of the query of the fee allowance
+// granted by a granter to a grantee
+type QueryAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryAllowanceParams creates a new QueryAllowanceParams instance
+func NewQueryAllowanceParams(granter, grantee sdk.AccAddress) QueryAllowanceParams {
+	return QueryAllowanceParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// QueryAccountAllowancesParams defines the params of the queries of the fee
+// allowances granted to a grantee or by a granter
+type QueryAccountAllowancesParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewQueryAccountAllowancesParams creates a new QueryAccountAllowancesParams
+// instance
+func NewQueryAccountAllowancesParams(address sdk.AccAddress) QueryAccountAllowancesParams {
+	return QueryAccountAllowancesParams{Address: address}
+}
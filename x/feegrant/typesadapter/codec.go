@@ -0,0 +1,36 @@
+package typesadapter
+
+import (
+	interfacetypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types"
+	txmsg "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	"github.com/okx/okbchain/libs/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the protobuf msgs and fee allowances of the
+// feegrant module
+func RegisterInterfaces(registry interfacetypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*txmsg.Msg)(nil),
+		&MsgGrantAllowance{},
+		&MsgRevokeAllowance{},
+	)
+	registry.RegisterImplementations(
+		(*types.MsgProtoAdapter)(nil),
+		&MsgGrantAllowance{},
+		&MsgRevokeAllowance{},
+	)
+	registry.RegisterImplementations(
+		(*types.Msg)(nil),
+		&MsgGrantAllowance{},
+		&MsgRevokeAllowance{},
+	)
+	registry.RegisterInterface(
+		"okbchain.feegrant.v1.FeeAllowanceI",
+		(*FeeAllowance)(nil),
+		&BasicAllowance{},
+		&PeriodicAllowance{},
+		&AllowedMsgAllowance{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,994 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: okbchain/feegrant/v1/feegrant.proto
+
+package typesadapter
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types2 "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	github_com_okx_okbchain_libs_cosmos_sdk_types "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	types "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BasicAllowance allows the grantee to spend up to spend_limit coins in fees
+// until the expiration time. An empty spend_limit means no limit, and an
+// allowance without expiration never expires.
+type BasicAllowance struct {
+	SpendLimit github_com_okx_okbchain_libs_cosmos_sdk_types.CoinAdapters `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/okx/okbchain/libs/cosmos-sdk/types.CoinAdapters" json:"spend_limit"`
+	Expiration *time.Time                                                 `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *BasicAllowance) Reset()         { *m = BasicAllowance{} }
+func (m *BasicAllowance) String() string { return proto.CompactTextString(m) }
+func (*BasicAllowance) ProtoMessage()    {}
+func (*BasicAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e317244b5adefd5e, []int{0}
+}
+func (m *BasicAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasicAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasicAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasicAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasicAllowance.Merge(m, src)
+}
+func (m *BasicAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasicAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasicAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasicAllowance proto.InternalMessageInfo
+
+// PeriodicAllowance extends BasicAllowance with a limit on the fees spent in
+// every period.
+type PeriodicAllowance struct {
+	Basic            BasicAllowance                                             `protobuf:"bytes,1,opt,name=basic,proto3" json:"basic"`
+	Period           time.Duration                                              `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	PeriodSpendLimit github_com_okx_okbchain_libs_cosmos_sdk_types.CoinAdapters `protobuf:"bytes,3,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/okx/okbchain/libs/cosmos-sdk/types.CoinAdapters" json:"period_spend_limit"`
+	// period_can_spend is what is left to spend in the current period
+	PeriodCanSpend github_com_okx_okbchain_libs_cosmos_sdk_types.CoinAdapters `protobuf:"bytes,4,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/okx/okbchain/libs/cosmos-sdk/types.CoinAdapters" json:"period_can_spend"`
+	// period_reset is the time the current period ends
+	PeriodReset time.Time `protobuf:"bytes,5,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicAllowance) Reset()         { *m = PeriodicAllowance{} }
+func (m *PeriodicAllowance) String() string { return proto.CompactTextString(m) }
+func (*PeriodicAllowance) ProtoMessage()    {}
+func (*PeriodicAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e317244b5adefd5e, []int{1}
+}
+func (m *PeriodicAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicAllowance.Merge(m, src)
+}
+func (m *PeriodicAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicAllowance proto.InternalMessageInfo
+
+// AllowedMsgAllowance restricts allowance to the txs whose msgs all have one of
+// the allowed_messages types, as "<route>/<type>".
+type AllowedMsgAllowance struct {
+	Allowance       *types2.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	AllowedMessages []string    `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+}
+
+func (m *AllowedMsgAllowance) Reset()         { *m = AllowedMsgAllowance{} }
+func (m *AllowedMsgAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsgAllowance) ProtoMessage()    {}
+func (*AllowedMsgAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e317244b5adefd5e, []int{2}
+}
+func (m *AllowedMsgAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsgAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsgAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsgAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsgAllowance.Merge(m, src)
+}
+func (m *AllowedMsgAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsgAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BasicAllowance)(nil), "okbchain.feegrant.v1.BasicAllowance")
+	proto.RegisterType((*PeriodicAllowance)(nil), "okbchain.feegrant.v1.PeriodicAllowance")
+	proto.RegisterType((*AllowedMsgAllowance)(nil), "okbchain.feegrant.v1.AllowedMsgAllowance")
+}
+
+func init() {
+	proto.RegisterFile("okbchain/feegrant/v1/feegrant.proto", fileDescriptor_e317244b5adefd5e)
+}
+
+var fileDescriptor_e317244b5adefd5e = []byte{
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0x35, 0x69, 0x45, 0x2f, 0xa8, 0x14, 0x13, 0x09, 0x37, 0x83, 0x13, 0x05, 0x86, 0x30,
+	0x70, 0xa7, 0x04, 0xb1, 0xc0, 0x42, 0xdc, 0x4a, 0x2c, 0x54, 0x42, 0x86, 0x89, 0x25, 0x3a, 0xdb,
+	0x57, 0xf7, 0x94, 0xd8, 0x67, 0xf9, 0x2e, 0x21, 0x19, 0x19, 0x58, 0x51, 0xc5, 0xc4, 0x6f, 0xe0,
+	0x97, 0x64, 0xec, 0xc8, 0x94, 0x42, 0xf2, 0x47, 0x90, 0xef, 0xce, 0x49, 0x68, 0x19, 0x3a, 0x75,
+	0x3b, 0xdf, 0xf7, 0xbe, 0xf7, 0xbd, 0xf7, 0xee, 0x93, 0xe1, 0x13, 0x3e, 0x0c, 0xc2, 0x73, 0xc2,
+	0x52, 0x7c, 0x46, 0x69, 0x9c, 0x93, 0x54, 0xe2, 0x49, 0x77, 0x7d, 0x46, 0x59, 0xce, 0x25, 0xb7,
+	0xeb, 0x25, 0x08, 0xad, 0x0b, 0x93, 0x6e, 0xa3, 0x1e, 0xf3, 0x98, 0x2b, 0x00, 0x2e, 0x4e, 0x1a,
+	0xdb, 0x38, 0x8a, 0x39, 0x8f, 0x47, 0x14, 0xab, 0xaf, 0x60, 0x7c, 0x86, 0x49, 0x3a, 0x33, 0xa5,
+	0xe6, 0xf5, 0x92, 0x64, 0x09, 0x15, 0x92, 0x24, 0x99, 0x01, 0xb8, 0xd7, 0x01, 0xd1, 0x38, 0x27,
+	0x92, 0xf1, 0xb4, 0xac, 0x87, 0x5c, 0x24, 0x5c, 0xe0, 0x80, 0x08, 0x8a, 0x27, 0xdd, 0x80, 0x4a,
+	0xd2, 0xc5, 0x21, 0x67, 0xa6, 0xde, 0x5e, 0x00, 0x78, 0xe0, 0x11, 0xc1, 0xc2, 0xfe, 0x68, 0xc4,
+	0x3f, 0x93, 0x34, 0xa4, 0xf6, 0x57, 0x00, 0x6b, 0x22, 0xa3, 0x69, 0x34, 0x18, 0xb1, 0x84, 0x49,
+	0x07, 0xb4, 0x2a, 0x9d, 0x5a, 0xaf, 0x85, 0x34, 0x13, 0x2a, 0x98, 0x90, 0x61, 0x42, 0xc7, 0x9c,
+	0xa5, 0xfd, 0x88, 0x64, 0x92, 0xe6, 0x9e, 0x37, 0x5f, 0x34, 0xad, 0x9f, 0x57, 0xcd, 0x57, 0x31,
+	0x93, 0xe7, 0xe3, 0x00, 0x85, 0x3c, 0xc1, 0x7c, 0x38, 0xc5, 0xeb, 0xb8, 0x46, 0x2c, 0x10, 0x58,
+	0xb3, 0x3c, 0x17, 0xd1, 0x10, 0xcb, 0x59, 0x46, 0xc5, 0x36, 0x85, 0xf0, 0xa1, 0x1a, 0xfc, 0xae,
+	0x98, 0x6b, 0x9f, 0x40, 0x48, 0xa7, 0x19, 0xd3, 0x76, 0x9c, 0x9d, 0x16, 0xe8, 0xd4, 0x7a, 0x0d,
+	0xa4, 0xfd, 0xa2, 0xd2, 0x2f, 0xfa, 0x58, 0x06, 0xe2, 0xdd, 0x9b, 0x2f, 0x9a, 0xe0, 0xe2, 0xaa,
+	0x09, 0xfc, 0xad, 0xbe, 0xf6, 0x97, 0x2a, 0x7c, 0xf8, 0x9e, 0xe6, 0x8c, 0x47, 0xdb, 0x1e, 0xdf,
+	0xc0, 0xdd, 0xa0, 0x70, 0xed, 0x00, 0x45, 0xfb, 0x14, 0xfd, 0xef, 0xb9, 0xd0, 0xbf, 0xc1, 0x78,
+	0xd5, 0xc2, 0xa0, 0xaf, 0x1b, 0xed, 0xd7, 0x70, 0x2f, 0x53, 0xb4, 0x46, 0xd9, 0xd1, 0x0d, 0x65,
+	0x27, 0xe6, 0x25, 0x94, 0x30, 0xeb, 0x47, 0x21, 0xcc, 0xb4, 0xd8, 0xdf, 0x01, 0xb4, 0xf5, 0x71,
+	0xb0, 0x9d, 0x74, 0xe5, 0x0e, 0x93, 0x3e, 0xd4, 0xf3, 0x3f, 0x6c, 0xf2, 0xfe, 0x06, 0xa0, 0xb9,
+	0x1c, 0x84, 0x24, 0xd5, 0xc2, 0x9c, 0xea, 0x1d, 0x4a, 0x3a, 0xd0, 0xd3, 0x8f, 0x49, 0xaa, 0x54,
+	0xd9, 0x6f, 0xe1, 0x7d, 0xa3, 0x27, 0xa7, 0x82, 0x4a, 0x67, 0xf7, 0x56, 0x2b, 0x60, 0xa9, 0x15,
+	0xa8, 0xe9, 0x4e, 0xbf, 0x68, 0x6c, 0x4b, 0xf8, 0x48, 0xbd, 0x22, 0x8d, 0x4e, 0x45, 0xbc, 0x59,
+	0x82, 0x1e, 0xdc, 0x27, 0xe5, 0x87, 0x59, 0x84, 0xfa, 0x0d, 0xf2, 0x7e, 0x3a, 0xf3, 0x37, 0x30,
+	0xfb, 0x19, 0x3c, 0x24, 0x9a, 0x6a, 0x90, 0x50, 0x21, 0x48, 0x4c, 0x85, 0xb3, 0xd3, 0xaa, 0x74,
+	0xf6, 0xfd, 0x07, 0xe6, 0xfe, 0xd4, 0x5c, 0x7b, 0x2f, 0xe7, 0x7f, 0x5c, 0x6b, 0xbe, 0x74, 0xc1,
+	0xe5, 0xd2, 0x05, 0xbf, 0x97, 0x2e, 0xb8, 0x58, 0xb9, 0xd6, 0xe5, 0xca, 0xb5, 0x7e, 0xad, 0x5c,
+	0xeb, 0xd3, 0xe3, 0xe9, 0xe6, 0xf7, 0xa1, 0xb2, 0x20, 0x3a, 0x86, 0x60, 0x4f, 0x8d, 0x7e, 0xf1,
+	0x77, 0x00, 0xc4, 0x8c, 0xc8, 0x32, 0x67, 0x04, 0x00, 0x00,
+}
+
+func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasicAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFeegrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFeegrant(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFeegrant(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsgAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BasicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *AllowedMsgAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.CoinAdapter{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.CoinAdapter{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.CoinAdapter{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types2.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,224 @@
+package typesadapter
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	codectypes "github.com/okx/okbchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	txmsg "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	"github.com/okx/okbchain/x/feegrant/types"
+)
+
+var (
+	_ txmsg.Msg = &MsgGrantAllowance{}
+	_ txmsg.Msg = &MsgRevokeAllowance{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgGrantAllowance{}
+	_ codectypes.UnpackInterfacesMessage = AllowedMsgAllowance{}
+
+	_ FeeAllowance = &BasicAllowance{}
+	_ FeeAllowance = &PeriodicAllowance{}
+	_ FeeAllowance = &AllowedMsgAllowance{}
+)
+
+// FeeAllowance is implemented by the protobuf fee allowances, which are
+// converted to their amino counterparts to be stored and accepted
+type FeeAllowance interface {
+	proto.Message
+
+	ToFeeAllowance() (types.FeeAllowanceI, error)
+}
+
+// ToFeeAllowance implements FeeAllowance
+func (a *BasicAllowance) ToFeeAllowance() (types.FeeAllowanceI, error) {
+	basic := a.toBasicAllowance()
+	return &basic, nil
+}
+
+func (a BasicAllowance) toBasicAllowance() types.BasicAllowance {
+	var expiration time.Time
+	if a.Expiration != nil {
+		expiration = *a.Expiration
+	}
+	return types.BasicAllowance{
+		SpendLimit: a.SpendLimit.ToCoins(),
+		Expiration: expiration,
+	}
+}
+
+// ToFeeAllowance implements FeeAllowance
+func (a *PeriodicAllowance) ToFeeAllowance() (types.FeeAllowanceI, error) {
+	return &types.PeriodicAllowance{
+		Basic:            a.Basic.toBasicAllowance(),
+		Period:           a.Period,
+		PeriodSpendLimit: a.PeriodSpendLimit.ToCoins(),
+		PeriodCanSpend:   a.PeriodCanSpend.ToCoins(),
+		PeriodReset:      a.PeriodReset,
+	}, nil
+}
+
+// NewAllowedMsgAllowance packs allowance into a new AllowedMsgAllowance
+// instance
+func NewAllowedMsgAllowance(allowance FeeAllowance, allowedMessages []string) (*AllowedMsgAllowance, error) {
+	any, err := codectypes.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowedMsgAllowance{
+		Allowance:       any,
+		AllowedMessages: allowedMessages,
+	}, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (a AllowedMsgAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackFeeAllowance(unpacker, a.Allowance)
+}
+
+// ToFeeAllowance implements FeeAllowance
+func (a *AllowedMsgAllowance) ToFeeAllowance() (types.FeeAllowanceI, error) {
+	allowance, err := toFeeAllowance(a.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewAllowedMsgAllowance(allowance, a.AllowedMessages), nil
+}
+
+func unpackFeeAllowance(unpacker codectypes.AnyUnpacker, any *codectypes.Any) error {
+	var allowance FeeAllowance
+	if err := unpacker.UnpackAny(any, &allowance); err != nil {
+		return err
+	}
+	// an allowed msg allowance nests another allowance
+	if nested, ok := allowance.(codectypes.UnpackInterfacesMessage); ok {
+		return nested.UnpackInterfaces(unpacker)
+	}
+	return nil
+}
+
+func toFeeAllowance(any *codectypes.Any) (types.FeeAllowanceI, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoAllowance, "allowance should not be empty")
+	}
+	allowance, ok := any.GetCachedValue().(FeeAllowance)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack allowance %s", any.TypeUrl)
+	}
+	return allowance.ToFeeAllowance()
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance
+func NewMsgGrantAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowance) (*MsgGrantAllowance, error) {
+	any, err := codectypes.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgGrantAllowance{
+		Granter:   granter.String(),
+		Grantee:   grantee.String(),
+		Allowance: any,
+	}, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage
+func (msg MsgGrantAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackFeeAllowance(unpacker, msg.Allowance)
+}
+
+// ToMsgGrantAllowance converts the msg to its amino counterpart
+func (msg MsgGrantAllowance) ToMsgGrantAllowance() (types.MsgGrantAllowance, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return types.MsgGrantAllowance{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return types.MsgGrantAllowance{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	allowance, err := toFeeAllowance(msg.Allowance)
+	if err != nil {
+		return types.MsgGrantAllowance{}, err
+	}
+	return types.NewMsgGrantAllowance(granter, grantee, allowance), nil
+}
+
+func (msg MsgGrantAllowance) ValidateBasic() error {
+	aminoMsg, err := msg.ToMsgGrantAllowance()
+	if err != nil {
+		return err
+	}
+	return aminoMsg.ValidateBasic()
+}
+
+func (msg MsgGrantAllowance) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgGrantAllowance) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgGrantAllowance) Type() string {
+	return types.TypeMsgGrantAllowance
+}
+
+// GetSignBytes returns the sign bytes of the amino counterpart of the msg
+func (msg MsgGrantAllowance) GetSignBytes() []byte {
+	aminoMsg, err := msg.ToMsgGrantAllowance()
+	if err != nil {
+		panic(err)
+	}
+	return aminoMsg.GetSignBytes()
+}
+
+// ToMsgRevokeAllowance converts the msg to its amino counterpart
+func (msg MsgRevokeAllowance) ToMsgRevokeAllowance() (types.MsgRevokeAllowance, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return types.MsgRevokeAllowance{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return types.MsgRevokeAllowance{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	return types.NewMsgRevokeAllowance(granter, grantee), nil
+}
+
+func (msg MsgRevokeAllowance) ValidateBasic() error {
+	aminoMsg, err := msg.ToMsgRevokeAllowance()
+	if err != nil {
+		return err
+	}
+	return aminoMsg.ValidateBasic()
+}
+
+func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgRevokeAllowance) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgRevokeAllowance) Type() string {
+	return types.TypeMsgRevokeAllowance
+}
+
+// GetSignBytes returns the sign bytes of the amino counterpart of the msg
+func (msg MsgRevokeAllowance) GetSignBytes() []byte {
+	aminoMsg, err := msg.ToMsgRevokeAllowance()
+	if err != nil {
+		panic(err)
+	}
+	return aminoMsg.GetSignBytes()
+}
@@ -44,11 +44,14 @@ var (
 	NewMsgSubmitProposal       = types.NewMsgSubmitProposal
 	NewMsgDeposit              = types.NewMsgDeposit
 	NewMsgVote                 = types.NewMsgVote
+	NewMsgVoteWeighted         = types.NewMsgVoteWeighted
 	ParamKeyTable              = types.ParamKeyTable
 	NewDepositParams           = types.NewDepositParams
 	NewTallyParams             = types.NewTallyParams
 	NewVotingParams            = types.NewVotingParams
 	NewParams                  = types.NewParams
+	NewExpeditedParams         = types.NewExpeditedParams
+	DefaultExpeditedParams     = types.DefaultExpeditedParams
 	NewTallyResultFromMap      = types.NewTallyResultFromMap
 	EmptyTallyResult           = types.EmptyTallyResult
 	NewTextProposal            = types.NewTextProposal
@@ -62,16 +65,17 @@ var (
 	NewQueryProposalsParams    = types.NewQueryProposalsParams
 
 	// variable aliases
-	ModuleCdc                   = types.ModuleCdc
-	ProposalsKeyPrefix          = types.ProposalsKeyPrefix
-	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
-	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
-	ProposalIDKey               = types.ProposalIDKey
-	DepositsKeyPrefix           = types.DepositsKeyPrefix
-	VotesKeyPrefix              = types.VotesKeyPrefix
-	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
-	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
-	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ModuleCdc                    = types.ModuleCdc
+	ProposalsKeyPrefix           = types.ProposalsKeyPrefix
+	ActiveProposalQueuePrefix    = types.ActiveProposalQueuePrefix
+	InactiveProposalQueuePrefix  = types.InactiveProposalQueuePrefix
+	ProposalIDKey                = types.ProposalIDKey
+	DepositsKeyPrefix            = types.DepositsKeyPrefix
+	VotesKeyPrefix               = types.VotesKeyPrefix
+	ParamStoreKeyDepositParams   = types.ParamStoreKeyDepositParams
+	ParamStoreKeyVotingParams    = types.ParamStoreKeyVotingParams
+	ParamStoreKeyTallyParams     = types.ParamStoreKeyTallyParams
+	ParamStoreKeyExpeditedParams = types.ParamStoreKeyExpeditedParams
 
 	NewKeeper  = keeper.NewKeeper
 	NewQuerier = keeper.NewQuerier
@@ -86,10 +90,12 @@ type (
 	MsgSubmitProposal = types.MsgSubmitProposal
 	MsgDeposit        = types.MsgDeposit
 	MsgVote           = types.MsgVote
+	MsgVoteWeighted   = types.MsgVoteWeighted
 	DepositParams     = types.DepositParams
 	TallyParams       = types.TallyParams
 	VotingParams      = types.VotingParams
 	Params            = types.Params
+	ExpeditedParams   = types.ExpeditedParams
 	Proposal          = types.Proposal
 	Proposals         = types.Proposals
 	ProposalStatus    = types.ProposalStatus
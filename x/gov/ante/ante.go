@@ -9,6 +9,8 @@ import (
 	ethermint "github.com/okx/okbchain/app/types"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	authztypes "github.com/okx/okbchain/x/authz/types"
+	authztypesadapter "github.com/okx/okbchain/x/authz/typesadapter"
 	evmtypes "github.com/okx/okbchain/x/evm/types"
 	"github.com/okx/okbchain/x/gov/types"
 	"github.com/okx/okbchain/x/params"
@@ -28,48 +30,69 @@ func NewAnteDecorator(k stakingkeeper.Keeper, ak auth.AccountKeeper, pk params.K
 }
 
 func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	for _, m := range tx.GetMsgs() {
+	if err := ad.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the proposals submitted by msgs, including the ones executed
+// on behalf of a granter by an authz MsgExec.
+func (ad AnteDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
 		switch msg := m.(type) {
 		case types.MsgSubmitProposal:
 			switch proposalType := msg.Content.(type) {
 			case evmtypes.ManageContractByteCodeProposal:
 				if !ad.sk.IsValidator(ctx, msg.Proposer) {
-					return ctx, evmtypes.ErrCodeProposerMustBeValidator()
+					return evmtypes.ErrCodeProposerMustBeValidator()
 				}
 
 				// check operation contract
 				contract := ad.ak.GetAccount(ctx, proposalType.Contract)
 				contractAcc, ok := contract.(*ethermint.EthAccount)
 				if !ok || !contractAcc.IsContract() {
-					return ctx, evmtypes.ErrNotContracAddress(fmt.Errorf(ethcmn.BytesToAddress(proposalType.Contract).String()))
+					return evmtypes.ErrNotContracAddress(fmt.Errorf(ethcmn.BytesToAddress(proposalType.Contract).String()))
 				}
 
 				//check substitute contract
 				substitute := ad.ak.GetAccount(ctx, proposalType.SubstituteContract)
 				substituteAcc, ok := substitute.(*ethermint.EthAccount)
 				if !ok || !substituteAcc.IsContract() {
-					return ctx, evmtypes.ErrNotContracAddress(fmt.Errorf(ethcmn.BytesToAddress(proposalType.SubstituteContract).String()))
+					return evmtypes.ErrNotContracAddress(fmt.Errorf(ethcmn.BytesToAddress(proposalType.SubstituteContract).String()))
 				}
 			case stakingtypes.ProposeValidatorProposal:
 				if !ad.sk.IsValidator(ctx, msg.Proposer) {
-					return ctx, stakingtypes.ErrCodeProposerMustBeValidator
+					return stakingtypes.ErrCodeProposerMustBeValidator
 				}
 			case paramstypes.UpgradeProposal:
 				if err := ad.pk.CheckMsgSubmitProposal(ctx, msg); err != nil {
-					return ctx, err
+					return err
 				}
 			case *wasmtypes.ExtraProposal:
 				if !ad.sk.IsValidator(ctx, msg.Proposer) {
-					return ctx, wasmtypes.ErrProposerMustBeValidator
+					return wasmtypes.ErrProposerMustBeValidator
 				}
 			case mint.ExtraProposal:
 				if !ad.sk.IsValidator(ctx, msg.Proposer) {
-					return ctx, mint.ErrProposerMustBeValidator
+					return mint.ErrProposerMustBeValidator
 				}
 
 			}
+		case authztypes.MsgExec:
+			if err := ad.checkMsgs(ctx, msg.Msgs); err != nil {
+				return err
+			}
+		case *authztypesadapter.MsgExec:
+			execMsg, err := msg.ToMsgExec()
+			if err != nil {
+				return err
+			}
+			if err = ad.checkMsgs(ctx, execMsg.Msgs); err != nil {
+				return err
+			}
 		}
 	}
-
-	return next(ctx, tx, simulate)
+	return nil
 }
@@ -18,6 +18,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(flagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(flagDeposit)
+		proposal.Expedited = viper.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
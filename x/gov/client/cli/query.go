@@ -208,7 +208,7 @@ func GetCmdQueryParam(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|expedited) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -216,8 +216,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param expedited
 `,
-				version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -242,8 +243,12 @@ $ %s query gov param deposit
 				var param types.DepositParams
 				cdc.MustUnmarshalJSON(res, &param)
 				out = param
+			case "expedited":
+				var param types.ExpeditedParams
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
 			default:
-				return fmt.Errorf("Argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("Argument must be one of (voting|tallying|deposit|expedited), was %s", args[0])
 			}
 
 			return cliCtx.PrintOutput(out)
@@ -280,6 +285,10 @@ $ %s query gov params
 			if err != nil {
 				return err
 			}
+			ep, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/expedited", queryRoute), nil)
+			if err != nil {
+				return err
+			}
 
 			var tallyParams types.TallyParams
 			cdc.MustUnmarshalJSON(tp, &tallyParams)
@@ -287,8 +296,10 @@ $ %s query gov params
 			cdc.MustUnmarshalJSON(dp, &depositParams)
 			var votingParams types.VotingParams
 			cdc.MustUnmarshalJSON(vp, &votingParams)
+			var expeditedParams types.ExpeditedParams
+			cdc.MustUnmarshalJSON(ep, &expeditedParams)
 
-			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams))
+			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams, expeditedParams))
 		},
 	}
 }
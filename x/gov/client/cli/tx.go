@@ -26,6 +26,7 @@ const (
 	FlagTitle        = "title"
 	FlagDescription  = "description"
 	FlagDeposit      = "deposit"
+	FlagExpedited    = "expedited"
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
@@ -43,6 +44,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
 }
 
 // proposalFlags defines the core required fields of a proposal. It is used to
@@ -77,6 +79,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(flags.PostCommands(
 		getCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -101,15 +104,23 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10%s"
+  "deposit": "10%s",
+  "expedited": false
 }
 
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" \
 	--deposit="10%s" --from mykey
+
+An expedited proposal needs a higher deposit and more Yes votes to pass in a shorter voting period,
+it's voted on as a regular proposal if it fails:
+
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" \
+	--deposit="50%s" --%s --from mykey
 `,
 				version.ClientName, sdk.DefaultBondDenom, version.ClientName, sdk.DefaultBondDenom,
+				version.ClientName, sdk.DefaultBondDenom, FlagExpedited,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -129,6 +140,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg.IsExpedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -141,6 +153,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "",
 		"proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagExpedited, false, "submit an expedited proposal")
 	cmd.Flags().String(flagProposal, "",
 		"proposal file path (if this path is given, other proposal flags are ignored)")
 
@@ -243,4 +256,54 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power across options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, giving a share of the voting
+power to each of the options. The weights must sum up to 1. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
@@ -43,6 +43,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, phs []ProposalREST
 	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/weighted-votes", RestProposalID), weightedVoteHandlerFn(cliCtx)).Methods("POST")
 
 	r.HandleFunc(
 		fmt.Sprintf("/gov/parameters/{%s}", RestParamsType),
@@ -70,6 +71,7 @@ type PostProposalReq struct {
 	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
 	InitialDeposit sdk.SysCoins   `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	IsExpedited    bool           `json:"is_expedited" yaml:"is_expedited"`       // Whether the proposal is expedited
 }
 
 // DepositReq defines the properties of a deposit request's body.
@@ -86,6 +88,13 @@ type VoteReq struct {
 	Option  string         `json:"option" yaml:"option"` // option from OptionSet chosen by the voter
 }
 
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`     // address of the voter
+	Options string         `json:"options" yaml:"options"` // weighted options chosen by the voter, e.g. "yes=0.6,no=0.4"
+}
+
 func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req PostProposalReq
@@ -102,6 +111,7 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		content := types.ContentFromProposalType(req.Title, req.Description, proposalType)
 
 		msg := types.NewMsgSubmitProposal(content, req.InitialDeposit, req.Proposer)
+		msg.IsExpedited = req.IsExpedited
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -191,6 +201,49 @@ func voteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func weightedVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if len(strProposalID) == 0 {
+			err := errors.New("proposalId required but not specified")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		var req WeightedVoteReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		options, err := types.WeightedVoteOptionsFromString(gcutils.NormalizeWeightedVoteOptions(req.Options))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgVoteWeighted(req.Voter, proposalID, options)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
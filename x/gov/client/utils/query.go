@@ -79,30 +79,26 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 // NOTE: SearchTxs is used to facilitate the txs query which does not currently
 // support configurable pagination.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
-	if err != nil {
-		return nil, err
-	}
-
 	var votes []types.Vote
 
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+		}
 
-				votes = append(votes, types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				})
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.Tx.GetMsgs() {
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
+				}
 			}
 		}
 	}
@@ -116,36 +112,30 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalPa
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
-	if err != nil {
-		return nil, err
-	}
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		}
 
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+		if err != nil {
+			return nil, err
+		}
 
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.Tx.GetMsgs() {
+				// there should only be a single vote under the given conditions
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					if cliCtx.Indent {
+						return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
+					}
 
-				if cliCtx.Indent {
-					return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
+					return cliCtx.Codec.MarshalJSON(vote)
 				}
-
-				return cliCtx.Codec.MarshalJSON(vote)
 			}
 		}
 	}
@@ -153,6 +143,18 @@ func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg builds the vote on proposalID cast by msg, if msg is a vote msg
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case types.MsgVote:
+		return types.NewVote(proposalID, msg.Voter, msg.Option), true
+	case types.MsgVoteWeighted:
+		return types.NewWeightedVote(proposalID, msg.Voter, msg.Options), true
+	default:
+		return types.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(cliCtx context.CLIContext, params types.QueryDepositParams) ([]byte, error) {
@@ -81,6 +81,11 @@ func handleActiveProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger)
 	k.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 
 		status, distribute, tallyResults := keeper.Tally(ctx, k, proposal, true)
+		if proposal.IsExpedited && status != StatusPassed {
+			handleFailedExpeditedProposal(ctx, k, proposal, tallyResults, logger)
+			return false
+		}
+
 		tagValue, logMsg := handleProposalAfterTally(ctx, k, &proposal, distribute, status)
 		proposal.FinalTallyResult = tallyResults
 		k.SetProposal(ctx, proposal)
@@ -103,3 +108,26 @@ func handleActiveProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger)
 		return false
 	})
 }
+
+// handleFailedExpeditedProposal converts the expedited proposal failed in its voting period to a regular
+// proposal, the votes and the deposits are kept to tally at the end of the regular voting period
+func handleFailedExpeditedProposal(ctx sdk.Context, k keeper.Keeper, proposal Proposal,
+	tallyResults types.TallyResult, logger log.Logger) {
+	k.ConvertExpeditedProposal(ctx, &proposal)
+	proposal.FinalTallyResult = tallyResults
+	k.SetProposal(ctx, proposal)
+
+	logger.Info(
+		fmt.Sprintf("expedited proposal %d (%s) failed; converted to regular proposal ending at %s",
+			proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+		),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActiveProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+		),
+	)
+}
@@ -21,6 +21,7 @@ type GenesisState struct {
 	DepositParams      DepositParams     `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams      `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams       `json:"tally_params" yaml:"tally_params"`
+	ExpeditedParams    ExpeditedParams   `json:"expedited_params" yaml:"expedited_params"`
 }
 
 // DefaultGenesisState get raw genesis raw message for testing
@@ -42,6 +43,7 @@ func DefaultGenesisState() GenesisState {
 			Veto:            sdk.NewDecWithPrec(334, 3),
 			YesInVotePeriod: sdk.NewDecWithPrec(667, 3),
 		},
+		ExpeditedParams: types.DefaultExpeditedParams(),
 	}
 }
 
@@ -89,7 +91,11 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
-	return nil
+	if err := data.ExpeditedParams.Validate(); err != nil {
+		return err
+	}
+
+	return data.ExpeditedParams.ValidateWith(data.DepositParams, data.VotingParams, data.TallyParams)
 }
 
 // InitGenesis - store genesis parameters
@@ -98,6 +104,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper keeper.SupplyKee
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetExpeditedParams(ctx, data.ExpeditedParams)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -151,6 +158,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	expeditedParams := k.GetExpeditedParams(ctx)
 
 	proposals := k.GetProposalsFiltered(ctx, nil, nil, StatusNil, 0)
 
@@ -179,5 +187,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		ExpeditedParams:    expeditedParams,
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/common"
 	"github.com/okx/okbchain/x/gov/keeper"
 	"github.com/okx/okbchain/x/gov/types"
@@ -24,6 +25,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -32,6 +36,11 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper keeper.Keeper, msg MsgSubmitProposal) (*sdk.Result, error) {
+	if msg.IsExpedited && !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		errMsg := fmt.Sprintf("expedited proposal is not supported at height %d", ctx.BlockHeight())
+		return sdk.ErrUnknownRequest(errMsg).Result()
+	}
+
 	err := hasOnlyDefaultBondDenom(msg.InitialDeposit)
 	if err != nil {
 		return sdk.EnvelopedErr{err}.Result()
@@ -48,7 +57,12 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper keeper.Keeper, msg MsgSubmi
 		return sdk.EnvelopedErr{err}.Result()
 	}
 
-	proposal, err := keeper.SubmitProposal(ctx, msg.Content)
+	var proposal types.Proposal
+	if msg.IsExpedited {
+		proposal, err = keeper.SubmitExpeditedProposal(ctx, msg.Content)
+	} else {
+		proposal, err = keeper.SubmitProposal(ctx, msg.Content)
+	}
 	if err != nil {
 		return sdk.EnvelopedErr{err}.Result()
 	}
@@ -112,6 +126,31 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		return sdk.EnvelopedErr{err}.Result()
 	}
 
+	return handleProposalAfterVote(ctx, k, proposal, msg.Voter)
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, k keeper.Keeper, msg MsgVoteWeighted) (*sdk.Result, error) {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		errMsg := fmt.Sprintf("weighted vote is not supported at height %d", ctx.BlockHeight())
+		return sdk.ErrUnknownRequest(errMsg).Result()
+	}
+
+	proposal, ok := k.GetProposal(ctx, msg.ProposalID)
+	if !ok {
+		return sdk.EnvelopedErr{types.ErrUnknownProposal(msg.ProposalID)}.Result()
+	}
+
+	err, _ := k.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return sdk.EnvelopedErr{err}.Result()
+	}
+
+	return handleProposalAfterVote(ctx, k, proposal, msg.Voter)
+}
+
+// handleProposalAfterVote tallies the votes on proposal after a vote of voter,
+// ending its voting period if the result is already decided
+func handleProposalAfterVote(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal, voter sdk.AccAddress) (*sdk.Result, error) {
 	status, distribute, tallyResults := keeper.Tally(ctx, k, proposal, false)
 	// update tally results after vote every time
 	proposal.FinalTallyResult = tallyResults
@@ -137,7 +176,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalStatus, proposal.Status.String()),
 		),
 	)
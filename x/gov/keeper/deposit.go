@@ -21,13 +21,7 @@ func tryEnterVotingPeriod(
 	proposal.TotalDeposit = proposal.TotalDeposit.Add(depositAmount...)
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	var minDeposit sdk.SysCoins
-	if !keeper.proposalHandlerRouter.HasRoute(proposal.ProposalRoute()) {
-		minDeposit = keeper.GetDepositParams(ctx).MinDeposit
-	} else {
-		phr := keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute())
-		minDeposit = phr.GetMinDeposit(ctx, proposal.Content)
-	}
+	minDeposit := keeper.proposalMinDeposit(ctx, *proposal)
 
 	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(minDeposit) {
 		keeper.activateVotingPeriod(ctx, proposal)
@@ -121,6 +121,21 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetExpeditedParams returns the current ExpeditedParams from the global param store, or the default
+// ExpeditedParams if they are not set yet
+func (keeper Keeper) GetExpeditedParams(ctx sdk.Context) types.ExpeditedParams {
+	expeditedParams := types.DefaultExpeditedParams()
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyExpeditedParams, &expeditedParams)
+	return expeditedParams
+}
+
+// ValidateParams checks the current ExpeditedParams are still stricter than the params of the
+// regular proposals, it's called after the gov params are changed by a proposal
+func (keeper Keeper) ValidateParams(ctx sdk.Context) error {
+	return keeper.GetExpeditedParams(ctx).ValidateWith(
+		keeper.GetDepositParams(ctx), keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx))
+}
+
 // SetDepositParams sets the current DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -136,6 +151,11 @@ func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyPara
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
 
+// SetExpeditedParams sets the current ExpeditedParams to the global param store
+func (keeper Keeper) SetExpeditedParams(ctx sdk.Context, expeditedParams types.ExpeditedParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyExpeditedParams, &expeditedParams)
+}
+
 // ProposalQueues
 
 // WaitingProposalQueueIterator returns an iterator for all the proposals in the Waiting Queue that expire by endTime
@@ -326,7 +346,11 @@ func (keeper Keeper) GetVotingPeriod(ctx sdk.Context, content types.Content) tim
 // nolint
 func (keeper Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg types.MsgSubmitProposal) sdk.Error {
 	// check initial deposit more than or equal to ratio of MinDeposit
-	initDeposit := keeper.GetDepositParams(ctx).MinDeposit.MulDec(sdk.NewDecWithPrec(1, 1))
+	minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+	if msg.IsExpedited {
+		minDeposit = keeper.GetExpeditedParams(ctx).MinDeposit
+	}
+	initDeposit := minDeposit.MulDec(sdk.NewDecWithPrec(1, 1))
 	err := common.HasSufficientCoins(msg.Proposer, msg.InitialDeposit,
 		initDeposit)
 	if err != nil {
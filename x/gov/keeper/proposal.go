@@ -10,6 +10,15 @@ import (
 
 // SubmitProposal creates new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, false)
+}
+
+// SubmitExpeditedProposal creates new expedited proposal given a content
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, true)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, content types.Content, isExpedited bool) (types.Proposal, sdk.Error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, types.ErrNoProposalHandlerExists(content)
 	}
@@ -30,6 +39,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	}
 	proposal := types.NewProposal(ctx, keeper.TotalPower(ctx), content, proposalID, submitTime,
 		submitTime.Add(depositPeriod))
+	proposal.IsExpedited = isExpedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -158,17 +168,63 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal *types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	var votingPeriod time.Duration
-	if !keeper.proposalHandlerRouter.HasRoute(proposal.ProposalRoute()) {
-		votingPeriod = keeper.GetVotingPeriod(ctx, proposal.Content)
-	} else {
-		phr := keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute())
-		votingPeriod = phr.GetVotingPeriod(ctx, proposal.Content)
-	}
 	// calculate the end time of voting
-	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.proposalVotingPeriod(ctx, *proposal))
 	proposal.Status = types.StatusVotingPeriod
 
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 }
+
+// ConvertExpeditedProposal converts a failed expedited proposal to a regular proposal. The votes and the
+// deposits are kept, and the voting period is extended to the one of the regular proposal.
+func (keeper Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal *types.Proposal) {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+	proposal.IsExpedited = false
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.proposalVotingPeriod(ctx, *proposal))
+
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+}
+
+// proposalMinDeposit returns the min deposit for the proposal to enter voting period, an expedited
+// proposal must deposit the larger of the expedited min deposit and the min deposit of its route
+func (keeper Keeper) proposalMinDeposit(ctx sdk.Context, proposal types.Proposal) sdk.SysCoins {
+	var minDeposit sdk.SysCoins
+	if !keeper.proposalHandlerRouter.HasRoute(proposal.ProposalRoute()) {
+		minDeposit = keeper.GetDepositParams(ctx).MinDeposit
+	} else {
+		minDeposit = keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute()).GetMinDeposit(ctx, proposal.Content)
+	}
+	if proposal.IsExpedited {
+		return maxCoins(keeper.GetExpeditedParams(ctx).MinDeposit, minDeposit)
+	}
+	return minDeposit
+}
+
+// maxCoins returns the larger amount of each denom of the two coin sets
+func maxCoins(coinsA, coinsB sdk.SysCoins) sdk.SysCoins {
+	max := sdk.SysCoins{}
+	for _, coin := range coinsA {
+		if coin.Amount.GTE(coinsB.AmountOf(coin.Denom)) {
+			max = max.Add(coin)
+		}
+	}
+	for _, coin := range coinsB {
+		if coin.Amount.GT(coinsA.AmountOf(coin.Denom)) {
+			max = max.Add(coin)
+		}
+	}
+	return max
+}
+
+// proposalVotingPeriod returns the voting period of the proposal
+func (keeper Keeper) proposalVotingPeriod(ctx sdk.Context, proposal types.Proposal) time.Duration {
+	if proposal.IsExpedited {
+		return keeper.GetExpeditedParams(ctx).VotingPeriod
+	}
+	if !keeper.proposalHandlerRouter.HasRoute(proposal.ProposalRoute()) {
+		return keeper.GetVotingPeriod(ctx, proposal.Content)
+	}
+	return keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute()).GetVotingPeriod(ctx, proposal.Content)
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxCoins(t *testing.T) {
+	coinsA := sdk.SysCoins{sdk.NewInt64DecCoin("aaa", 10), sdk.NewInt64DecCoin("bbb", 5)}
+	coinsB := sdk.SysCoins{sdk.NewInt64DecCoin("bbb", 20), sdk.NewInt64DecCoin("ccc", 1)}
+
+	expected := sdk.SysCoins{sdk.NewInt64DecCoin("aaa", 10), sdk.NewInt64DecCoin("bbb", 20), sdk.NewInt64DecCoin("ccc", 1)}
+	require.Equal(t, expected, maxCoins(coinsA, coinsB))
+	require.Equal(t, expected, maxCoins(coinsB, coinsA))
+	require.Equal(t, coinsA, maxCoins(coinsA, nil))
+	require.Equal(t, coinsA, maxCoins(coinsA, coinsA))
+}
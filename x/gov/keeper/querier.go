@@ -55,6 +55,12 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 			return nil, common.ErrMarshalJSONFailed(err.Error())
 		}
 		return bz, nil
+	case types.ParamExpedited:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetExpeditedParams(ctx))
+		if err != nil {
+			return nil, common.ErrMarshalJSONFailed(err.Error())
+		}
+		return bz, nil
 	default:
 		return nil, types.ErrUnknownGovParamType()
 	}
@@ -2,21 +2,22 @@ package keeper
 
 import (
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/gov/types"
 	"github.com/okx/okbchain/x/staking/exported"
 )
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress   // address of the validator operator
-	BondedTokens        sdk.Int          // Power of a Validator
-	DelegatorShares     sdk.Dec          // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec          // Delegator deductions from validator's delegators voting independently
-	Vote                types.VoteOption // Vote of the validator
+	Address             sdk.ValAddress            // address of the validator operator
+	BondedTokens        sdk.Int                   // Power of a Validator
+	DelegatorShares     sdk.Dec                   // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec                   // Delegator deductions from validator's delegators voting independently
+	Vote                types.WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote types.VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote types.WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -43,7 +44,7 @@ func tallyDelegatorVotes(
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.WeightedOptions()
 			currValidators[valAddrStr] = val
 		} else {
 			// iterate over all delegations from voter, deduct from any delegated-to validators
@@ -62,7 +63,7 @@ func tallyDelegatorVotes(
 					if voteP != nil && vote.Voter.Equals(voteP.Voter) {
 						voterPower.Add(votedPower)
 					}
-					results[vote.Option] = results[vote.Option].Add(votedPower)
+					addWeightedVotes(results, vote.WeightedOptions(), votedPower)
 					*totalVotedPower = totalVotedPower.Add(votedPower)
 				}
 			}
@@ -78,7 +79,7 @@ func tallyValidatorVotes(
 	for key, val := range currValidators {
 		// calculate all vote power of current validators including delegated for voterPowerRate
 		*totalPower = totalPower.Add(val.DelegatorShares)
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -87,11 +88,18 @@ func tallyValidatorVotes(
 			// calculate vote power of validator after deduction for voterPowerRate
 			*voterPower = voterPower.Add(valValidVotedPower)
 		}
-		results[val.Vote] = results[val.Vote].Add(valValidVotedPower)
+		addWeightedVotes(results, val.Vote, valValidVotedPower)
 		*totalVotedPower = totalVotedPower.Add(valValidVotedPower)
 	}
 }
 
+// addWeightedVotes splits votedPower across the weighted options of a vote
+func addWeightedVotes(results map[types.VoteOption]sdk.Dec, options types.WeightedVoteOptions, votedPower sdk.Dec) {
+	for _, option := range options {
+		results[option.Option] = results[option.Option].Add(votedPower.Mul(option.Weight))
+	}
+}
+
 func preTally(
 	ctx sdk.Context, keeper Keeper, proposal types.Proposal, voteP *types.Vote,
 ) (results map[types.VoteOption]sdk.Dec, totalVotedPower sdk.Dec, voterPowerRate sdk.Dec) {
@@ -113,7 +121,7 @@ func preTally(
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
@@ -134,9 +142,14 @@ func preTally(
 
 // tally and return status before voting period end time
 func tallyStatusInVotePeriod(
-	ctx sdk.Context, keeper Keeper, tallyResults types.TallyResult,
+	ctx sdk.Context, keeper Keeper, tallyResults types.TallyResult, isExpedited bool,
 ) (types.ProposalStatus, bool) {
 	tallyParams := keeper.GetTallyParams(ctx)
+	if isExpedited {
+		// an expedited proposal can't pass before its voting period ends with less Yes than its threshold,
+		// while it is rejected as early as a regular proposal
+		tallyParams.YesInVotePeriod = sdk.MaxDec(tallyParams.YesInVotePeriod, keeper.GetExpeditedParams(ctx).Threshold)
+	}
 	totalPower := tallyResults.TotalPower
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
@@ -166,9 +179,12 @@ func tallyStatusInVotePeriod(
 
 // tally and return status expire voting period end time
 func tallyStatusExpireVotePeriod(
-	ctx sdk.Context, keeper Keeper, tallyResults types.TallyResult,
+	ctx sdk.Context, keeper Keeper, tallyResults types.TallyResult, isExpedited bool,
 ) (types.ProposalStatus, bool) {
 	tallyParams := keeper.GetTallyParams(ctx)
+	if isExpedited {
+		tallyParams.Threshold = keeper.GetExpeditedParams(ctx).Threshold
+	}
 	totalVoted := tallyResults.TotalVotedPower
 	totalPower := tallyResults.TotalPower
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudo code.
@@ -206,10 +222,12 @@ func Tally(ctx sdk.Context, keeper Keeper, proposal types.Proposal, isExpireVote
 	tallyResults.TotalPower = keeper.TotalPower(ctx)
 	tallyResults.TotalVotedPower = totalVotedPower
 
+	// the expedited params only apply from venus8 on
+	isExpedited := proposal.IsExpedited && tmtypes.HigherThanVenus8(ctx.BlockHeight())
 	if isExpireVoteEndTime {
-		status, distribute := tallyStatusExpireVotePeriod(ctx, keeper, tallyResults)
+		status, distribute := tallyStatusExpireVotePeriod(ctx, keeper, tallyResults, isExpedited)
 		return status, distribute, tallyResults
 	}
-	status, distribute := tallyStatusInVotePeriod(ctx, keeper, tallyResults)
+	status, distribute := tallyStatusInVotePeriod(ctx, keeper, tallyResults, isExpedited)
 	return status, distribute, tallyResults
 }
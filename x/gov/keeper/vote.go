@@ -10,22 +10,35 @@ import (
 func (keeper Keeper) AddVote(
 	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption,
 ) (sdk.Error, string) {
-	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	return keeper.addVote(ctx, types.NewVote(proposalID, voterAddr, option), option.String())
+}
+
+// AddWeightedVote adds a vote on a specific proposal, splitting the voting power
+// of the voter across weighted options
+func (keeper Keeper) AddWeightedVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
+) (sdk.Error, string) {
+	if !types.ValidWeightedVoteOptions(options) {
+		return types.ErrInvalidWeightedVote(options), ""
+	}
+
+	return keeper.addVote(ctx, types.NewWeightedVote(proposalID, voterAddr, options), options.String())
+}
+
+func (keeper Keeper) addVote(ctx sdk.Context, vote types.Vote, optionStr string) (sdk.Error, string) {
+	proposal, ok := keeper.GetProposal(ctx, vote.ProposalID)
 	if !ok {
-		return types.ErrUnknownProposal(proposalID), ""
+		return types.ErrUnknownProposal(vote.ProposalID), ""
 	}
 	if proposal.Status != types.StatusVotingPeriod {
 		return types.ErrInvalidateProposalStatus(), ""
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(option), ""
+	if len(vote.Options) == 0 && !types.ValidVoteOption(vote.Option) {
+		return types.ErrInvalidVote(vote.Option), ""
 	}
 
 	voteFeeStr := ""
-	vote := types.Vote{
-		ProposalID: proposalID, Voter: voterAddr, Option: option,
-	}
 	if keeper.ProposalHandlerRouter().HasRoute(proposal.ProposalRoute()) {
 		var err sdk.Error
 		voteFeeStr, err = keeper.ProposalHandlerRouter().GetRoute(proposal.ProposalRoute()).VoteHandler(ctx, proposal, vote)
@@ -34,13 +47,13 @@ func (keeper Keeper) AddVote(
 		}
 	}
 
-	keeper.SetVote(ctx, proposalID, vote)
+	keeper.SetVote(ctx, vote.ProposalID, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, optionStr),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalID)),
 		),
 	)
 
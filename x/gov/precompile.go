@@ -0,0 +1,88 @@
+package gov
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+	"github.com/okx/okbchain/x/gov/types"
+)
+
+const (
+	PrecompileVote    = "vote"
+	PrecompileDeposit = "deposit"
+)
+
+var (
+	PrecompileABI evmtypes.ABI
+
+	//go:embed precompile.json
+	precompileJson []byte
+
+	precompileGas = map[string]uint64{
+		PrecompileVote:    10000,
+		PrecompileDeposit: 20000,
+	}
+)
+
+func init() {
+	ret, err := abi.JSON(bytes.NewReader(precompileJson))
+	if err != nil {
+		panic(err)
+	}
+	PrecompileABI = evmtypes.ABI{ABI: &ret}
+}
+
+var _ evmtypes.Precompile = Precompile{}
+
+// Precompile lets evm contracts vote on and deposit to the proposals
+type Precompile struct {
+	handler sdk.Handler
+}
+
+// NewPrecompile creates a new gov precompile
+func NewPrecompile(keeper Keeper) Precompile {
+	return Precompile{handler: NewHandler(keeper)}
+}
+
+// ABI returns the abi of the gov precompile
+func (p Precompile) ABI() evmtypes.ABI {
+	return PrecompileABI
+}
+
+// RequiredGas returns the gas charged before the gov method is run
+func (p Precompile) RequiredGas(method *abi.Method) uint64 {
+	return precompileGas[method.Name]
+}
+
+// Run executes the gov message built from the args with the caller as the voter or the depositor
+func (p Precompile) Run(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}) ([]byte, error) {
+	addr := sdk.AccAddress(caller.Bytes())
+
+	var msg sdk.Msg
+	switch method.Name {
+	case PrecompileVote:
+		msg = types.NewMsgVote(addr, args[0].(uint64), types.VoteOption(args[1].(uint8)))
+	case PrecompileDeposit:
+		amount := sdk.NewDecFromBigIntWithPrec(args[1].(*big.Int), sdk.Precision)
+		msg = types.NewMsgDeposit(addr, args[0].(uint64), sdk.SysCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, amount)})
+	default:
+		return nil, fmt.Errorf("gov precompile: unknown method %s", method.Name)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	res, err := p.handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(res.Events)
+
+	return method.Outputs.Pack(true)
+}
@@ -16,6 +16,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, system.Chain+"/gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, system.Chain+"/gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, system.Chain+"/gov/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, system.Chain+"/gov/MsgVoteWeighted", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, system.Chain+"/gov/TextProposal", nil)
 	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, system.Chain+"/gov/SoftwareUpgradeProposal", nil)
@@ -24,6 +24,7 @@ const (
 	CodeInvalidHeight            uint32 = BaseGovError + 10
 	CodeInvalidCoins             uint32 = BaseGovError + 11
 	CodeUnknownParamType         uint32 = BaseGovError + 12
+	CodeInvalidWeightedVote      uint32 = BaseGovError + 13
 )
 
 func ErrInvalidAddress(address string) sdk.Error {
@@ -46,6 +47,10 @@ func ErrInvalidVote(voteOption VoteOption) sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+func ErrInvalidWeightedVote(options WeightedVoteOptions) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidWeightedVote, fmt.Sprintf("'%s' are not valid weighted voting options", options))
+}
+
 func ErrInvalidGenesis() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeInvalidGenesis, "initial proposal ID hasn't been set")
 }
@@ -21,4 +21,6 @@ const (
 	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // converted to regular proposal
 )
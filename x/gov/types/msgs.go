@@ -10,20 +10,30 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
-	InitialDeposit sdk.SysCoins   `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit sdk.SysCoins   `json:"initial_deposit" yaml:"initial_deposit"`     //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`                   //  Address of the proposer
+	IsExpedited    bool           `json:"is_expedited,omitempty" yaml:"is_expedited"` //  Whether the proposal is expedited
 }
 
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.SysCoins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
+}
+
+// NewMsgSubmitExpeditedProposal creates a MsgSubmitProposal of an expedited proposal, which needs a higher
+// deposit and threshold to pass in a shorter voting period
+func NewMsgSubmitExpeditedProposal(content Content, initialDeposit sdk.SysCoins, proposer sdk.AccAddress) MsgSubmitProposal {
+	msg := NewMsgSubmitProposal(content, initialDeposit, proposer)
+	msg.IsExpedited = true
+	return msg
 }
 
 //nolint
@@ -67,7 +77,8 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.IsExpedited)
 }
 
 // Implements Msg.
@@ -176,3 +187,49 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options chosen by the voter
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(msg.Options)
+	}
+
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
@@ -13,6 +13,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyExpeditedParams = []byte("expeditedparams")
 )
 
 // Key declaration for parameters
@@ -22,6 +24,7 @@ func ParamKeyTable() subspace.KeyTable {
 			{ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams},
 			{ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams},
 			{ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams},
+			{ParamStoreKeyExpeditedParams, ExpeditedParams{}, validateExpeditedParams},
 		}...,
 	)
 }
@@ -150,23 +153,103 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
+// Param around expedited proposals in governance
+type ExpeditedParams struct {
+	MinDeposit   sdk.SysCoins  `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`     //  Minimum deposit for an expedited proposal to enter voting period.
+	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period of an expedited proposal.
+	Threshold    sdk.Dec       `json:"threshold,omitempty" yaml:"threshold,omitempty"`         //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
+}
+
+// NewExpeditedParams creates a new ExpeditedParams object
+func NewExpeditedParams(minDeposit sdk.SysCoins, votingPeriod time.Duration, threshold sdk.Dec) ExpeditedParams {
+	return ExpeditedParams{
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Threshold:    threshold,
+	}
+}
+
+// DefaultExpeditedParams returns the ExpeditedParams used until they are set, the chains started before
+// the expedited proposals are introduced have no ExpeditedParams in their param store
+func DefaultExpeditedParams() ExpeditedParams {
+	return ExpeditedParams{
+		MinDeposit:   sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(500))},
+		VotingPeriod: time.Hour * 24,
+		Threshold:    sdk.NewDecWithPrec(667, 3),
+	}
+}
+
+func (ep ExpeditedParams) String() string {
+	return fmt.Sprintf(`Expedited Params:
+  Min Deposit:        %s
+  Voting Period:      %s
+  Threshold:          %s`, ep.MinDeposit, ep.VotingPeriod, ep.Threshold)
+}
+
+// ValidateWith checks the expedited params are stricter than the params of the regular proposals
+func (ep ExpeditedParams) ValidateWith(dp DepositParams, vp VotingParams, tp TallyParams) error {
+	if !ep.MinDeposit.IsAllGT(dp.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit %s must be greater than minimum deposit %s",
+			ep.MinDeposit, dp.MinDeposit)
+	}
+	if ep.VotingPeriod >= vp.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than voting period %s",
+			ep.VotingPeriod, vp.VotingPeriod)
+	}
+	if ep.Threshold.LTE(tp.Threshold) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than vote threshold %s",
+			ep.Threshold, tp.Threshold)
+	}
+	return nil
+}
+
+// Validate checks the expedited params are within valid ranges
+func (ep ExpeditedParams) Validate() error {
+	if !ep.MinDeposit.IsValid() || ep.MinDeposit.Empty() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", ep.MinDeposit)
+	}
+	if ep.VotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", ep.VotingPeriod)
+	}
+	if ep.Threshold.IsNil() || !ep.Threshold.IsPositive() {
+		return fmt.Errorf("expedited vote threshold must be positive: %s", ep.Threshold)
+	}
+	if ep.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", ep.Threshold)
+	}
+
+	return nil
+}
+
+func validateExpeditedParams(i interface{}) error {
+	v, ok := i.(ExpeditedParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+	VotingParams    VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams     TallyParams     `json:"tally_params" yaml:"tally_params"`
+	DepositParams   DepositParams   `json:"deposit_params" yaml:"deposit_parmas"`
+	ExpeditedParams ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
 		gp.TallyParams.String() + "\n" +
-		gp.DepositParams.String()
+		gp.DepositParams.String() + "\n" +
+		gp.ExpeditedParams.String()
 }
 
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, ep ExpeditedParams) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:    vp,
+		DepositParams:   dp,
+		TallyParams:     tp,
+		ExpeditedParams: ep,
 	}
 }
@@ -24,6 +24,8 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	IsExpedited bool `json:"is_expedited,omitempty" yaml:"is_expedited,omitempty"` // Whether the proposal is expedited, it's converted to a regular proposal if it fails
 }
 
 func NewProposal(ctx sdk.Context, totalVoting sdk.Dec, content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
@@ -49,10 +51,11 @@ func (p Proposal) String() string {
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.IsExpedited, p.GetDescription(),
 	)
 }
 
@@ -82,6 +85,7 @@ func WrapProposalForCosmosAPI(proposal Proposal, content Content) Proposal {
 		TotalDeposit:     proposal.TotalDeposit,
 		VotingStartTime:  proposal.VotingStartTime,
 		VotingEndTime:    proposal.VotingEndTime,
+		IsExpedited:      proposal.IsExpedited,
 	}
 }
 
@@ -15,9 +15,10 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
-	ParamDeposit  = "deposit"
-	ParamVoting   = "voting"
-	ParamTallying = "tallying"
+	ParamDeposit   = "deposit"
+	ParamVoting    = "voting"
+	ParamTallying  = "tallying"
+	ParamExpedited = "expedited"
 )
 
 // Params for queries:
@@ -3,23 +3,42 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`             //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                         //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`                       //  option from OptionSet chosen by the voter
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"` //  weighted options of a split vote, Option is empty then
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power of voter across options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalID: proposalID, Voter: voter, Options: options}
+}
+
+// WeightedOptions returns the weighted options of the vote. A vote with a single
+// option is a weighted vote giving the whole voting power to that option.
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) != 0 {
+		return v.Options
+	}
+	return NewNonSplitVoteOption(v.Option)
 }
 
 func (v Vote) String() string {
+	if len(v.Options) != 0 {
+		return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
+	}
 	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
 }
 
@@ -32,6 +51,10 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
+		if len(vot.Options) != 0 {
+			out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
+			continue
+		}
 		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
 	}
 	return out
@@ -41,7 +64,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -93,6 +117,100 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// WeightedVoteOption defines the share of voting power given to a vote option
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions is the split of voting power across vote options
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns WeightedVoteOptions giving the whole voting power to option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: sdk.OneDec()}}
+}
+
+func (v WeightedVoteOptions) String() string {
+	out := make([]string, len(v))
+	for i, option := range v {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Equals returns whether two WeightedVoteOptions are equal.
+func (v WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(v) != len(comp) {
+		return false
+	}
+	for i := range v {
+		if v[i].Option != comp[i].Option || !v[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidWeightedVoteOptions returns true if the options are valid, have positive
+// weights, don't repeat and their weights sum up to 1.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || usedOptions[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return false
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+	return totalWeight.Equal(sdk.OneDec())
+}
+
+// WeightedVoteOptionsFromString returns WeightedVoteOptions from a string of
+// comma separated <option>=<weight> pairs, e.g. "Yes=0.6,No=0.4". A single
+// option without weight gets the whole voting power.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, optionStr := range strings.Split(strings.TrimSpace(str), ",") {
+		fields := strings.Split(strings.TrimSpace(optionStr), "=")
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight := sdk.OneDec()
+		switch len(fields) {
+		case 1:
+		case 2:
+			weight, err = sdk.NewDecFromStr(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("'%s' is not a valid vote weight: %s", fields[1], err)
+			}
+		default:
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", optionStr)
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
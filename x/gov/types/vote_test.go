@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,No=0.3,NoWithVeto=0.1")
+	require.NoError(t, err)
+	require.True(t, ValidWeightedVoteOptions(options))
+	require.Equal(t, "Yes=0.600000000000000000,No=0.300000000000000000,NoWithVeto=0.100000000000000000", options.String())
+
+	options, err = WeightedVoteOptionsFromString("Abstain")
+	require.NoError(t, err)
+	require.True(t, NewNonSplitVoteOption(OptionAbstain).Equals(options))
+
+	_, err = WeightedVoteOptionsFromString("Yes=0.6,Maybe=0.4")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=half")
+	require.Error(t, err)
+}
+
+func TestValidWeightedVoteOptions(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	require.False(t, ValidWeightedVoteOptions(nil))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionYes, half}}))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionYes, half}, {OptionYes, half}}))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionEmpty, half}, {OptionYes, half}}))
+	require.False(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionYes, sdk.NewDec(2)}, {OptionNo, sdk.NewDec(-1)}}))
+	require.True(t, ValidWeightedVoteOptions(WeightedVoteOptions{{OptionYes, half}, {OptionNo, half}}))
+}
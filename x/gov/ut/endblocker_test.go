@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/gov"
 	"github.com/okx/okbchain/x/gov/types"
 	"github.com/okx/okbchain/x/params"
@@ -237,3 +238,153 @@ func TestEndBlockerIterateWaitingProposalsQueue(t *testing.T) {
 	require.False(t, waitingQueue.Valid())
 	waitingQueue.Close()
 }
+
+func createTestValidators(t *testing.T, ctx sdk.Context, sk staking.Keeper) {
+	ctx.SetBlockHeight(int64(sk.GetEpoch(ctx)))
+	skHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:4]))
+	for i, addr := range Addrs[:4] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, skHandler, ctx, valAddrs, []int64{10, 10, 10, 10})
+	staking.EndBlocker(ctx, sk)
+}
+
+func newExpeditedTextProposal(t *testing.T, ctx sdk.Context, gk gov.Keeper, initialDeposit sdk.SysCoins,
+	govHandler sdk.Handler) uint64 {
+	content := types.NewTextProposal("Test", "description")
+	newProposalMsg := types.NewMsgSubmitExpeditedProposal(content, initialDeposit, Addrs[0])
+	res, err := govHandler(ctx, newProposalMsg)
+	require.Nil(t, err)
+	var proposalID uint64
+	gk.Cdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+	return proposalID
+}
+
+func TestExpeditedProposalBeforeVenus8(t *testing.T) {
+	ctx, _, gk, _, _ := CreateTestInput(t, false, 100000)
+	govHandler := gov.NewHandler(gk)
+
+	content := types.NewTextProposal("Test", "description")
+	newProposalMsg := types.NewMsgSubmitExpeditedProposal(content, gk.GetExpeditedParams(ctx).MinDeposit, Addrs[0])
+	_, err := govHandler(ctx, newProposalMsg)
+	require.NotNil(t, err)
+}
+
+func TestExpeditedProposalDeposit(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	ctx, _, gk, _, _ := CreateTestInput(t, false, 100000)
+	govHandler := gov.NewHandler(gk)
+
+	// the min deposit of regular proposals is not enough for expedited proposals
+	proposalID := newExpeditedTextProposal(t, ctx, gk,
+		sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 150)}, govHandler)
+	proposal, ok := gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.IsExpedited)
+	require.Equal(t, gov.StatusDepositPeriod, proposal.Status)
+
+	proposalID = newExpeditedTextProposal(t, ctx, gk, gk.GetExpeditedParams(ctx).MinDeposit, govHandler)
+	proposal, ok = gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, ctx.BlockHeader().Time.Add(gk.GetExpeditedParams(ctx).VotingPeriod), proposal.VotingEndTime)
+}
+
+func TestExpeditedProposalPassedInVotingPeriod(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	ctx, _, gk, sk, _ := CreateTestInput(t, false, 100000)
+	govHandler := gov.NewHandler(gk)
+	createTestValidators(t, ctx, sk)
+	proposalID := newExpeditedTextProposal(t, ctx, gk, gk.GetExpeditedParams(ctx).MinDeposit, govHandler)
+
+	// 2/3 Yes of total power is not enough for the expedited threshold
+	for _, addr := range Addrs[:2] {
+		_, err := govHandler(ctx, gov.NewMsgVote(addr, proposalID, types.OptionYes))
+		require.Nil(t, err)
+	}
+	proposal, ok := gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+
+	_, err := govHandler(ctx, gov.NewMsgVote(Addrs[2], proposalID, types.OptionYes))
+	require.Nil(t, err)
+	proposal, ok = gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+}
+
+func TestFailedExpeditedProposalConverted(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	ctx, _, gk, sk, _ := CreateTestInput(t, false, 100000)
+	govHandler := gov.NewHandler(gk)
+	createTestValidators(t, ctx, sk)
+	proposalID := newExpeditedTextProposal(t, ctx, gk, gk.GetExpeditedParams(ctx).MinDeposit, govHandler)
+	votingStartTime := ctx.BlockHeader().Time
+
+	// 2/3 Yes of the voters passes a regular proposal, but not an expedited one
+	for i, option := range []types.VoteOption{types.OptionYes, types.OptionYes, types.OptionNo} {
+		_, err := govHandler(ctx, gov.NewMsgVote(Addrs[i], proposalID, option))
+		require.Nil(t, err)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = votingStartTime.Add(gk.GetExpeditedParams(ctx).VotingPeriod)
+	ctx.SetBlockHeader(newHeader)
+	gov.EndBlocker(ctx, gk)
+
+	proposal, ok := gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.False(t, proposal.IsExpedited)
+	require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, votingStartTime.Add(gk.GetVotingPeriod(ctx, nil)), proposal.VotingEndTime)
+	require.Equal(t, 3, len(gk.GetVotes(ctx, proposalID)))
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx.SetBlockHeader(newHeader)
+	gov.EndBlocker(ctx, gk)
+
+	proposal, ok = gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+	activeQueue := gk.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func TestExpeditedParamsChangeProposal(t *testing.T) {
+	ctx, _, gk, sk, _ := CreateTestInput(t, false, 100000)
+	govHandler := gov.NewHandler(gk)
+	createTestValidators(t, ctx, sk)
+
+	initialDeposit := sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 150)}
+	height := uint64(ctx.BlockHeight() + 1000)
+	submit := func(ep types.ExpeditedParams) error {
+		paramsChanges := []params.ParamChange{{Subspace: types.DefaultParamspace,
+			Key: string(types.ParamStoreKeyExpeditedParams), Value: string(gk.Cdc().MustMarshalJSON(ep))}}
+		content := paramsTypes.NewParameterChangeProposal("Test", "", paramsChanges, height)
+		_, err := govHandler(ctx, gov.NewMsgSubmitProposal(content, initialDeposit, Addrs[0]))
+		return err
+	}
+
+	ep := gk.GetExpeditedParams(ctx)
+	ep.VotingPeriod = ep.VotingPeriod / 2
+	require.Nil(t, submit(ep))
+
+	// the expedited params must stay stricter than the params of the regular proposals
+	ep = gk.GetExpeditedParams(ctx)
+	ep.VotingPeriod = gk.GetVotingPeriod(ctx, nil)
+	require.NotNil(t, submit(ep))
+
+	ep = gk.GetExpeditedParams(ctx)
+	ep.MinDeposit = gk.GetDepositParams(ctx).MinDeposit
+	require.NotNil(t, submit(ep))
+
+	ep = gk.GetExpeditedParams(ctx)
+	ep.Threshold = gk.GetTallyParams(ctx).Threshold
+	require.NotNil(t, submit(ep))
+}
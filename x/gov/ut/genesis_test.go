@@ -179,6 +179,19 @@ func TestValidateGenesis(t *testing.T) {
 	require.Nil(t, err)
 	data.DepositParams.MinDeposit = sdk.SysCoins{sdk.SysCoin{Denom: sdk.DefaultBondDenom, Amount: coin}}
 	require.NotNil(t, gov.ValidateGenesis(data))
+
+	data = gov.DefaultGenesisState()
+	require.Nil(t, gov.ValidateGenesis(data))
+	data.ExpeditedParams.VotingPeriod = data.VotingParams.VotingPeriod
+	require.NotNil(t, gov.ValidateGenesis(data))
+
+	data.ExpeditedParams.VotingPeriod = time.Hour * 24
+	data.ExpeditedParams.Threshold = data.TallyParams.Threshold
+	require.NotNil(t, gov.ValidateGenesis(data))
+
+	data.ExpeditedParams.Threshold = sdk.NewDecWithPrec(667, 3)
+	data.ExpeditedParams.MinDeposit = data.DepositParams.MinDeposit
+	require.NotNil(t, gov.ValidateGenesis(data))
 }
 
 func TestGenesisState_Equal(t *testing.T) {
@@ -199,6 +212,11 @@ func TestGenesisState_Equal(t *testing.T) {
 			Veto:            sdk.NewDecWithPrec(334, 3),
 			YesInVotePeriod: sdk.NewDecWithPrec(667, 3),
 		},
+		ExpeditedParams: types.ExpeditedParams{
+			MinDeposit:   sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(500))},
+			VotingPeriod: time.Hour * 24,
+			Threshold:    sdk.NewDecWithPrec(667, 3),
+		},
 	}
 	require.True(t, expected.Equal(gov.DefaultGenesisState()))
 }
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/okx/okbchain/libs/tendermint/libs/cli/flags"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/gov"
 	"github.com/okx/okbchain/x/gov/types"
 	"github.com/okx/okbchain/x/staking"
@@ -75,6 +76,41 @@ func TestHandleMsgVote(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestHandleMsgVoteWeighted(t *testing.T) {
+	ctx, _, gk, _, _ := CreateTestInput(t, false, 1000)
+	govHandler := gov.NewHandler(gk)
+
+	proposalCoins := sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 500)}
+	content := types.NewTextProposal("Test", "description")
+	newProposalMsg := gov.NewMsgSubmitProposal(content, proposalCoins, Addrs[0])
+	res, err := govHandler(ctx, newProposalMsg)
+	require.Nil(t, err)
+	var proposalID uint64
+	gk.Cdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+
+	options, err := types.WeightedVoteOptionsFromString("Yes=0.7,Abstain=0.3")
+	require.Nil(t, err)
+	newVoteMsg := gov.NewMsgVoteWeighted(Addrs[4], proposalID, options)
+	require.Nil(t, newVoteMsg.ValidateBasic())
+	// weighted vote is not supported before venus8
+	_, err = govHandler(ctx, newVoteMsg)
+	require.NotNil(t, err)
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	_, err = govHandler(ctx, newVoteMsg)
+	require.Nil(t, err)
+
+	newVoteMsg = gov.NewMsgVoteWeighted(Addrs[4], 0, options)
+	_, err = govHandler(ctx, newVoteMsg)
+	require.NotNil(t, err)
+
+	newVoteMsg = gov.NewMsgVoteWeighted(Addrs[4], proposalID, options[:1])
+	require.NotNil(t, newVoteMsg.ValidateBasic())
+	_, err = govHandler(ctx, newVoteMsg)
+	require.NotNil(t, err)
+}
+
 func TestHandleMsgVote2(t *testing.T) {
 	ctx, _, gk, sk, _ := CreateTestInput(t, false, 100000)
 	govHandler := gov.NewHandler(gk)
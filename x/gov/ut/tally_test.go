@@ -313,3 +313,55 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInput(t, false, 100000)
+	ctx.SetBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx.SetBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	coin, err := sdk.ParseDecCoin("1.0" + common.NativeToken)
+	require.Nil(t, err)
+	delegator1Msg := staking.NewMsgDeposit(Addrs[3], coin)
+	stakingHandler(ctx, delegator1Msg)
+
+	addSharesMsg := staking.NewMsgAddShares(Addrs[3], []sdk.ValAddress{sdk.ValAddress(Addrs[2])})
+	stakingHandler(ctx, addSharesMsg)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := k.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	k.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = k.AddVote(ctx, proposalID, Addrs[0], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = k.AddVote(ctx, proposalID, Addrs[1], types.OptionYes)
+	require.Nil(t, err)
+	valOptions, err := types.WeightedVoteOptionsFromString("Yes=0.5,No=0.5")
+	require.Nil(t, err)
+	err, _ = k.AddWeightedVote(ctx, proposalID, Addrs[2], valOptions)
+	require.Nil(t, err)
+	delOptions, err := types.WeightedVoteOptionsFromString("No=0.6,Abstain=0.4")
+	require.Nil(t, err)
+	err, _ = k.AddWeightedVote(ctx, proposalID, Addrs[3], delOptions)
+	require.Nil(t, err)
+
+	// the delegator's voting power is deducted from its validator's, and both
+	// are split across their weighted options
+	//  yes: 1 + 1 + 0.5
+	//  no: 0.5 + 0.6
+	//  abstain: 0.4
+	expectedTallyResult := newTallyResult(t, "4", "2.5", "0.4", "1.1", "0.0", "4")
+	status, dist, tallyResults := keeper.Tally(ctx, k, proposal, true)
+	require.False(t, dist)
+	require.Equal(t, types.StatusPassed, status)
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
@@ -198,7 +198,7 @@ func CreateTestInput(
 	govRouter.AddRoute(types.RouterKey, types.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(&pk))
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
-	govProposalHandlerRouter.AddRoute(params.RouterKey, pk)
+	govProposalHandlerRouter.AddRoute(params.RouterKey, &pk)
 	keeper := keeper.NewKeeper(cdc, keyGov, pk, govSubspace, supplyKeeper, stakingKeeper,
 		types.DefaultCodespace, govRouter, bk, govProposalHandlerRouter, auth.FeeCollectorName)
 	pk.SetGovKeeper(keeper)
@@ -236,6 +236,7 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(types.MsgSubmitProposal{}, "test/gov/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(types.MsgDeposit{}, "test/gov/MsgDeposit", nil)
 	cdc.RegisterConcrete(types.MsgVote{}, "test/gov/MsgVote", nil)
+	cdc.RegisterConcrete(types.MsgVoteWeighted{}, "test/gov/MsgVoteWeighted", nil)
 
 	cdc.RegisterInterface((*types.Content)(nil), nil)
 	cdc.RegisterConcrete(types.TextProposal{}, "test/gov/TextProposal", nil)
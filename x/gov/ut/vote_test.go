@@ -18,6 +18,10 @@ func TestKeeper_AddVote(t *testing.T) {
 	require.NotNil(t, err)
 	require.Equal(t, "", votefee)
 
+	// the proposal is checked before the vote option
+	err, _ = keeper.AddVote(ctx, 0, Addrs[0], types.OptionEmpty)
+	require.Equal(t, types.ErrUnknownProposal(0).Error(), err.Error())
+
 	content := types.NewTextProposal("Test", "description")
 	proposal, err := keeper.SubmitProposal(ctx, content)
 	require.Nil(t, err)
@@ -61,6 +65,55 @@ func TestKeeper_AddVote(t *testing.T) {
 	require.Equal(t, expectedVote, vote)
 }
 
+func TestKeeper_AddWeightedVote(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// weights not summing up to 1
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.NotNil(t, err)
+
+	// repeated option
+	options = types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+	}
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.NotNil(t, err)
+
+	options = types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	}
+	err, votefee := keeper.AddWeightedVote(ctx, proposalID, Addrs[0], options)
+	require.Nil(t, err)
+	require.Equal(t, "", votefee)
+
+	vote, ok := keeper.GetVote(ctx, proposalID, Addrs[0])
+	require.True(t, ok)
+	require.True(t, vote.Equals(types.NewWeightedVote(proposalID, Addrs[0], options)))
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, options.Equals(vote.WeightedOptions()))
+
+	// a plain vote replaces the weighted one
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionNo)
+	require.Nil(t, err)
+	vote, ok = keeper.GetVote(ctx, proposalID, Addrs[0])
+	require.True(t, ok)
+	require.Empty(t, vote.Options)
+	require.True(t, types.NewNonSplitVoteOption(types.OptionNo).Equals(vote.WeightedOptions()))
+}
+
 func TestKeeper_GetVote(t *testing.T) {
 	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
 
@@ -18,4 +18,5 @@ type StakingKeeper interface {
 type GovKeeper interface {
 	InsertWaitingProposalQueue(ctx sdk.Context, blockHeight, proposalID uint64)
 	RemoveFromWaitingProposalQueue(ctx sdk.Context, blockHeight, proposalID uint64)
+	ValidateParams(ctx sdk.Context) error
 }
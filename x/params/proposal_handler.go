@@ -41,6 +41,7 @@ func handleParameterChangeProposal(ctx sdk.Context, k *Keeper, proposal *govtype
 
 func changeParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChangeProposal) sdk.Error {
 	defer k.signalUpdate()
+	govChanged := false
 	for _, c := range paramProposal.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
@@ -51,6 +52,14 @@ func changeParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChang
 		if err != nil {
 			return sdkerrors.Wrap(sdkparams.ErrSettingParameter, err.Error())
 		}
+		govChanged = govChanged || c.Subspace == govtypes.DefaultParamspace
+	}
+
+	// the gov params are validated against each other once all the changes are applied
+	if govChanged {
+		if err := k.gk.ValidateParams(ctx); err != nil {
+			return sdkerrors.Wrap(sdkparams.ErrSettingParameter, err.Error())
+		}
 	}
 	return nil
 }
@@ -75,6 +75,10 @@ func (gk *mockGovKeeper) InsertWaitingProposalQueue(_ sdk.Context, blockHeight,
 	gk.waitQueue = append(gk.waitQueue, waitPair{height: blockHeight, proposalID: proposalID})
 }
 
+func (gk *mockGovKeeper) ValidateParams(_ sdk.Context) error {
+	return nil
+}
+
 func (gk *mockGovKeeper) RemoveFromWaitingProposalQueue(_ sdk.Context, blockHeight, proposalID uint64) {
 	delIndex := -1
 	for i, pair := range gk.waitQueue {
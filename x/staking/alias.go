@@ -13,15 +13,16 @@ import (
 )
 
 const (
-	DefaultParamspace = keeper.DefaultParamspace
-	ModuleName        = types.ModuleName
-	StoreKey          = types.StoreKey
-	TStoreKey         = types.TStoreKey
-	QuerierRoute      = types.QuerierRoute
-	RouterKey         = types.RouterKey
-	NotBondedPoolName = types.NotBondedPoolName
-	BondedPoolName    = types.BondedPoolName
-	QueryParameters   = types.QueryParameters
+	DefaultParamspace        = keeper.DefaultParamspace
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	TStoreKey                = types.TStoreKey
+	QuerierRoute             = types.QuerierRoute
+	RouterKey                = types.RouterKey
+	NotBondedPoolName        = types.NotBondedPoolName
+	BondedPoolName           = types.BondedPoolName
+	TokenizedDepositPoolName = types.TokenizedDepositPoolName
+	QueryParameters          = types.QueryParameters
 )
 
 var (
@@ -42,6 +43,9 @@ var (
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDeposit                      = types.NewMsgDeposit
 	NewMsgWithdraw                     = types.NewMsgWithdraw
+	NewMsgCancelWithdraw               = types.NewMsgCancelWithdraw
+	NewMsgTokenizeDeposit              = types.NewMsgTokenizeDeposit
+	NewMsgRedeemTokenizedDeposit       = types.NewMsgRedeemTokenizedDeposit
 	DefaultParams                      = types.DefaultParams
 	DefaultDposParams                  = types.DefaultDposParams
 	NewValidator                       = types.NewValidator
@@ -43,6 +43,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 			GetCmdEditValidatorCommissionRate(cdc),
 			GetCmdDeposit(cdc),
 			GetCmdWithdraw(cdc),
+			GetCmdCancelWithdraw(cdc),
+			GetCmdTokenizeDeposit(cdc),
+			GetCmdRedeemTokenizedDeposit(cdc),
 			GetCmdAddShares(cdc),
 			GetCmdDepositMinSelfDelegation(cdc),
 		)...)
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okx/okbchain/libs/cosmos-sdk/client/context"
+	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/libs/cosmos-sdk/version"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
+	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okx/okbchain/x/staking/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdTokenizeDeposit gets command for tokenizing the deposit
+func GetCmdTokenizeDeposit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "tokenize [amount]",
+		Args: cobra.ExactArgs(1),
+		Short: fmt.Sprintf("turn an amount of %s in the deposit into transferable %s", sdk.DefaultBondDenom,
+			types.GetTokenizedDepositDenom(sdk.DefaultBondDenom)),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Turn an amount of %s in the deposit into the same amount of transferable %s, the corresponding
+shares are withdrawn from all validators. %s is an unstaked IOU of the deposit: it backs no shares, so it earns
+no rewards and carries no votes until it's redeemed into the deposit by anyone holding it.
+
+Example:
+$ %s tx staking tokenize 1%s --from mykey
+`,
+				sdk.DefaultBondDenom, types.GetTokenizedDepositDenom(sdk.DefaultBondDenom),
+				types.GetTokenizedDepositDenom(sdk.DefaultBondDenom), version.ClientName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgTokenizeDeposit(delAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdRedeemTokenizedDeposit gets command for redeeming the tokenized deposit
+func GetCmdRedeemTokenizedDeposit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: fmt.Sprintf("redeem an amount of %s into the deposit", types.GetTokenizedDepositDenom(sdk.DefaultBondDenom)),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of %s into the same amount of %s in the deposit, which adds shares to
+the validators voted last time and starts earning rewards again.
+
+Example:
+$ %s tx staking redeem 1%s --from mykey
+`,
+				types.GetTokenizedDepositDenom(sdk.DefaultBondDenom), sdk.DefaultBondDenom, version.ClientName,
+				types.GetTokenizedDepositDenom(sdk.DefaultBondDenom),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgRedeemTokenizedDeposit(delAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
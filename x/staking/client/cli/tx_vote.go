@@ -112,6 +112,40 @@ $ %s tx staking withdraw 1%s
 	return cmd
 }
 
+// GetCmdCancelWithdraw gets command for canceling the withdrawing
+func GetCmdCancelWithdraw(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-withdraw [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: fmt.Sprintf("move an amount of %s being withdrawn back into the deposit", sdk.DefaultBondDenom),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move an amount of %s being withdrawn back into the deposit before the unbonding completes.
+The shares are added back to the validators voted, or the validators voted before withdrawing all of the deposit.
+
+Example:
+$ %s tx staking cancel-withdraw 1%s
+`,
+				sdk.DefaultBondDenom, version.ClientName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgCancelWithdraw(delAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
 // GetCmdAddShares gets command for multi voting
 func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -46,6 +46,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	for _, proxyDelegatorKeyExported := range data.ProxyDelegatorKeys {
 		keeper.SetProxyBinding(ctx, proxyDelegatorKeyExported.ProxyAddr, proxyDelegatorKeyExported.DelAddr, false)
 	}
+	// the okb of the tokenized deposit is kept in the bonded pool
+	if !data.TokenizedDeposit.IsNil() && data.TokenizedDeposit.IsPositive() {
+		keeper.SetTotalTokenizedDeposit(ctx, data.TokenizedDeposit)
+		bondedTokens = bondedTokens.Add(data.TokenizedDeposit)
+	}
 
 	checkPools(ctx, keeper, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, bondedTokens),
 		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, notBondedTokens), data.Exported)
@@ -174,6 +179,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		UnbondingDelegations: undelegationInfos,
 		AllShares:            sharesExportedSlice,
 		ProxyDelegatorKeys:   proxyDelegatorKeys,
+		TokenizedDeposit:     keeper.GetTotalTokenizedDeposit(ctx),
 		Exported:             true,
 	}
 }
@@ -22,7 +22,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		if !k.ParamsEnableDposOp(ctx) && ctx.BlockHeight() != 0 {
 			switch msg.(type) {
 			case types.MsgCreateValidator, types.MsgEditValidatorCommissionRate,
-				types.MsgDeposit, types.MsgAddShares, types.MsgDepositMinSelfDelegation:
+				types.MsgDeposit, types.MsgAddShares, types.MsgDepositMinSelfDelegation,
+				types.MsgTokenizeDeposit, types.MsgRedeemTokenizedDeposit, types.MsgCancelWithdraw:
 				return nil, types.ErrDisableOperation
 			}
 		}
@@ -38,6 +39,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgDeposit(ctx, msg, k)
 		case types.MsgWithdraw:
 			return handleMsgWithdraw(ctx, msg, k)
+		case types.MsgCancelWithdraw:
+			return handleMsgCancelWithdraw(ctx, msg, k)
+		case types.MsgTokenizeDeposit:
+			return handleMsgTokenizeDeposit(ctx, msg, k)
+		case types.MsgRedeemTokenizedDeposit:
+			return handleMsgRedeemTokenizedDeposit(ctx, msg, k)
 		case types.MsgAddShares:
 			return handleMsgAddShares(ctx, msg, k)
 		case types.MsgDestroyValidator:
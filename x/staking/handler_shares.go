@@ -307,6 +307,25 @@ func handleMsgWithdraw(ctx sdk.Context, msg types.MsgWithdraw, k keeper.Keeper)
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgCancelWithdraw(ctx sdk.Context, msg types.MsgCancelWithdraw, k keeper.Keeper) (*sdk.Result, error) {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom().Result()
+	}
+
+	if err := k.CancelWithdraw(ctx, msg.DelegatorAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbond,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgDestroyValidator(ctx sdk.Context, msg types.MsgDestroyValidator, k keeper.Keeper) (*sdk.Result, error) {
 	valAddr := sdk.ValAddress(msg.DelAddr)
 	// 0.check to see if the validator which belongs to the delegator exists
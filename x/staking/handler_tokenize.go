@@ -0,0 +1,57 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/staking/keeper"
+	"github.com/okx/okbchain/x/staking/types"
+)
+
+func handleMsgTokenizeDeposit(ctx sdk.Context, msg types.MsgTokenizeDeposit, k keeper.Keeper) (*sdk.Result, error) {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		errMsg := fmt.Sprintf("tokenized deposit is not supported at height %d", ctx.BlockHeight())
+		return sdk.ErrUnknownRequest(errMsg).Result()
+	}
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom().Result()
+	}
+
+	tokenized, err := k.TokenizeDeposit(ctx, msg.DelegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeDeposit,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokenized.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRedeemTokenizedDeposit(ctx sdk.Context, msg types.MsgRedeemTokenizedDeposit, k keeper.Keeper) (*sdk.Result, error) {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		errMsg := fmt.Sprintf("tokenized deposit is not supported at height %d", ctx.BlockHeight())
+		return sdk.ErrUnknownRequest(errMsg).Result()
+	}
+	if msg.Amount.Denom != k.TokenizedDepositDenom(ctx) {
+		return ErrBadDenom().Result()
+	}
+
+	if err := k.RedeemTokenizedDeposit(ctx, msg.DelegatorAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemTokenizedDeposit,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,57 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	keep "github.com/okx/okbchain/x/staking/keeper"
+	"github.com/okx/okbchain/x/staking/types"
+)
+
+func TestHandleMsgTokenizeAndRedeemDeposit(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	keeper := mKeeper.Keeper
+	handler := NewHandler(keeper)
+	delAddr := keep.Addrs[0]
+	bondDenom := keeper.BondDenom(ctx)
+	tokenizedDenom := keeper.TokenizedDepositDenom(ctx)
+
+	_, err := handler(ctx, NewMsgDeposit(delAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100))))
+	require.NoError(t, err)
+
+	// not supported before venus8
+	_, err = handler(ctx, NewMsgTokenizeDeposit(delAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(40))))
+	require.Error(t, err)
+	_, err = handler(ctx, NewMsgRedeemTokenizedDeposit(delAddr, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(40))))
+	require.Error(t, err)
+	require.True(t, keeper.GetTotalTokenizedDeposit(ctx).IsZero())
+
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+
+	// tokenize with a wrong denom
+	_, err = handler(ctx, NewMsgTokenizeDeposit(delAddr, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(40))))
+	require.Error(t, err)
+
+	res, err := handler(ctx, NewMsgTokenizeDeposit(delAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(40))))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeTokenizeDeposit, res.Events[len(res.Events)-1].Type)
+	delegator, found := keeper.GetDelegator(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(60), delegator.Tokens)
+
+	// redeem with a wrong denom
+	_, err = handler(ctx, NewMsgRedeemTokenizedDeposit(delAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(40))))
+	require.Error(t, err)
+
+	res, err = handler(ctx, NewMsgRedeemTokenizedDeposit(delAddr, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(40))))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeRedeemTokenizedDeposit, res.Events[len(res.Events)-1].Type)
+	delegator, found = keeper.GetDelegator(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegator.Tokens)
+	require.True(t, keeper.GetTotalTokenizedDeposit(ctx).IsZero())
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/staking/types"
 )
 
@@ -41,13 +42,18 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 		return err
 	}
 
-	// 2.get
+	// 2.add the okb into the deposit of delegator
+	return k.increaseDeposit(ctx, delAddr, delQuantity)
+}
+
+// increaseDeposit adds the quantity into the deposit of the delegator, and updates the shares of the delegator or
+// its proxy
+func (k Keeper) increaseDeposit(ctx sdk.Context, delAddr sdk.AccAddress, delQuantity sdk.Dec) error {
 	delegator, found := k.GetDelegator(ctx, delAddr)
 	if !found {
 		delegator = types.NewDelegator(delAddr)
 	}
 
-	// 3.update delegator
 	delegator.Tokens = delegator.Tokens.Add(delQuantity)
 	k.SetDelegator(ctx, delegator)
 
@@ -56,7 +62,7 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 		return k.UpdateProxy(ctx, delegator, delQuantity)
 
 	}
-	// 4.update shares when delAddr has added already
+	// update shares when delAddr has added already
 	finalTokens := delegator.Tokens
 	// finalTokens should add TotalDelegatedTokens when delegator is proxy
 	if delegator.IsProxy {
@@ -67,30 +73,144 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 
 // Withdraw handles the process of withdrawing token from deposit account
 func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysCoin) (time.Time, error) {
-	delegator, found := k.GetDelegator(ctx, delAddr)
+	quantity := token.Amount
+	delegator, err := k.getDelegatorToDecreaseDeposit(ctx, delAddr, quantity)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// 1.some okb transfer bondPool into unbondPool
+	k.bondedTokensToNotBonded(ctx, token)
+
+	// 2.delete delegator in store, or set back
+	if err := k.decreaseDeposit(ctx, delegator, quantity); err != nil {
+		return time.Time{}, err
+	}
+
+	// 3.set undelegation and into store
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	undelegation, found := k.GetUndelegating(ctx, delAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegationToAddShares(delAddr.String())
+		undelegation = types.NewUndelegationInfo(delAddr, quantity, completionTime)
+	} else {
+		k.DeleteAddrByTimeKey(ctx, undelegation.CompletionTime, delAddr)
+		undelegation.Quantity = undelegation.Quantity.Add(quantity)
+		undelegation.CompletionTime = completionTime
+	}
+	// record the voted validators to add shares to again if the withdrawing of all the deposit is canceled
+	if tmtypes.HigherThanVenus8(ctx.BlockHeight()) && delegator.Tokens.Equal(quantity) {
+		undelegation.ValidatorAddresses = delegator.ValidatorAddresses
 	}
+	k.SetUndelegating(ctx, undelegation)
+	k.SetAddrByTimeKeyWithNilValue(ctx, completionTime, delAddr)
+
+	return completionTime, nil
+}
+
+// CancelWithdraw handles the process of moving token in the undelegation back into the deposit account
+func (k Keeper) CancelWithdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysCoin) error {
+	undelegation, found := k.GetUndelegating(ctx, delAddr)
+	if !found {
+		return types.ErrNotInDelegating(delAddr.String())
+	}
+
 	quantity, minDelLimit := token.Amount, k.ParamsMinDelegation(ctx)
 	if quantity.LT(minDelLimit) {
-		return time.Time{}, types.ErrInsufficientQuantity(quantity.String(), minDelLimit.String())
+		return types.ErrInsufficientQuantity(quantity.String(), minDelLimit.String())
+	} else if undelegation.Quantity.LT(quantity) {
+		return types.ErrInsufficientUndelegation(quantity.String(), undelegation.Quantity.String())
+	}
+
+	// 1.some okb transfer unbondPool back into bondPool
+	k.notBondedTokensToBonded(ctx, token)
+
+	// 2.delete undelegation in store, or set back with the same completion time
+	undelegation.Quantity = undelegation.Quantity.Sub(quantity)
+	if undelegation.Quantity.IsZero() {
+		k.DeleteAddrByTimeKey(ctx, undelegation.CompletionTime, delAddr)
+		k.DeleteUndelegating(ctx, delAddr)
+	} else {
+		k.SetUndelegating(ctx, undelegation)
+	}
+
+	// 3.add the okb back into the deposit of delegator
+	_, found = k.GetDelegator(ctx, delAddr)
+	if err := k.increaseDeposit(ctx, delAddr, quantity); err != nil {
+		return err
+	}
+	if found {
+		return nil
+	}
+
+	// 4.the delegator withdrew all of its deposit before, add shares to the validators voted last time
+	return k.addSharesToLastVotedValidators(ctx, delAddr, undelegation.ValidatorAddresses)
+}
+
+// addSharesToLastVotedValidators adds the shares of a delegator without votes to the validators it voted before. The
+// validators which have been removed or dismissed are skipped
+func (k Keeper) addSharesToLastVotedValidators(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddrs []sdk.ValAddress) error {
+	minSelfDelegation := k.ParamsMinSelfDelegation(ctx)
+	vals := make(types.Validators, 0, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		val, found := k.GetValidator(ctx, valAddr)
+		if found && !val.MinSelfDelegation.LT(minSelfDelegation) {
+			vals = append(vals, val)
+		}
+	}
+	if len(vals) == 0 {
+		return nil
+	}
+
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found {
+		return types.ErrNoDelegatorExisted(delAddr.String())
+	}
+
+	votedValAddrs := vals.ToValAddresses()
+	// init the delegator starting info for the distribution
+	k.BeforeDelegationCreated(ctx, delAddr, votedValAddrs)
+	shares, sdkErr := k.AddSharesToValidators(ctx, delAddr, vals, delegator.Tokens)
+	if sdkErr != nil {
+		return sdkErr
+	}
+
+	delegator.ValidatorAddresses = votedValAddrs
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+	k.AfterDelegationModified(ctx, delAddr, votedValAddrs)
+	return nil
+}
+
+// getDelegatorToDecreaseDeposit checks whether the quantity can be taken out of the deposit of the delegator
+func (k Keeper) getDelegatorToDecreaseDeposit(ctx sdk.Context, delAddr sdk.AccAddress, quantity sdk.Dec) (
+	types.Delegator, error) {
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found {
+		return delegator, types.ErrNoDelegationToAddShares(delAddr.String())
+	}
+	minDelLimit := k.ParamsMinDelegation(ctx)
+	if quantity.LT(minDelLimit) {
+		return delegator, types.ErrInsufficientQuantity(quantity.String(), minDelLimit.String())
 	} else if delegator.Tokens.LT(quantity) {
-		return time.Time{}, types.ErrInsufficientDelegation(quantity.String(), delegator.Tokens.String())
+		return delegator, types.ErrInsufficientDelegation(quantity.String(), delegator.Tokens.String())
 	}
 
 	// proxy has to unreg before withdrawing total tokens
-	leftTokens := delegator.Tokens.Sub(quantity)
-	if delegator.IsProxy && leftTokens.IsZero() {
-		return time.Time{}, types.ErrInvalidProxyWithdrawTotal(delAddr.String())
+	if delegator.IsProxy && delegator.Tokens.Equal(quantity) {
+		return delegator, types.ErrInvalidProxyWithdrawTotal(delAddr.String())
 	}
+	return delegator, nil
+}
 
-	// 1.some okb transfer bondPool into unbondPool
-	k.bondedTokensToNotBonded(ctx, token)
-
-	// 2.delete delegator in store, or set back
+// decreaseDeposit takes the quantity out of the deposit of the delegator, and updates the shares of the delegator
+// or its proxy. The delegator is deleted from the store when there are no tokens left
+func (k Keeper) decreaseDeposit(ctx sdk.Context, delegator types.Delegator, quantity sdk.Dec) error {
+	delAddr := delegator.DelegatorAddress
+	leftTokens := delegator.Tokens.Sub(quantity)
 	if delegator.HasProxy() {
 		if sdkErr := k.UpdateProxy(ctx, delegator, quantity.Mul(sdk.NewDec(-1))); sdkErr != nil {
-			return time.Time{}, sdkErr
+			return sdkErr
 		}
 	}
 	if leftTokens.IsZero() {
@@ -101,35 +221,20 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 			k.SetProxyBinding(ctx, delegator.ProxyAddress, delAddr, true)
 		}
 		k.DeleteDelegator(ctx, delAddr)
-	} else {
-		delegator.Tokens = leftTokens
-		k.SetDelegator(ctx, delegator)
-		if !delegator.HasProxy() {
-			finalTokens := delegator.Tokens
-			// finalTokens should add TotalDelegatedTokens when delegator is proxy
-			if delegator.IsProxy {
-				finalTokens = finalTokens.Add(delegator.TotalDelegatedTokens)
-			}
-			if err := k.UpdateShares(ctx, delegator.DelegatorAddress, finalTokens); err != nil {
-				return time.Time{}, err
-			}
-		}
+		return nil
 	}
 
-	// 3.set undelegation and into store
-	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	undelegation, found := k.GetUndelegating(ctx, delAddr)
-	if !found {
-		undelegation = types.NewUndelegationInfo(delAddr, quantity, completionTime)
-	} else {
-		k.DeleteAddrByTimeKey(ctx, undelegation.CompletionTime, delAddr)
-		undelegation.Quantity = undelegation.Quantity.Add(quantity)
-		undelegation.CompletionTime = completionTime
+	delegator.Tokens = leftTokens
+	k.SetDelegator(ctx, delegator)
+	if !delegator.HasProxy() {
+		finalTokens := delegator.Tokens
+		// finalTokens should add TotalDelegatedTokens when delegator is proxy
+		if delegator.IsProxy {
+			finalTokens = finalTokens.Add(delegator.TotalDelegatedTokens)
+		}
+		return k.UpdateShares(ctx, delegator.DelegatorAddress, finalTokens)
 	}
-	k.SetUndelegating(ctx, undelegation)
-	k.SetAddrByTimeKeyWithNilValue(ctx, completionTime, delAddr)
-
-	return completionTime, nil
+	return nil
 }
 
 // GetUndelegating gets UndelegationInfo entity from store
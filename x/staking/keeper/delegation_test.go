@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelWithdraw(t *testing.T) {
+	initPower := int64(1000000)
+	ctx, _, mKeeper := CreateTestInput(t, false, initPower)
+	k := mKeeper.Keeper
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	ctx.SetBlockHeight(2)
+	dAddr := Addrs[0]
+	vAddr := sdk.ValAddress(Addrs[1])
+	bondDenom := k.BondDenom(ctx)
+
+	// create validator
+	msgCreateValidator := NewTestMsgCreateValidator(vAddr, PKs[1], types.DefaultDPoSMinSelfDelegation)
+	validator := types.NewValidator(msgCreateValidator.ValidatorAddress, msgCreateValidator.PubKey,
+		msgCreateValidator.Description, msgCreateValidator.MinSelfDelegation.Amount)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	msdToken := sdk.NewDecCoinFromDec(bondDenom, validator.MinSelfDelegation)
+	require.NoError(t, k.AddSharesAsMinSelfDelegation(ctx, msgCreateValidator.DelegatorAddress, &validator, msdToken))
+
+	// deposit and add shares
+	require.NoError(t, k.Delegate(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100))))
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr})
+	require.NoError(t, err)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, sdk.NewDec(100))
+	require.NoError(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	invariant := ModuleAccountInvariantsCustom(k)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// cancel without undelegation
+	require.Error(t, k.CancelWithdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(10))))
+
+	// withdraw a part of the deposit, no voted validators are recorded
+	_, err = k.Withdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(30)))
+	require.NoError(t, err)
+	undelegation, found := k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Empty(t, undelegation.ValidatorAddresses)
+	require.NoError(t, k.CancelWithdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(30))))
+
+	// withdraw all of the deposit
+	completionTime, err := k.Withdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100)))
+	require.NoError(t, err)
+	_, found = k.GetDelegator(ctx, dAddr)
+	require.False(t, found)
+	undelegation, found = k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, []sdk.ValAddress{vAddr}, undelegation.ValidatorAddresses)
+
+	// cancel more than the undelegation
+	require.Error(t, k.CancelWithdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(101))))
+
+	// cancel a part of the undelegation, the shares are added to the validator voted before
+	require.NoError(t, k.CancelWithdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(40))))
+	undelegation, found = k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(60), undelegation.Quantity)
+	require.True(t, completionTime.Equal(undelegation.CompletionTime))
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(40), delegator.Tokens)
+	require.Equal(t, []sdk.ValAddress{vAddr}, delegator.ValidatorAddresses)
+	require.Equal(t, calculateWeight(sdk.NewDec(40)), delegator.Shares)
+	sharesAdded, found := k.GetShares(ctx, dAddr, vAddr)
+	require.True(t, found)
+	require.Equal(t, delegator.Shares, sharesAdded)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// cancel the rest of the undelegation
+	require.NoError(t, k.CancelWithdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(60))))
+	_, found = k.GetUndelegating(ctx, dAddr)
+	require.False(t, found)
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegator.Tokens)
+	require.Equal(t, calculateWeight(sdk.NewDec(100)), delegator.Shares)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// nothing to complete at the completion time
+	ctx.SetBlockTime(completionTime)
+	_, err = k.CompleteUndelegation(ctx, dAddr)
+	require.Error(t, err)
+
+	// no voted validators are recorded before venus8
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+	_, err = k.Withdraw(ctx, dAddr, sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(100)))
+	require.NoError(t, err)
+	undelegation, found = k.GetUndelegating(ctx, dAddr)
+	require.True(t, found)
+	require.Empty(t, undelegation.ValidatorAddresses)
+}
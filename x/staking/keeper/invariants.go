@@ -136,6 +136,8 @@ func ModuleAccountInvariantsCustom(k Keeper) sdk.Invariant {
 			bonded = bonded.Add(delegator.Tokens)
 			return false
 		})
+		// the okb of the tokenized deposit stays in the bonded pool until it's redeemed
+		bonded = bonded.Add(k.GetTotalTokenizedDeposit(ctx))
 
 		k.IterateUndelegationInfo(ctx, func(_ int64, undelegationInfo types.UndelegationInfo) bool {
 			notBonded = notBonded.Add(undelegationInfo.Quantity)
@@ -146,7 +148,7 @@ func ModuleAccountInvariantsCustom(k Keeper) sdk.Invariant {
 		poolNotBonded := notBondedPool.GetCoins().AmountOf(bondDenom)
 		broken := !poolBonded.Equal(bonded) || !poolNotBonded.Equal(notBonded)
 
-		// Bonded tokens should be equal to the sum of delegators' tokens
+		// Bonded tokens should be equal to the sum of delegators' tokens and the tokenized deposit
 		// Not-bonded tokens should be equal to the sum of undelegation infos' tokens
 		return sdk.FormatInvariant(types.ModuleName, "bonded and not bonded module account coins", fmt.Sprintf(
 			"\tPool's bonded tokens: %v\n"+
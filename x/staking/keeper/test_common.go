@@ -166,9 +166,10 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initBalance int64) (sdk.Conte
 	)
 
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName:   nil,
-		types.NotBondedPoolName: {supply.Burner, supply.Staking},
-		types.BondedPoolName:    {supply.Burner, supply.Staking},
+		auth.FeeCollectorName:          nil,
+		types.NotBondedPoolName:        {supply.Burner, supply.Staking},
+		types.BondedPoolName:           {supply.Burner, supply.Staking},
+		types.TokenizedDepositPoolName: {supply.Minter, supply.Burner},
 	}
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bank.NewBankKeeperAdapter(bk), maccPerms)
 
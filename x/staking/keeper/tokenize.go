@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/staking/types"
+)
+
+// TokenizedDepositDenom returns the denom of the coins which the deposit is tokenized into
+func (k Keeper) TokenizedDepositDenom(ctx sdk.Context) string {
+	return types.GetTokenizedDepositDenom(k.BondDenom(ctx))
+}
+
+// GetTotalTokenizedDeposit gets the total amount of the deposit which is tokenized and not redeemed yet
+func (k Keeper) GetTotalTokenizedDeposit(ctx sdk.Context) (amount sdk.Dec) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenizedDepositKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	k.cdcMarshl.GetCdc().MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return
+}
+
+// SetTotalTokenizedDeposit sets the total amount of the deposit which is tokenized and not redeemed yet
+func (k Keeper) SetTotalTokenizedDeposit(ctx sdk.Context, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.TokenizedDepositKey)
+		return
+	}
+	store.Set(types.TokenizedDepositKey, k.cdcMarshl.GetCdc().MustMarshalBinaryLengthPrefixed(amount))
+}
+
+// TokenizeDeposit takes the token out of the deposit of the delegator and mints the same amount of tokenized deposit
+// coins to the delegator. The tokenized deposit is an unstaked IOU: the okb stays in the bonded pool but backs no
+// shares, so it earns no rewards and carries no votes until it's redeemed into a deposit
+func (k Keeper) TokenizeDeposit(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysCoin) (sdk.SysCoin, error) {
+	quantity := token.Amount
+	delegator, err := k.getDelegatorToDecreaseDeposit(ctx, delAddr, quantity)
+	if err != nil {
+		return sdk.SysCoin{}, err
+	}
+
+	// 1.take the tokens out of the deposit, the shares are updated with the distribution hooks
+	if err := k.decreaseDeposit(ctx, delegator, quantity); err != nil {
+		return sdk.SysCoin{}, err
+	}
+
+	// 2.mint the tokenized deposit coins to the delegator
+	tokenized := sdk.NewDecCoinFromDec(k.TokenizedDepositDenom(ctx), quantity)
+	coins := sdk.NewCoins(tokenized)
+	if err := k.supplyKeeper.MintCoins(ctx, types.TokenizedDepositPoolName, coins); err != nil {
+		return sdk.SysCoin{}, err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedDepositPoolName, delAddr, coins); err != nil {
+		return sdk.SysCoin{}, err
+	}
+
+	k.SetTotalTokenizedDeposit(ctx, k.GetTotalTokenizedDeposit(ctx).Add(quantity))
+	return tokenized, nil
+}
+
+// RedeemTokenizedDeposit burns the tokenized deposit coins of the delegator and adds the same amount of okb into the
+// deposit of the delegator
+func (k Keeper) RedeemTokenizedDeposit(ctx sdk.Context, delAddr sdk.AccAddress, tokenized sdk.SysCoin) error {
+	quantity, minDelLimit := tokenized.Amount, k.ParamsMinDelegation(ctx)
+	if quantity.LT(minDelLimit) {
+		return types.ErrInsufficientQuantity(quantity.String(), minDelLimit.String())
+	}
+
+	// 1.burn the tokenized deposit coins of the delegator
+	coins := sdk.NewCoins(tokenized)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizedDepositPoolName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.TokenizedDepositPoolName, coins); err != nil {
+		return err
+	}
+
+	totalTokenized := k.GetTotalTokenizedDeposit(ctx)
+	if totalTokenized.LT(quantity) {
+		return types.ErrInsufficientDelegation(quantity.String(), totalTokenized.String())
+	}
+	k.SetTotalTokenizedDeposit(ctx, totalTokenized.Sub(quantity))
+
+	// 2.add the okb kept in the bonded pool back into the deposit of the delegator
+	return k.increaseDeposit(ctx, delAddr, quantity)
+}
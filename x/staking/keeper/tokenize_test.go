@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	"github.com/okx/okbchain/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeAndRedeemDeposit(t *testing.T) {
+	initPower := int64(1000000)
+	ctx, ak, mKeeper := CreateTestInput(t, false, initPower)
+	k := mKeeper.Keeper
+	dAddr := Addrs[0]
+	vAddr := sdk.ValAddress(Addrs[1])
+
+	// create validator
+	msgCreateValidator := NewTestMsgCreateValidator(vAddr, PKs[1], types.DefaultDPoSMinSelfDelegation)
+	validator := types.NewValidator(msgCreateValidator.ValidatorAddress, msgCreateValidator.PubKey,
+		msgCreateValidator.Description, msgCreateValidator.MinSelfDelegation.Amount)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	msdToken := sdk.NewDecCoinFromDec(k.BondDenom(ctx), validator.MinSelfDelegation)
+	require.NoError(t, k.AddSharesAsMinSelfDelegation(ctx, msgCreateValidator.DelegatorAddress, &validator, msdToken))
+
+	// deposit and add shares
+	delegateAmount, err := sdk.ParseDecCoin(fmt.Sprintf("100%s", k.BondDenom(ctx)))
+	require.NoError(t, err)
+	require.NoError(t, k.Delegate(ctx, dAddr, delegateAmount))
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr})
+	require.NoError(t, err)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, delegateAmount.Amount)
+	require.NoError(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	invariant := ModuleAccountInvariantsCustom(k)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	tokenizedDenom := types.GetTokenizedDepositDenom(k.BondDenom(ctx))
+	require.Equal(t, tokenizedDenom, k.TokenizedDepositDenom(ctx))
+
+	// tokenize more than the deposit
+	_, err = k.TokenizeDeposit(ctx, dAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(101)))
+	require.Error(t, err)
+
+	// tokenize a part of the deposit
+	tokenized, err := k.TokenizeDeposit(ctx, dAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(40)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(40)), tokenized)
+	require.Equal(t, sdk.NewDec(40), ak.GetAccount(ctx, dAddr).GetCoins().AmountOf(tokenizedDenom))
+	require.Equal(t, sdk.NewDec(40), k.GetTotalTokenizedDeposit(ctx))
+
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(60), delegator.Tokens)
+	require.Equal(t, calculateWeight(sdk.NewDec(60)), delegator.Shares)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// redeem more than the tokenized deposit coins held
+	err = k.RedeemTokenizedDeposit(ctx, dAddr, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(41)))
+	require.Error(t, err)
+
+	// redeem a part of the tokenized deposit coins
+	require.NoError(t, k.RedeemTokenizedDeposit(ctx, dAddr, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(10))))
+	require.Equal(t, sdk.NewDec(30), ak.GetAccount(ctx, dAddr).GetCoins().AmountOf(tokenizedDenom))
+	require.Equal(t, sdk.NewDec(30), k.GetTotalTokenizedDeposit(ctx))
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(70), delegator.Tokens)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// tokenize the rest of the deposit, the delegator is removed
+	_, err = k.TokenizeDeposit(ctx, dAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(70)))
+	require.NoError(t, err)
+	_, found = k.GetDelegator(ctx, dAddr)
+	require.False(t, found)
+	require.Equal(t, sdk.NewDec(100), k.GetTotalTokenizedDeposit(ctx))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// redeem all the tokenized deposit coins into a new deposit
+	require.NoError(t, k.RedeemTokenizedDeposit(ctx, dAddr, sdk.NewDecCoinFromDec(tokenizedDenom, sdk.NewDec(100))))
+	require.True(t, ak.GetAccount(ctx, dAddr).GetCoins().AmountOf(tokenizedDenom).IsZero())
+	require.True(t, k.GetTotalTokenizedDeposit(ctx).IsZero())
+	delegator, found = k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegator.Tokens)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestTokenizeDepositOfProxy(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	proxyAddr := Addrs[0]
+
+	delegateAmount := sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(100))
+	require.NoError(t, k.Delegate(ctx, proxyAddr, delegateAmount))
+	proxy, found := k.GetDelegator(ctx, proxyAddr)
+	require.True(t, found)
+	proxy.RegProxy(true)
+	k.SetDelegator(ctx, proxy)
+
+	// proxy has to unreg before tokenizing total tokens
+	_, err := k.TokenizeDeposit(ctx, proxyAddr, delegateAmount)
+	require.Error(t, err)
+
+	_, err = k.TokenizeDeposit(ctx, proxyAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(40)))
+	require.NoError(t, err)
+	proxy, found = k.GetDelegator(ctx, proxyAddr)
+	require.True(t, found)
+	require.True(t, proxy.IsProxy)
+	require.Equal(t, sdk.NewDec(60), proxy.Tokens)
+}
@@ -129,6 +129,23 @@ func (k Keeper) GetAllValidators(ctx sdk.Context) (validators types.Validators)
 	return validators
 }
 
+// GetBondedValidatorsByPower gets the current group of bonded validators sorted by power-rank
+func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) types.Validators {
+	maxValidators := k.MaxValidators(ctx)
+	validators := make(types.Validators, 0, maxValidators)
+
+	iterator := k.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(validators) < int(maxValidators); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		if validator.IsBonded() {
+			validators = append(validators, validator)
+		}
+	}
+	return validators
+}
+
 // ValidatorsPowerStoreIterator returns an iterator for the current validator power store
 func (k Keeper) ValidatorsPowerStoreIterator(ctx sdk.Context) (iterator sdk.Iterator) {
 	store := ctx.KVStore(k.storeKey)
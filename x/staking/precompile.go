@@ -0,0 +1,104 @@
+package staking
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okx/okbchain/x/evm/types"
+	"github.com/okx/okbchain/x/staking/keeper"
+	"github.com/okx/okbchain/x/staking/types"
+)
+
+const (
+	PrecompileDeposit   = "deposit"
+	PrecompileWithdraw  = "withdraw"
+	PrecompileAddShares = "addShares"
+)
+
+var (
+	PrecompileABI evmtypes.ABI
+
+	//go:embed precompile.json
+	precompileJson []byte
+
+	precompileGas = map[string]uint64{
+		PrecompileDeposit:   20000,
+		PrecompileWithdraw:  20000,
+		PrecompileAddShares: 30000,
+	}
+)
+
+func init() {
+	ret, err := abi.JSON(bytes.NewReader(precompileJson))
+	if err != nil {
+		panic(err)
+	}
+	PrecompileABI = evmtypes.ABI{ABI: &ret}
+}
+
+var _ evmtypes.Precompile = Precompile{}
+
+// Precompile lets evm contracts deposit, withdraw and add shares to validators as the delegator
+type Precompile struct {
+	k       keeper.Keeper
+	handler sdk.Handler
+}
+
+// NewPrecompile creates a new staking precompile
+func NewPrecompile(k keeper.Keeper) Precompile {
+	return Precompile{k: k, handler: NewHandler(k)}
+}
+
+// ABI returns the abi of the staking precompile
+func (p Precompile) ABI() evmtypes.ABI {
+	return PrecompileABI
+}
+
+// RequiredGas returns the gas charged before the staking method is run
+func (p Precompile) RequiredGas(method *abi.Method) uint64 {
+	return precompileGas[method.Name]
+}
+
+// Run executes the staking message built from the args with the caller as the delegator
+func (p Precompile) Run(ctx sdk.Context, caller common.Address, method *abi.Method, args []interface{}) ([]byte, error) {
+	delAddr := sdk.AccAddress(caller.Bytes())
+
+	var msg sdk.Msg
+	switch method.Name {
+	case PrecompileDeposit:
+		msg = types.NewMsgDeposit(delAddr, sdk.NewDecCoinFromDec(p.k.BondDenom(ctx),
+			sdk.NewDecFromBigIntWithPrec(args[0].(*big.Int), sdk.Precision)))
+	case PrecompileWithdraw:
+		msg = types.NewMsgWithdraw(delAddr, sdk.NewDecCoinFromDec(p.k.BondDenom(ctx),
+			sdk.NewDecFromBigIntWithPrec(args[0].(*big.Int), sdk.Precision)))
+	case PrecompileAddShares:
+		validators := args[0].([]string)
+		valAddrs := make([]sdk.ValAddress, len(validators))
+		for i, validator := range validators {
+			valAddr, err := sdk.ValAddressFromBech32(validator)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validator address %s: %s", validator, err)
+			}
+			valAddrs[i] = valAddr
+		}
+		msg = types.NewMsgAddShares(delAddr, valAddrs)
+	default:
+		return nil, fmt.Errorf("staking precompile: unknown method %s", method.Name)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	res, err := p.handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(res.Events)
+
+	return method.Outputs.Pack(true)
+}
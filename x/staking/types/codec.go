@@ -13,9 +13,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDestroyValidator{}, system.Chain+"/staking/MsgDestroyValidator", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, system.Chain+"/staking/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgWithdraw{}, system.Chain+"/staking/MsgWithdraw", nil)
+	cdc.RegisterConcrete(MsgCancelWithdraw{}, system.Chain+"/staking/MsgCancelWithdraw", nil)
 	cdc.RegisterConcrete(MsgAddShares{}, system.Chain+"/staking/MsgAddShares", nil)
 	cdc.RegisterConcrete(ProposeValidatorProposal{}, ProposeValidatorProposalName, nil)
 	cdc.RegisterConcrete(MsgDepositMinSelfDelegation{}, system.Chain+"/staking/MsgDepositMinSelfDelegation", nil)
+	cdc.RegisterConcrete(MsgTokenizeDeposit{}, system.Chain+"/staking/MsgTokenizeDeposit", nil)
+	cdc.RegisterConcrete(MsgRedeemTokenizedDeposit{}, system.Chain+"/staking/MsgRedeemTokenizedDeposit", nil)
 }
 
 // ModuleCdc is generic sealed codec to be used throughout this module
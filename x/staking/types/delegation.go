@@ -13,6 +13,9 @@ type UndelegationInfo struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 	Quantity         sdk.Dec        `json:"quantity" yaml:"quantity"`
 	CompletionTime   time.Time      `json:"completion_time"`
+	// ValidatorAddresses are the validators voted by the delegator before it withdrew all of its deposit, which
+	// get the shares back when the withdrawing is canceled. It's only recorded after venus8
+	ValidatorAddresses []sdk.ValAddress `json:"validator_addresses,omitempty" yaml:"validator_addresses,omitempty"`
 }
 
 // NewUndelegationInfo creates a new delegation object
@@ -51,6 +54,6 @@ func (ud UndelegationInfo) String() string {
 // DefaultUndelegation returns default entity for UndelegationInfo
 func DefaultUndelegation() UndelegationInfo {
 	return UndelegationInfo{
-		nil, sdk.ZeroDec(), time.Unix(0, 0).UTC(),
+		nil, sdk.ZeroDec(), time.Unix(0, 0).UTC(), nil,
 	}
 }
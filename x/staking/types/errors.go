@@ -58,6 +58,7 @@ const (
 	CodeNoDelegatorExisted              uint32 = 67044
 	CodeTargetValsDuplicate             uint32 = 67045
 	CodeAlreadyBound                    uint32 = 67046
+	CodeInsufficientUndelegation        uint32 = 67051
 )
 
 var (
@@ -263,6 +264,13 @@ func ErrNotInDelegating(addr string) sdk.Error {
 		fmt.Sprintf("failed. the addr %s is not in the status of undelegating", addr))
 }
 
+// ErrInsufficientUndelegation returns an error when the quantity to cancel is more than the undelegation left
+func ErrInsufficientUndelegation(quantity, undelLeft string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInsufficientUndelegation,
+		fmt.Sprintf("failed. insufficient undelegation. [undelegation left]:%s, [quantity to cancel]:%s",
+			undelLeft, quantity))
+}
+
 // ErrInsufficientDelegation returns an error when the delegation left is not enough for unbonding
 func ErrInsufficientDelegation(quantity, delLeft string) sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeInsufficientDelegation,
@@ -7,7 +7,10 @@ const (
 	EventTypeEditValidator            = "edit_validator"
 	EventTypeDelegate                 = "delegate"
 	EventTypeUnbond                   = "unbond"
+	EventTypeCancelUnbond             = "cancel_unbond"
 	EventTypeDepositMinSelfDelegation = "deposit_min_self_delegation"
+	EventTypeTokenizeDeposit          = "tokenize_deposit"
+	EventTypeRedeemTokenizedDeposit   = "redeem_tokenized_deposit"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
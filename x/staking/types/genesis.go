@@ -17,6 +17,7 @@ type GenesisState struct {
 	UnbondingDelegations []UndelegationInfo          `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	AllShares            []SharesExported            `json:"all_shares" yaml:"all_shares"`
 	ProxyDelegatorKeys   []ProxyDelegatorKeyExported `json:"proxy_delegator_keys" yaml:"proxy_delegator_keys"`
+	TokenizedDeposit     sdk.Dec                     `json:"tokenized_deposit" yaml:"tokenized_deposit"`
 	Exported             bool                        `json:"exported" yaml:"exported"`
 }
 
@@ -37,16 +38,18 @@ func NewLastValidatorPower(valAddr sdk.ValAddress, power int64) LastValidatorPow
 // NewGenesisState creates a new object of GenesisState
 func NewGenesisState(params Params, validators Validators, delegators []Delegator) GenesisState {
 	return GenesisState{
-		Params:     params,
-		Validators: validators.Export(),
-		Delegators: delegators,
+		Params:           params,
+		Validators:       validators.Export(),
+		Delegators:       delegators,
+		TokenizedDeposit: sdk.ZeroDec(),
 	}
 }
 
 // DefaultGenesisState gets the default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params: DefaultParams(),
+		Params:           DefaultParams(),
+		TokenizedDeposit: sdk.ZeroDec(),
 	}
 }
 
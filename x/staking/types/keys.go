@@ -49,6 +49,7 @@ var (
 	UnDelegateQueueKey   = []byte{0x54}
 	ProxyKey             = []byte{0x55}
 	ProposeValidatorsKey = []byte{0x56}
+	TokenizedDepositKey  = []byte{0x57} // key for the total amount of the tokenized deposit
 	// prefix key for vals info to enforce the update of validator-set
 	ValidatorAbandonedKey = []byte{0x60}
 
@@ -286,3 +286,41 @@ func (msg MsgWithdraw) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// MsgCancelWithdraw - structure for moving okb in the undelegation back into the deposit
+type MsgCancelWithdraw struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.SysCoin    `json:"quantity" yaml:"quantity"`
+}
+
+// NewMsgCancelWithdraw creates a new instance of MsgCancelWithdraw
+func NewMsgCancelWithdraw(delAddr sdk.AccAddress, amount sdk.SysCoin) MsgCancelWithdraw {
+	return MsgCancelWithdraw{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// nolint
+func (msg MsgCancelWithdraw) Route() string { return RouterKey }
+func (msg MsgCancelWithdraw) Type() string  { return "cancel_withdraw" }
+func (msg MsgCancelWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgCancelWithdraw) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroDec()) || !msg.Amount.IsValid() {
+		return ErrBadUnDelegationAmount()
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgCancelWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
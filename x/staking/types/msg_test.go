@@ -343,3 +343,62 @@ func TestMsgAddShares(t *testing.T) {
 //		}
 //	}
 //}
+
+// test ValidateBasic for MsgTokenizeDeposit and MsgRedeemTokenizedDeposit
+func TestMsgTokenizeAndRedeemDeposit(t *testing.T) {
+	coinPos := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000))
+	coinZero := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec())
+	tokenizedPos := sdk.NewDecCoinFromDec(GetTokenizedDepositDenom(sdk.DefaultBondDenom), sdk.NewDec(1000))
+
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.SysCoin
+		expectPass    bool
+	}{
+		{"basic good", dlgAddr1, coinPos, true},
+		{"basic good tokenized", dlgAddr1, tokenizedPos, true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), coinPos, false},
+		{"empty amount", sdk.AccAddress(addr1), coinZero, false},
+	}
+
+	for _, tc := range tests {
+		tokenizeMsg := NewMsgTokenizeDeposit(tc.delegatorAddr, tc.amount)
+		redeemMsg := NewMsgRedeemTokenizedDeposit(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, tokenizeMsg.ValidateBasic(), "test: %v", tc.name)
+			require.Nil(t, redeemMsg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, tokenizeMsg, "tokenize_deposit")
+			checkMsg(t, redeemMsg, "redeem_tokenized_deposit")
+		} else {
+			require.NotNil(t, tokenizeMsg.ValidateBasic(), "test: %v", tc.name)
+			require.NotNil(t, redeemMsg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgCancelWithdraw(t *testing.T) {
+	coinPos := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000))
+	coinZero := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec())
+
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.SysCoin
+		expectPass    bool
+	}{
+		{"basic good", dlgAddr1, coinPos, true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), coinPos, false},
+		{"empty amount", sdk.AccAddress(addr1), coinZero, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelWithdraw(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "cancel_withdraw")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = (*MsgTokenizeDeposit)(nil)
+	_ sdk.Msg = (*MsgRedeemTokenizedDeposit)(nil)
+)
+
+// MsgTokenizeDeposit - structure for turning a part of the deposit into the transferable tokenized deposit coins,
+// which are an unstaked IOU of the deposit earning no rewards until they're redeemed
+type MsgTokenizeDeposit struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.SysCoin    `json:"quantity" yaml:"quantity"`
+}
+
+// NewMsgTokenizeDeposit creates a new instance of MsgTokenizeDeposit
+func NewMsgTokenizeDeposit(delAddr sdk.AccAddress, amount sdk.SysCoin) MsgTokenizeDeposit {
+	return MsgTokenizeDeposit{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// nolint
+func (msg MsgTokenizeDeposit) Route() string { return RouterKey }
+func (msg MsgTokenizeDeposit) Type() string  { return "tokenize_deposit" }
+func (msg MsgTokenizeDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgTokenizeDeposit) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroDec()) || !msg.Amount.IsValid() {
+		return ErrBadUnDelegationAmount()
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgTokenizeDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// MsgRedeemTokenizedDeposit - structure for turning the tokenized deposit coins back into the deposit
+type MsgRedeemTokenizedDeposit struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.SysCoin    `json:"quantity" yaml:"quantity"`
+}
+
+// NewMsgRedeemTokenizedDeposit creates a new instance of MsgRedeemTokenizedDeposit
+func NewMsgRedeemTokenizedDeposit(delAddr sdk.AccAddress, amount sdk.SysCoin) MsgRedeemTokenizedDeposit {
+	return MsgRedeemTokenizedDeposit{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// nolint
+func (msg MsgRedeemTokenizedDeposit) Route() string { return RouterKey }
+func (msg MsgRedeemTokenizedDeposit) Type() string  { return "redeem_tokenized_deposit" }
+func (msg MsgRedeemTokenizedDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgRedeemTokenizedDeposit) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroDec()) || !msg.Amount.IsValid() {
+		return ErrBadDelegationAmount()
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgRedeemTokenizedDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -11,11 +11,22 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - TokenizedDepositPool -> "tokenized_deposit_pool"
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName        = "not_bonded_tokens_pool"
+	BondedPoolName           = "bonded_tokens_pool"
+	TokenizedDepositPoolName = "tokenized_deposit_pool"
+
+	// TokenizedDepositDenomPrefix is the prefix of the denom of the tokenized deposit coins
+	TokenizedDepositDenomPrefix = "st"
 )
 
+// GetTokenizedDepositDenom returns the denom of the coins which the deposit of bond denom is tokenized into
+func GetTokenizedDepositDenom(bondDenom string) string {
+	return TokenizedDepositDenomPrefix + bondDenom
+}
+
 // Pool - tracking bonded and not-bonded token supply of the bond denomination
 type Pool struct {
 	// tokens which are not bonded to a validator (unbonded or unbonding)
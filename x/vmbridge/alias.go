@@ -6,14 +6,17 @@ import (
 )
 
 var (
-	RegisterMsgServer         = types.RegisterMsgServer
-	NewMsgServerImpl          = keeper.NewMsgServerImpl
-	NewSendToWasmEventHandler = keeper.NewSendToWasmEventHandler
-	NewCallToWasmEventHandler = keeper.NewCallToWasmEventHandler
-	RegisterSendToEvmEncoder  = keeper.RegisterSendToEvmEncoder
-	NewKeeper                 = keeper.NewKeeper
-	RegisterInterface         = types.RegisterInterface
-	PrecompileHooks           = keeper.PrecompileHooks
+	RegisterMsgServer                  = types.RegisterMsgServer
+	NewMsgServerImpl                   = keeper.NewMsgServerImpl
+	NewSendToWasmEventHandler          = keeper.NewSendToWasmEventHandler
+	NewCallToWasmEventHandler          = keeper.NewCallToWasmEventHandler
+	NewSendInterchainQueryEventHandler = keeper.NewSendInterchainQueryEventHandler
+	NewInterchainQueryCallbacks        = keeper.NewInterchainQueryCallbacks
+	RegisterSendToEvmEncoder           = keeper.RegisterSendToEvmEncoder
+	RegisterEvmQuerier                 = keeper.RegisterEvmQuerier
+	NewKeeper                          = keeper.NewKeeper
+	RegisterInterface                  = types.RegisterInterface
+	PrecompileHooks                    = keeper.PrecompileHooks
 )
 
 type (
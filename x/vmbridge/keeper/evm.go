@@ -277,3 +277,44 @@ func (k Keeper) CallEvm(ctx sdk.Context, callerAddr common.Address, to *common.A
 
 	return executionResult, resultData, err
 }
+
+// staticCallEvm executes a read-only evm call for a wasm query. The evm gas is limited by the gas left
+// in the query context and charged to it afterwards.
+func staticCallEvm(ctx sdk.Context, evmKeeper EVMKeeper, callerAddr common.Address, to common.Address, data []byte) ([]byte, error) {
+	config, found := evmKeeper.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	maxGas := evmKeeper.GetParams(ctx).MaxGasLimitPerTx
+	st := evmtypes.StateTransition{
+		Price:     big.NewInt(0),
+		Recipient: &to,
+		Amount:    big.NewInt(0),
+		Payload:   data,
+		Csdb:      evmtypes.CreateEmptyCommitStateDB(evmKeeper.GenerateCSDBParams(), ctx),
+		ChainID:   chainIDEpoch,
+		Sender:    callerAddr,
+		Simulate:  true,
+	}
+	st.Csdb.Prepare(common.Hash{}, evmKeeper.GetBlockHash(), 0)
+	st.SetCallToCM(evmKeeper.GetCallToCM())
+
+	gasMeter := ctx.GasMeter()
+	st.GasLimit = gasMeter.Limit() - gasMeter.GasConsumed()
+	if st.GasLimit > maxGas {
+		st.GasLimit = maxGas
+	}
+
+	ret, gasConsumed, err := st.StaticCall(ctx, config)
+	gasMeter.ConsumeGas(gasConsumed, "evm static call")
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
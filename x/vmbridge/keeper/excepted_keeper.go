@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	authexported "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/exported"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
 	evmtypes "github.com/okx/okbchain/x/evm/types"
 	wasmtypes "github.com/okx/okbchain/x/wasm/types"
 )
@@ -18,6 +20,7 @@ type EVMKeeper interface {
 	GetBlockHash() ethcmn.Hash
 	AddInnerTx(...interface{})
 	AddContract(...interface{})
+	IsContractAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 }
 
 type WASMKeeper interface {
@@ -26,6 +29,9 @@ type WASMKeeper interface {
 	GetParams(ctx sdk.Context) wasmtypes.Params
 	NewQueryHandler(ctx sdk.Context, contractAddress sdk.WasmAddress) wasmvmtypes.Querier
 	RuntimeGasForContract(ctx sdk.Context) uint64
+	// Sudo allows to call privileged entry point of a contract.
+	Sudo(ctx sdk.Context, contractAddress sdk.WasmAddress, msg []byte) ([]byte, error)
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.WasmAddress) *wasmtypes.ContractInfo
 }
 
 // AccountKeeper defines the expected account keeper interface
@@ -38,3 +44,9 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
+
+// ICQKeeper defines the expected interchain query keeper which sends the queries of the contracts
+type ICQKeeper interface {
+	SendInterchainQuery(ctx sdk.Context, requester sdk.AccAddress, sourceChannel string, requests []abci.RequestQuery,
+		timeoutHeight clienttypes.Height, timeoutTimestamp uint64) (uint64, error)
+}
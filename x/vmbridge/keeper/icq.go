@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	icqtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
+	clienttypes "github.com/okx/okbchain/libs/ibc-go/modules/core/02-client/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	"github.com/okx/okbchain/x/evm/watcher"
+	"github.com/okx/okbchain/x/vmbridge/types"
+)
+
+// event __OKBCSendInterchainQuery(string channelId, string[] paths, bytes[] data, uint64 timeoutTimestamp)
+type SendInterchainQueryEventHandler struct {
+	Keeper
+}
+
+func NewSendInterchainQueryEventHandler(k Keeper) *SendInterchainQueryEventHandler {
+	return &SendInterchainQueryEventHandler{k}
+}
+
+// EventID Return the id of the log signature it handles
+func (h SendInterchainQueryEventHandler) EventID() common.Hash {
+	return types.SendInterchainQueryEvent.ID
+}
+
+// Handle Process the log
+func (h SendInterchainQueryEventHandler) Handle(ctx sdk.Context, contract common.Address, data []byte) error {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		errMsg := fmt.Sprintf("interchain query not supported at height %d", ctx.BlockHeight())
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+
+	params := h.wasmKeeper.GetParams(ctx)
+	if !params.VmbridgeEnable {
+		return types.ErrVMBridgeEnable
+	}
+
+	logger := h.Keeper.Logger()
+	unpacked, err := types.SendInterchainQueryEvent.Inputs.Unpack(data)
+	if err != nil {
+		// log and ignore
+		logger.Error("log signature matches but failed to decode", "error", err)
+		return nil
+	}
+
+	caller := sdk.AccAddress(contract.Bytes())
+	channelID := unpacked[0].(string)
+	paths := unpacked[1].([]string)
+	queryData := unpacked[2].([][]byte)
+	timeoutTimestamp := unpacked[3].(uint64)
+
+	_, err = h.Keeper.SendInterchainQuery(ctx, caller, channelID, paths, queryData, timeoutTimestamp)
+	return err
+}
+
+// SendInterchainQuery sends the queries of the evm contract to the host chain through the channel. The
+// timeout is relative to the block time if it is not set.
+func (k Keeper) SendInterchainQuery(ctx sdk.Context, caller sdk.AccAddress, channelID string, paths []string, data [][]byte, timeoutTimestamp uint64) (uint64, error) {
+	if len(paths) != len(data) {
+		return 0, sdkerrors.Wrapf(icqtypes.ErrInvalidQuery, "the length of paths %d doesn't match the length of data %d", len(paths), len(data))
+	}
+	requests := make([]abci.RequestQuery, len(paths))
+	for i := range paths {
+		requests[i] = abci.RequestQuery{Path: paths[i], Data: data[i]}
+	}
+
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(time.Duration(icqtypes.DefaultRelativePacketTimeoutTimestamp)).UnixNano())
+	}
+
+	sequence, err := k.icqKeeper.SendInterchainQuery(ctx, caller, channelID, requests, clienttypes.ZeroHeight(), timeoutTimestamp)
+	var attribute sdk.Attribute
+	if err != nil {
+		attribute = sdk.NewAttribute(types.AttributeResult, err.Error())
+	} else {
+		attribute = sdk.NewAttribute(types.AttributeResult, fmt.Sprintf("%d", sequence))
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEvmSendInterchainQuery,
+			attribute,
+		),
+	)
+	return sequence, err
+}
+
+var _ icqtypes.QueryCallbacks = InterchainQueryCallbacks{}
+
+// InterchainQueryCallbacks delivers the results of the interchain queries to the requesters. The results
+// are delivered to the evm contracts by calling their onInterchainQueryResult method, and to the wasm
+// contracts by sudo. The results of the other requesters are only available in the events.
+type InterchainQueryCallbacks struct {
+	Keeper
+}
+
+func NewInterchainQueryCallbacks(k Keeper) InterchainQueryCallbacks {
+	return InterchainQueryCallbacks{k}
+}
+
+// OnInterchainQueryResult implements the icqtypes.QueryCallbacks interface
+func (c InterchainQueryCallbacks) OnInterchainQueryResult(
+	ctx sdk.Context, requester sdk.AccAddress, channelID string, sequence uint64,
+	responses []abci.ResponseQuery, errMsg string,
+) error {
+	if !tmtypes.HigherThanVenus8(ctx.BlockHeight()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("interchain query not supported at height %d", ctx.BlockHeight()))
+	}
+
+	if c.evmKeeper.IsContractAccount(ctx, requester) {
+		input, err := types.GetInterchainQueryResultInput(channelID, sequence, responses, errMsg)
+		if err != nil {
+			return err
+		}
+		// k.CallEvm will call evm, so we must enable evm watch db with follow code
+		if watcher.IsWatcherEnabled() {
+			ctx.SetWatcher(watcher.NewTxWatcher())
+		}
+		contract := common.BytesToAddress(requester.Bytes())
+		if _, _, err := c.CallEvm(ctx, types.IcqEvmModuleETHAddr, &contract, big.NewInt(0), input); err != nil {
+			return err
+		}
+		if watcher.IsWatcherEnabled() {
+			ctx.GetWatcher().Finalize()
+		}
+		return nil
+	}
+
+	contractAddr := sdk.AccToAWasmddress(requester)
+	if c.wasmKeeper.GetContractInfo(ctx, contractAddr) != nil {
+		msg, err := types.GetInterchainQueryResultSudoMsg(channelID, sequence, responses, errMsg)
+		if err != nil {
+			return err
+		}
+		_, err = c.wasmKeeper.Sudo(ctx, contractAddr, msg)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
+	keeper2 "github.com/okx/okbchain/x/vmbridge/keeper"
+)
+
+func (suite *KeeperTestSuite) TestInterchainQueryBeforeVenus8() {
+	tmtypes.UnittestOnlySetMilestoneVenus8Height(suite.ctx.BlockHeight())
+	defer tmtypes.UnittestOnlySetMilestoneVenus8Height(0)
+
+	handler := keeper2.NewSendInterchainQueryEventHandler(*suite.keeper)
+	err := handler.Handle(suite.ctx, suite.evmContract, nil)
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+
+	callbacks := keeper2.NewInterchainQueryCallbacks(*suite.keeper)
+	err = callbacks.OnInterchainQueryResult(suite.ctx, suite.addr, "channel-0", 1, nil, "")
+	suite.Require().True(sdkerrors.ErrUnknownRequest.Is(err))
+}
@@ -16,11 +16,12 @@ type Keeper struct {
 	wasmKeeper    WASMKeeper
 	accountKeeper AccountKeeper
 	bankKeeper    BankKeeper
+	icqKeeper     ICQKeeper
 }
 
-func NewKeeper(cdc *codec.CodecProxy, logger log.Logger, evmKeeper EVMKeeper, wasmKeeper WASMKeeper, accountKeeper AccountKeeper, bk BankKeeper) *Keeper {
+func NewKeeper(cdc *codec.CodecProxy, logger log.Logger, evmKeeper EVMKeeper, wasmKeeper WASMKeeper, accountKeeper AccountKeeper, bk BankKeeper, icqKeeper ICQKeeper) *Keeper {
 	logger = logger.With("module", types.ModuleName)
-	return &Keeper{cdc: cdc, logger: logger, evmKeeper: evmKeeper, wasmKeeper: wasmKeeper, accountKeeper: accountKeeper, bankKeeper: bk}
+	return &Keeper{cdc: cdc, logger: logger, evmKeeper: evmKeeper, wasmKeeper: wasmKeeper, accountKeeper: accountKeeper, bankKeeper: bk, icqKeeper: icqKeeper}
 }
 
 func (k Keeper) Logger() log.Logger {
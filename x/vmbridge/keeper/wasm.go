@@ -2,12 +2,18 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/okx/okbchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
 	ibcadapter "github.com/okx/okbchain/libs/cosmos-sdk/types/ibc-adapter"
+	icqtypes "github.com/okx/okbchain/libs/ibc-go/modules/apps/async-icq/types"
 	tmtypes "github.com/okx/okbchain/libs/tendermint/types"
 	"github.com/okx/okbchain/x/vmbridge/types"
 	"github.com/okx/okbchain/x/wasm"
@@ -100,7 +106,12 @@ func sendToEvmEncoder(cdc *codec.ProtoCodec) wasm.CustomEncoder {
 		if err := cdc.UnmarshalJSON(data, &sendToEvmMsg); err != nil {
 			var callToEvmMsg types.MsgCallToEvm
 			if err := cdc.UnmarshalJSON(data, &callToEvmMsg); err != nil {
-				return nil, err
+				// the wasm contracts send the interchain queries by the same custom message
+				var sendQueryMsg icqtypes.MsgSendQuery
+				if err := cdc.UnmarshalJSON(data, &sendQueryMsg); err != nil {
+					return nil, err
+				}
+				return []ibcadapter.Msg{&sendQueryMsg}, nil
 			}
 			return []ibcadapter.Msg{&callToEvmMsg}, nil
 		}
@@ -109,6 +120,54 @@ func sendToEvmEncoder(cdc *codec.ProtoCodec) wasm.CustomEncoder {
 	}
 }
 
+// RegisterEvmQuerier returns the wasm query plugins which let contracts read the evm state
+func RegisterEvmQuerier(evmKeeper EVMKeeper) *wasm.QueryPlugins {
+	return &wasm.QueryPlugins{
+		Custom: evmQuerier(evmKeeper),
+	}
+}
+
+func evmQuerier(evmKeeper EVMKeeper) wasm.CustomQuerier {
+	return func(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+		if !tmtypes.HigherThanEarth(ctx.BlockHeight()) {
+			errMsg := fmt.Sprintf("vmbridge not supprt at height %d", ctx.BlockHeight())
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+
+		var query types.EvmQuery
+		if err := json.Unmarshal(data, &query); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if query.StaticCall == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown evm query variant"}
+		}
+
+		req := query.StaticCall
+		if !sdk.IsETHAddress(req.Evmaddr) {
+			return nil, types.ErrIsNotETHAddr
+		}
+		contractAddr := common.HexToAddress(req.Evmaddr)
+		var callerAddr common.Address
+		if req.Sender != "" {
+			sender, err := sdk.WasmAddressFromBech32(req.Sender)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Sender)
+			}
+			callerAddr = common.BytesToAddress(sender.Bytes())
+		}
+		calldata, err := hex.DecodeString(strings.TrimPrefix(req.Calldata, "0x"))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		ret, err := staticCallEvm(ctx, evmKeeper, callerAddr, contractAddr, calldata)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(types.StaticCallResponse{Data: hex.EncodeToString(ret)})
+	}
+}
+
 type msgServer struct {
 	Keeper
 }
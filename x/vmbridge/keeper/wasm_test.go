@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -745,3 +746,109 @@ func (suite *KeeperTestSuite) TestMsgServer_CallToEvmEvent() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestEvmQuerier_StaticCall() {
+	balanceOf, err := suite.evmABI.Pack("balanceOf", common.BytesToAddress(suite.addr.Bytes()))
+	suite.Require().NoError(err)
+	mint, err := suite.evmABI.Pack("mint", common.BytesToAddress(suite.addr.Bytes()), big.NewInt(1))
+	suite.Require().NoError(err)
+	queryFormat := "{\"static_call\":{\"sender\":\"%s\",\"evmaddr\":\"%s\",\"calldata\":\"%s\"}}"
+	sender := sdk.AccToAWasmddress(suite.addr).String()
+
+	testCases := []struct {
+		msg      string
+		query    string
+		gasLimit uint64
+		error    error
+		expect   *big.Int
+		panics   bool
+	}{
+		{
+			"erc20 balanceOf",
+			fmt.Sprintf(queryFormat, sender, suite.evmContract.String(), hex.EncodeToString(balanceOf)),
+			1000000,
+			nil,
+			big.NewInt(1000),
+			false,
+		},
+		{
+			"erc20 balanceOf without sender",
+			fmt.Sprintf("{\"static_call\":{\"evmaddr\":\"%s\",\"calldata\":\"0x%s\"}}", suite.evmContract.String(), hex.EncodeToString(balanceOf)),
+			1000000,
+			nil,
+			big.NewInt(1000),
+			false,
+		},
+		{
+			"state modification is rejected",
+			fmt.Sprintf(queryFormat, sender, suite.evmContract.String(), hex.EncodeToString(mint)),
+			1000000,
+			errors.New("write protection"),
+			nil,
+			false,
+		},
+		{
+			"out of gas",
+			fmt.Sprintf(queryFormat, sender, suite.evmContract.String(), hex.EncodeToString(balanceOf)),
+			100,
+			nil,
+			nil,
+			true,
+		},
+		{
+			"evmaddr is not 0x",
+			fmt.Sprintf(queryFormat, sender, sdk.AccAddress(suite.evmContract.Bytes()).String(), hex.EncodeToString(balanceOf)),
+			1000000,
+			types.ErrIsNotETHAddr,
+			nil,
+			false,
+		},
+		{
+			"invalid sender",
+			fmt.Sprintf(queryFormat, "0x12", suite.evmContract.String(), hex.EncodeToString(balanceOf)),
+			1000000,
+			sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "0x12"),
+			nil,
+			false,
+		},
+		{
+			"unknown query",
+			"{\"unknown\":{}}",
+			1000000,
+			errors.New("unsupported request: unknown evm query variant"),
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx.SetGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			querier := keeper.RegisterEvmQuerier(suite.app.EvmKeeper).Custom
+
+			if tc.panics {
+				// gas is charged to the gas meter of the query context
+				suite.Require().Panics(func() {
+					querier(ctx, []byte(tc.query))
+				})
+				return
+			}
+			result, err := querier(ctx, []byte(tc.query))
+			if tc.error != nil {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.error.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(ctx.GasMeter().GasConsumed() > 0)
+
+			var response types.StaticCallResponse
+			suite.Require().NoError(json.Unmarshal(result, &response))
+			data, err := hex.DecodeString(response.Data)
+			suite.Require().NoError(err)
+			r, err := suite.evmABI.Unpack("balanceOf", data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expect, r[0].(*big.Int))
+		})
+	}
+}
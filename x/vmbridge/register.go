@@ -11,6 +11,9 @@ func RegisterServices(cfg module.Configurator, keeper keeper.Keeper) {
 	RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(keeper))
 }
 
-func GetWasmOpts(cdc *codec.ProtoCodec) wasm.Option {
-	return wasm.WithMessageEncoders(RegisterSendToEvmEncoder(cdc))
+func GetWasmOpts(cdc *codec.ProtoCodec, evmKeeper keeper.EVMKeeper) []wasm.Option {
+	return []wasm.Option{
+		wasm.WithMessageEncoders(RegisterSendToEvmEncoder(cdc)),
+		wasm.WithQueryPlugins(RegisterEvmQuerier(evmKeeper)),
+	}
 }
@@ -1,8 +1,9 @@
 package types
 
 const (
-	EventTypeWasmCallEvm = "wasm_call_evm"
-	EventTypeEvmCallWasm = "evm_call_wasm"
-	EventTypeEvmSendWasm = "evm_send_wasm"
-	AttributeResult      = "result"
+	EventTypeWasmCallEvm            = "wasm_call_evm"
+	EventTypeEvmCallWasm            = "evm_call_wasm"
+	EventTypeEvmSendWasm            = "evm_send_wasm"
+	EventTypeEvmSendInterchainQuery = "evm_send_interchain_query"
+	AttributeResult                 = "result"
 )
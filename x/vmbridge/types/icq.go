@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/auth/types"
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+const (
+	// IcqEvmModuleName is the caller of the evm contracts when the interchain query results are delivered
+	IcqEvmModuleName = "icq-evm"
+)
+
+var (
+	IcqEvmModuleETHAddr  common.Address
+	IcqEvmModuleBechAddr sdk.AccAddress
+)
+
+func init() {
+	IcqEvmModuleBechAddr = authtypes.NewModuleAddress(IcqEvmModuleName)
+	IcqEvmModuleETHAddr = common.BytesToAddress(IcqEvmModuleBechAddr.Bytes())
+}
+
+// InterchainQueryResult is the result of an interchain query delivered to the wasm contract by sudo
+type InterchainQueryResult struct {
+	ChannelID string   `json:"channel_id"`
+	Sequence  uint64   `json:"sequence"`
+	Success   bool     `json:"success"`
+	Responses [][]byte `json:"responses"`
+	Error     string   `json:"error"`
+}
+
+func getResponseValues(responses []abci.ResponseQuery) [][]byte {
+	values := make([][]byte, len(responses))
+	for i, resp := range responses {
+		values[i] = resp.Value
+	}
+	return values
+}
+
+// GetInterchainQueryResultSudoMsg returns the sudo msg which delivers the interchain query result to the wasm contract
+func GetInterchainQueryResultSudoMsg(channelID string, sequence uint64, responses []abci.ResponseQuery, errMsg string) ([]byte, error) {
+	result := InterchainQueryResult{
+		ChannelID: channelID,
+		Sequence:  sequence,
+		Success:   errMsg == "",
+		Responses: getResponseValues(responses),
+		Error:     errMsg,
+	}
+	input := struct {
+		Result InterchainQueryResult `json:"interchain_query_result"`
+	}{
+		Result: result,
+	}
+	return json.Marshal(input)
+}
+
+// GetInterchainQueryResultInput returns the input of the evm contract method which receives the interchain query result
+func GetInterchainQueryResultInput(channelID string, sequence uint64, responses []abci.ResponseQuery, errMsg string) ([]byte, error) {
+	return EvmABI.Pack(EvmQueryCallbackMethodName, channelID, sequence, errMsg == "", getResponseValues(responses), errMsg)
+}
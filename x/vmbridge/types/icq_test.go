@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/okx/okbchain/libs/tendermint/abci/types"
+)
+
+func TestGetInterchainQueryResultSudoMsg(t *testing.T) {
+	msg, err := GetInterchainQueryResultSudoMsg("channel-0", 3, []abci.ResponseQuery{{Value: []byte("value")}}, "")
+	require.NoError(t, err)
+	require.Equal(t, `{"interchain_query_result":{"channel_id":"channel-0","sequence":3,"success":true,"responses":["dmFsdWU="],"error":""}}`, string(msg))
+
+	msg, err = GetInterchainQueryResultSudoMsg("channel-0", 4, nil, "packet timed out")
+	require.NoError(t, err)
+	require.Equal(t, `{"interchain_query_result":{"channel_id":"channel-0","sequence":4,"success":false,"responses":[],"error":"packet timed out"}}`, string(msg))
+}
+
+func TestGetInterchainQueryResultInput(t *testing.T) {
+	input, err := GetInterchainQueryResultInput("channel-0", 3, []abci.ResponseQuery{{Value: []byte("value")}}, "")
+	require.NoError(t, err)
+
+	method := EvmABI.Methods[EvmQueryCallbackMethodName]
+	require.Equal(t, method.ID, input[:4])
+	args, err := method.Inputs.Unpack(input[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"channel-0", uint64(3), true, [][]byte{[]byte("value")}, ""}, args)
+}
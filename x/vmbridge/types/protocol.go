@@ -20,6 +20,9 @@ const (
 	CallToWasmEventName = "__OKBCCallToWasm"
 
 	WasmEvent2EvmMsgName = "call-to-wasm"
+
+	SendInterchainQueryEventName = "__OKBCSendInterchainQuery"
+	EvmQueryCallbackMethodName   = "onInterchainQueryResult"
 )
 
 var (
@@ -31,6 +34,10 @@ var (
 	// `event __OKBCCallToWasm(string wasmAddr,uint256 value, string calldata)`
 	CallToWasmEvent abi.Event
 
+	// SendInterchainQueryEvent represent the signature of
+	// `event __OKBCSendInterchainQuery(string channelId, string[] paths, bytes[] data, uint64 timeoutTimestamp)`
+	SendInterchainQueryEvent abi.Event
+
 	EvmABI abi.ABI
 	//go:embed abi.json
 	abiJson []byte
@@ -38,6 +45,7 @@ var (
 
 func init() {
 	EvmABI, SendToWasmEvent, CallToWasmEvent = GetEVMABIConfig(abiJson)
+	SendInterchainQueryEvent = EvmABI.Events[SendInterchainQueryEventName]
 }
 
 type MintCW20Method struct {
@@ -0,0 +1,22 @@
+package types
+
+// EvmQuery is the custom query a wasm contract sends to read the state of the evm
+type EvmQuery struct {
+	StaticCall *StaticCallQuery `json:"static_call,omitempty"`
+}
+
+// StaticCallQuery runs a read-only call of an evm contract, e.g. an erc20 balanceOf or any view function
+type StaticCallQuery struct {
+	// Sender is the msg.sender seen by the evm contract, the zero address is used if it is empty
+	Sender string `json:"sender,omitempty"`
+	// Evmaddr is the 0x address of the evm contract
+	Evmaddr string `json:"evmaddr"`
+	// Calldata is the hex encoded abi input of the call
+	Calldata string `json:"calldata"`
+}
+
+// StaticCallResponse is the response of a StaticCallQuery
+type StaticCallResponse struct {
+	// Data is the hex encoded abi output of the call
+	Data string `json:"data"`
+}
@@ -95,6 +95,7 @@ var (
 	NecessaryProposals               = types.NecessaryProposals
 	ContractCodeHistoryElementPrefix = types.ContractCodeHistoryElementPrefix
 	WithMessageEncoders              = keeper.WithMessageEncoders
+	WithQueryPlugins                 = keeper.WithQueryPlugins
 	SetNeedParamsUpdate              = keeper.SetNeedParamsUpdate
 )
 
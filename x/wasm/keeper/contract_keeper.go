@@ -24,6 +24,7 @@ type decoratedKeeper interface {
 	updateContractMethodBlockedList(ctx sdk.Context, blockedMethods *types.ContractMethods, isDelete bool) error
 
 	GetParams(ctx sdk.Context) types.Params
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.WasmAddress) *types.ContractInfo
 	newQueryHandler(ctx sdk.Context, contractAddress sdk.WasmAddress) QueryHandler
 	runtimeGasForContract(ctx sdk.Context) uint64
 	InvokeExtraProposal(ctx sdk.Context, action string, extra string) error
@@ -105,6 +106,10 @@ func (p PermissionedKeeper) GetParams(ctx sdk.Context) types.Params {
 	return p.nested.GetParams(ctx)
 }
 
+func (p PermissionedKeeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.WasmAddress) *types.ContractInfo {
+	return p.nested.GetContractInfo(ctx, contractAddress)
+}
+
 func (p PermissionedKeeper) NewQueryHandler(ctx sdk.Context, contractAddress sdk.WasmAddress) wasmvmtypes.Querier {
 	return p.nested.newQueryHandler(ctx, contractAddress)
 }
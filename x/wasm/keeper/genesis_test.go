@@ -711,7 +711,7 @@ func setupKeeper(t *testing.T) (*Keeper, sdk.Context, []sdk.StoreKey) {
 	}
 
 	accountKeeper := auth.NewAccountKeeper(encodingConfig.Amino, keyMpt, subspace(authtypes.ModuleName), chain.ProtoAccount)
-	srcKeeper := NewKeeper(&encodingConfig.Marshaler, keyWasm, keyMpt, subspace(wasmTypes.ModuleName), &accountKeeper, nil, nil, nil, nil, nil, nil, nil, tempDir, wasmConfig, SupportedFeatures)
+	srcKeeper := NewKeeper(&encodingConfig.Marshaler, keyWasm, keyMpt, subspace(wasmTypes.ModuleName), &accountKeeper, nil, nil, nil, nil, nil, nil, nil, nil, nil, tempDir, wasmConfig, SupportedFeatures)
 
 	return &srcKeeper, ctx, []sdk.StoreKey{keyWasm, keyParams, keyMpt}
 }
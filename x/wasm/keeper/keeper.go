@@ -112,7 +112,8 @@ func NewKeeper(
 	paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
-	//distKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
+	distKeeper types.DistributionKeeper,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	capabilityKeeper types.CapabilityKeeper,
@@ -133,7 +134,9 @@ func NewKeeper(
 	wasmMptStorageKey = storageKey
 	*wasmAccountKeeper = accountKeeper
 	*WasmbankKeeper = bankKeeper
-	k := newKeeper(cdc, storeKey, storageKey, paramSpace, accountKeeper, bankKeeper, channelKeeper, portKeeper, capabilityKeeper, portSource, router, queryRouter, homeDir, wasmConfig, supportedFeatures, defaultAdapter{}, opts...)
+	*wasmStakingKeeper = stakingKeeper
+	*wasmDistKeeper = distKeeper
+	k := newKeeper(cdc, storeKey, storageKey, paramSpace, accountKeeper, bankKeeper, stakingKeeper, distKeeper, channelKeeper, portKeeper, capabilityKeeper, portSource, router, queryRouter, homeDir, wasmConfig, supportedFeatures, defaultAdapter{}, opts...)
 	*wasmGasRegister = k.gasRegister
 	accountKeeper.SetObserverKeeper(k)
 
@@ -144,10 +147,14 @@ var (
 	nilwasmGasRegister = GasRegister(nil)
 	nilAccountKeeper   = types.AccountKeeper(nil)
 	nilBankKeeper      = types.BankKeeper(nil)
+	nilStakingKeeper   = types.StakingKeeper(nil)
+	nilDistKeeper      = types.DistributionKeeper(nil)
 	wasmStorageKey     = sdk.StoreKey(sdk.NewKVStoreKey("wasm")) // need reset by NewKeeper
 	wasmMptStorageKey  = sdk.StoreKey(sdk.NewKVStoreKey("mpt"))  //need reset by NewKeeper
 	wasmAccountKeeper  = &nilAccountKeeper                       //need reset by NewKeeper
 	WasmbankKeeper     = &nilBankKeeper
+	wasmStakingKeeper  = &nilStakingKeeper //need reset by NewKeeper
+	wasmDistKeeper     = &nilDistKeeper    //need reset by NewKeeper
 	wasmGasRegister    = &nilwasmGasRegister
 )
 
@@ -167,7 +174,7 @@ func NewSimulateKeeper(
 	supportedFeatures string,
 	opts ...Option,
 ) Keeper {
-	k := newKeeper(cdc, wasmStorageKey, wasmMptStorageKey, paramSpace, *wasmAccountKeeper, *WasmbankKeeper, channelKeeper, portKeeper, capabilityKeeper, portSource, router, queryRouter, homeDir, wasmConfig, supportedFeatures, watcher.Adapter{}, opts...)
+	k := newKeeper(cdc, wasmStorageKey, wasmMptStorageKey, paramSpace, *wasmAccountKeeper, *WasmbankKeeper, *wasmStakingKeeper, *wasmDistKeeper, channelKeeper, portKeeper, capabilityKeeper, portSource, router, queryRouter, homeDir, wasmConfig, supportedFeatures, watcher.Adapter{}, opts...)
 	k.gasRegister = *wasmGasRegister
 	return k
 }
@@ -178,6 +185,8 @@ func newKeeper(cdc *codec.CodecProxy,
 	paramSpace types.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	distKeeper types.DistributionKeeper,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	capabilityKeeper types.CapabilityKeeper,
@@ -211,7 +220,7 @@ func newKeeper(cdc *codec.CodecProxy,
 		ada:               ada,
 		maxQueryStackSize: types.DefaultMaxQueryStackSize,
 	}
-	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, channelKeeper, queryRouter, keeper)
+	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper)
 	for _, o := range opts {
 		o.apply(keeper)
 	}
@@ -88,7 +88,7 @@ func TestConstructorOptions(t *testing.T) {
 			t.Cleanup(func() {
 				os.RemoveAll(tempDir)
 			})
-			k := NewKeeper(&cfg.Marshaler, nil, nil, params.NewSubspace(nil, nil, nil, ""), &authkeeper.AccountKeeper{}, nil, nil, nil, nil, nil, nil, nil, tempDir, types.DefaultWasmConfig(), SupportedFeatures, spec.srcOpt)
+			k := NewKeeper(&cfg.Marshaler, nil, nil, params.NewSubspace(nil, nil, nil, ""), &authkeeper.AccountKeeper{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, tempDir, types.DefaultWasmConfig(), SupportedFeatures, spec.srcOpt)
 			spec.verify(t, k)
 		})
 	}
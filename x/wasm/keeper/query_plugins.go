@@ -13,8 +13,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okx/okbchain/libs/cosmos-sdk/types/errors"
-	distributiontypes "github.com/okx/okbchain/libs/cosmos-sdk/x/distribution/types"
-	stakingtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/staking/types"
+	stakingtypes "github.com/okx/okbchain/x/staking/types"
 )
 
 type QueryHandler struct {
@@ -76,10 +75,10 @@ func (q QueryHandler) GasConsumed() uint64 {
 type CustomQuerier func(ctx sdk.Context, request json.RawMessage) ([]byte, error)
 
 type QueryPlugins struct {
-	Bank   func(ctx sdk.Context, request *wasmvmtypes.BankQuery) ([]byte, error)
-	Custom CustomQuerier
-	//IBC      func(ctx sdk.Context, caller sdk.WasmAddress, request *wasmvmtypes.IBCQuery) ([]byte, error)
-	//Staking  func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error)
+	Bank     func(ctx sdk.Context, request *wasmvmtypes.BankQuery) ([]byte, error)
+	Custom   CustomQuerier
+	IBC      func(ctx sdk.Context, caller sdk.WasmAddress, request *wasmvmtypes.IBCQuery) ([]byte, error)
+	Staking  func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error)
 	Stargate func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error)
 	Wasm     func(ctx sdk.Context, request *wasmvmtypes.WasmQuery) ([]byte, error)
 }
@@ -97,17 +96,17 @@ type wasmQueryKeeper interface {
 
 func DefaultQueryPlugins(
 	bank types.BankViewKeeper,
-	//staking types.StakingKeeper,
-	//distKeeper types.DistributionKeeper,
+	staking types.StakingKeeper,
+	distKeeper types.DistributionKeeper,
 	channelKeeper types.ChannelKeeper,
 	queryRouter GRPCQueryRouter,
 	wasm wasmQueryKeeper,
 ) QueryPlugins {
 	return QueryPlugins{
-		Bank:   BankQuerier(bank),
-		Custom: NoCustomQuerier,
-		//IBC:    IBCQuerier(wasm, channelKeeper),
-		//Staking:  StakingQuerier(staking, distKeeper),
+		Bank:     BankQuerier(bank),
+		Custom:   NoCustomQuerier,
+		IBC:      IBCQuerier(wasm, channelKeeper),
+		Staking:  StakingQuerier(staking, distKeeper),
 		Stargate: StargateQuerier(queryRouter),
 		Wasm:     WasmQuerier(wasm),
 	}
@@ -124,12 +123,12 @@ func (e QueryPlugins) Merge(o *QueryPlugins) QueryPlugins {
 	if o.Custom != nil {
 		e.Custom = o.Custom
 	}
-	//if o.IBC != nil {
-	//	e.IBC = o.IBC
-	//}
-	//if o.Staking != nil {
-	//	e.Staking = o.Staking
-	//}
+	if o.IBC != nil {
+		e.IBC = o.IBC
+	}
+	if o.Staking != nil {
+		e.Staking = o.Staking
+	}
 	if o.Stargate != nil {
 		e.Stargate = o.Stargate
 	}
@@ -148,12 +147,12 @@ func (e QueryPlugins) HandleQuery(ctx sdk.Context, caller sdk.WasmAddress, reque
 	if request.Custom != nil {
 		return e.Custom(ctx, request.Custom)
 	}
-	//if request.IBC != nil {
-	//	return e.IBC(ctx, caller, request.IBC)
-	//}
-	//if request.Staking != nil {
-	//	return e.Staking(ctx, request.Staking)
-	//}
+	if request.IBC != nil {
+		return e.IBC(ctx, caller, request.IBC)
+	}
+	if request.Staking != nil {
+		return e.Staking(ctx, request.Staking)
+	}
 	if request.Stargate != nil {
 		return e.Stargate(ctx, request.Stargate)
 	}
@@ -303,6 +302,10 @@ func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request
 //	}
 //}
 
+// StakingQuerier answers staking queries on top of the okbchain shares model: a delegator adds the
+// same shares to every validator it votes for, so each of those validators reports an equal share of
+// the delegated amount and the shares summed over all of them match the deposit. Shares can be moved
+// to other validators at any time, hence each share can always be redelegated.
 func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKeeper) func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
 		if request.BondedDenom != nil {
@@ -314,15 +317,9 @@ func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKee
 		}
 		if request.AllValidators != nil {
 			validators := keeper.GetBondedValidatorsByPower(ctx)
-			// validators := keeper.GetAllValidators(ctx)
 			wasmVals := make([]wasmvmtypes.Validator, len(validators))
 			for i, v := range validators {
-				wasmVals[i] = wasmvmtypes.Validator{
-					Address:       v.OperatorAddress.String(),
-					Commission:    v.Commission.Rate.String(),
-					MaxCommission: v.Commission.MaxRate.String(),
-					MaxChangeRate: v.Commission.MaxChangeRate.String(),
-				}
+				wasmVals[i] = sdkToWasmValidator(v)
 			}
 			res := wasmvmtypes.AllValidatorsResponse{
 				Validators: wasmVals,
@@ -337,12 +334,8 @@ func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKee
 			v, found := keeper.GetValidator(ctx, valAddr)
 			res := wasmvmtypes.ValidatorResponse{}
 			if found {
-				res.Validator = &wasmvmtypes.Validator{
-					Address:       v.OperatorAddress.String(),
-					Commission:    v.Commission.Rate.String(),
-					MaxCommission: v.Commission.MaxRate.String(),
-					MaxChangeRate: v.Commission.MaxChangeRate.String(),
-				}
+				wasmVal := sdkToWasmValidator(v)
+				res.Validator = &wasmVal
 			}
 			return json.Marshal(res)
 		}
@@ -351,10 +344,10 @@ func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKee
 			if err != nil {
 				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, request.AllDelegations.Delegator)
 			}
-			sdkDels := keeper.GetAllDelegatorDelegations(ctx, sdk.WasmToAccAddress(delegator))
-			delegations, err := sdkToDelegations(ctx, keeper, sdkDels)
-			if err != nil {
-				return nil, err
+			delegations := make(wasmvmtypes.Delegations, 0)
+			d, found := keeper.GetDelegator(ctx, sdk.WasmToAccAddress(delegator))
+			if found {
+				delegations = sdkToDelegations(ctx, keeper, d)
 			}
 			res := wasmvmtypes.AllDelegationsResponse{
 				Delegations: delegations,
@@ -372,11 +365,13 @@ func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKee
 			}
 
 			var res wasmvmtypes.DelegationResponse
-			d, found := keeper.GetDelegation(ctx, sdk.WasmToAccAddress(delegator), validator)
-			if found {
-				res.Delegation, err = sdkToFullDelegation(ctx, keeper, distKeeper, d)
-				if err != nil {
-					return nil, err
+			d, found := keeper.GetDelegator(ctx, sdk.WasmToAccAddress(delegator))
+			if found && hasAddedSharesTo(d, validator) {
+				if _, found := keeper.GetValidator(ctx, validator); found {
+					res.Delegation, err = sdkToFullDelegation(ctx, keeper, distKeeper, d, validator)
+					if err != nil {
+						return nil, err
+					}
 				}
 			}
 			return json.Marshal(res)
@@ -385,104 +380,85 @@ func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKee
 	}
 }
 
-func sdkToDelegations(ctx sdk.Context, keeper types.StakingKeeper, delegations []stakingtypes.Delegation) (wasmvmtypes.Delegations, error) {
-	result := make([]wasmvmtypes.Delegation, len(delegations))
-	bondDenom := keeper.BondDenom(ctx)
+func sdkToWasmValidator(v stakingtypes.Validator) wasmvmtypes.Validator {
+	return wasmvmtypes.Validator{
+		Address:       v.OperatorAddress.String(),
+		Commission:    v.Commission.Rate.String(),
+		MaxCommission: v.Commission.MaxRate.String(),
+		MaxChangeRate: v.Commission.MaxChangeRate.String(),
+	}
+}
 
-	for i, d := range delegations {
-		delAddr, err := sdk.WasmAddressFromBech32(d.DelegatorAddress.String())
-		if err != nil {
-			return nil, sdkerrors.Wrap(err, "delegator address")
-		}
-		valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress.String())
-		if err != nil {
-			return nil, sdkerrors.Wrap(err, "validator address")
+func hasAddedSharesTo(delegator stakingtypes.Delegator, valAddr sdk.ValAddress) bool {
+	for _, addr := range delegator.ValidatorAddresses {
+		if addr.Equals(valAddr) {
+			return true
 		}
+	}
+	return false
+}
 
-		// shares to amount logic comes from here:
-		// https://github.com/okx/okbchain/blob/v0.1.0/libs/cosmos-sdk/x/staking/keeper/querier.go#L404
-		val, found := keeper.GetValidator(ctx, valAddr)
-		if !found {
-			return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, "can't load validator for delegation")
+// delegatedAmount returns the tokens backing the shares a delegator added to a single validator,
+// including the tokens delegated to it when it is a proxy. Every voted validator holds the same
+// shares, so each of them is backed by an equal part of the tokens.
+func delegatedAmount(ctx sdk.Context, keeper types.StakingKeeper, delegator stakingtypes.Delegator) wasmvmtypes.Coin {
+	tokens := delegator.Tokens
+	if delegator.IsProxy {
+		tokens = tokens.Add(delegator.TotalDelegatedTokens)
+	}
+	if n := len(delegator.ValidatorAddresses); n > 0 {
+		tokens = tokens.QuoInt64(int64(n))
+	}
+	amount := sdk.NewDecCoinFromDec(keeper.BondDenom(ctx), tokens)
+	return ConvertSdkCoinToWasmCoin(sdk.CoinToCoinAdapter(amount))
+}
+
+func sdkToDelegations(ctx sdk.Context, keeper types.StakingKeeper, delegator stakingtypes.Delegator) wasmvmtypes.Delegations {
+	delAddr := sdk.AccToAWasmddress(delegator.DelegatorAddress)
+	amount := delegatedAmount(ctx, keeper, delegator)
+
+	result := make(wasmvmtypes.Delegations, 0, len(delegator.ValidatorAddresses))
+	for _, valAddr := range delegator.ValidatorAddresses {
+		// skip validators which have been removed since the shares were added
+		if _, found := keeper.GetValidator(ctx, valAddr); !found {
+			continue
 		}
-		amount := sdk.NewCoin(bondDenom, val.TokensFromShares(d.Shares).TruncateInt())
-		adapter := sdk.CoinToCoinAdapter(amount)
-		result[i] = wasmvmtypes.Delegation{
+		result = append(result, wasmvmtypes.Delegation{
 			Delegator: delAddr.String(),
 			Validator: valAddr.String(),
-			Amount:    ConvertSdkCoinToWasmCoin(adapter),
-		}
+			Amount:    amount,
+		})
 	}
-	return result, nil
+	return result
 }
 
-func sdkToFullDelegation(ctx sdk.Context, keeper types.StakingKeeper, distKeeper types.DistributionKeeper, delegation stakingtypes.Delegation) (*wasmvmtypes.FullDelegation, error) {
-	delAddr, err := sdk.WasmAddressFromBech32(delegation.DelegatorAddress.String())
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "delegator address")
-	}
-	valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress.String())
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "validator address")
-	}
-	val, found := keeper.GetValidator(ctx, valAddr)
-	if !found {
-		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, "can't load validator for delegation")
-	}
-	bondDenom := keeper.BondDenom(ctx)
-	amount := sdk.NewCoin(bondDenom, val.TokensFromShares(delegation.Shares).TruncateInt())
-	adapter := sdk.CoinToCoinAdapter(amount)
-	delegationCoins := ConvertSdkCoinToWasmCoin(adapter)
-
-	// FIXME: this is very rough but better than nothing...
-	// if this (val, delegate) pair is receiving a redelegation, it cannot redelegate more
-	// otherwise, it can redelegate the full amount
-	// (there are cases of partial funds redelegated, but this is a start)
-	redelegateCoins := wasmvmtypes.NewCoin(0, bondDenom)
-	if !keeper.HasReceivingRedelegation(ctx, sdk.WasmToAccAddress(delAddr), valAddr) {
-		redelegateCoins = delegationCoins
-	}
+func sdkToFullDelegation(ctx sdk.Context, keeper types.StakingKeeper, distKeeper types.DistributionKeeper, delegator stakingtypes.Delegator, valAddr sdk.ValAddress) (*wasmvmtypes.FullDelegation, error) {
+	amount := delegatedAmount(ctx, keeper, delegator)
 
-	// FIXME: make a cleaner way to do this (modify the sdk)
-	// we need the info from `distKeeper.calculateDelegationRewards()`, but it is not public
-	// neither is `queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper)`
-	// so we go through the front door of the querier....
-	accRewards, err := getAccumulatedRewards(ctx, distKeeper, delegation)
+	accRewards, err := getAccumulatedRewards(ctx, distKeeper, delegator.DelegatorAddress, valAddr)
 	if err != nil {
 		return nil, err
 	}
 
 	return &wasmvmtypes.FullDelegation{
-		Delegator:          delAddr.String(),
+		Delegator:          sdk.AccToAWasmddress(delegator.DelegatorAddress).String(),
 		Validator:          valAddr.String(),
-		Amount:             delegationCoins,
+		Amount:             amount,
 		AccumulatedRewards: accRewards,
-		CanRedelegate:      redelegateCoins,
+		CanRedelegate:      amount,
 	}, nil
 }
 
-// FIXME: simplify this enormously when
-func getAccumulatedRewards(ctx sdk.Context, distKeeper types.DistributionKeeper, delegation stakingtypes.Delegation) ([]wasmvmtypes.Coin, error) {
-	// Try to get *delegator* reward info!
-	params := distributiontypes.QueryDelegationRewardsParams{
-		DelegatorAddress: delegation.DelegatorAddress,
-		ValidatorAddress: delegation.ValidatorAddress,
-	}
+func getAccumulatedRewards(ctx sdk.Context, distKeeper types.DistributionKeeper, delAddr sdk.AccAddress, valAddr sdk.ValAddress) ([]wasmvmtypes.Coin, error) {
+	// the reward calculation moves the validator period, so never persist it
 	cache, _ := ctx.CacheContext()
-	qres, err := distKeeper.DelegationRewards(sdk.WrapSDKContext(cache), &params)
+	rewards, err := distKeeper.DelegationRewards(cache, delAddr, valAddr)
 	if err != nil {
 		return nil, err
 	}
 
 	// now we have it, convert it into wasmvm types
-	rewards := make([]wasmvmtypes.Coin, qres.Len())
-	for i, r := range *qres {
-		rewards[i] = wasmvmtypes.Coin{
-			Denom:  r.Denom,
-			Amount: r.Amount.TruncateInt().String(),
-		}
-	}
-	return rewards, nil
+	return ConvertSdkCoinsToWasmCoins(sdk.CoinsToCoinAdapters(rewards)), nil
 }
 
 func WasmQuerier(k wasmQueryKeeper) func(ctx sdk.Context, request *wasmvmtypes.WasmQuery) ([]byte, error) {
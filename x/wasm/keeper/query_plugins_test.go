@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	stakingtypes "github.com/okx/okbchain/x/staking/types"
 	"github.com/okx/okbchain/x/wasm/keeper/wasmtesting"
 	"github.com/okx/okbchain/x/wasm/types"
 )
@@ -318,6 +319,161 @@ func TestIBCQuerier(t *testing.T) {
 	}
 }
 
+func TestStakingQuerier(t *testing.T) {
+	valAddr := sdk.ValAddress(RandomAccountAddress(t))
+	otherValAddr := sdk.ValAddress(RandomAccountAddress(t))
+	removedValAddr := sdk.ValAddress(RandomAccountAddress(t))
+	delAddr := RandomAccountAddress(t)
+	proxyAddr := RandomAccountAddress(t)
+
+	myValidator := stakingtypes.Validator{
+		OperatorAddress: valAddr,
+		Status:          sdk.Bonded,
+		Commission:      stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+	}
+	otherValidator := stakingtypes.Validator{
+		OperatorAddress: otherValAddr,
+		Status:          sdk.Bonded,
+		Commission:      stakingtypes.NewCommission(sdk.NewDecWithPrec(5, 2), sdk.OneDec(), sdk.NewDecWithPrec(1, 1)),
+	}
+	delegators := map[string]stakingtypes.Delegator{
+		sdk.WasmToAccAddress(delAddr).String(): {
+			DelegatorAddress:     sdk.WasmToAccAddress(delAddr),
+			ValidatorAddresses:   []sdk.ValAddress{valAddr, removedValAddr, otherValAddr},
+			Shares:               sdk.NewDec(12),
+			Tokens:               sdk.NewDec(10),
+			TotalDelegatedTokens: sdk.ZeroDec(),
+		},
+		sdk.WasmToAccAddress(proxyAddr).String(): {
+			DelegatorAddress:     sdk.WasmToAccAddress(proxyAddr),
+			ValidatorAddresses:   []sdk.ValAddress{otherValAddr},
+			Shares:               sdk.NewDec(30),
+			Tokens:               sdk.NewDec(20),
+			IsProxy:              true,
+			TotalDelegatedTokens: sdk.NewDecWithPrec(55, 1),
+		},
+	}
+	stakingKeeper := stakingKeeperMock{
+		BondDenomFn: func(ctx sdk.Context) string {
+			return "okb"
+		},
+		GetValidatorFn: func(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+			for _, v := range []stakingtypes.Validator{myValidator, otherValidator} {
+				if v.OperatorAddress.Equals(addr) {
+					return v, true
+				}
+			}
+			return stakingtypes.Validator{}, false
+		},
+		GetBondedValidatorsByPowerFn: func(ctx sdk.Context) stakingtypes.Validators {
+			return stakingtypes.Validators{otherValidator, myValidator}
+		},
+		GetDelegatorFn: func(ctx sdk.Context, addr sdk.AccAddress) (stakingtypes.Delegator, bool) {
+			d, found := delegators[addr.String()]
+			return d, found
+		},
+	}
+	distKeeper := distKeeperMock{
+		DelegationRewardsFn: func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+			return sdk.NewDecCoinsFromDec("okb", sdk.NewDecWithPrec(15, 1)), nil
+		},
+	}
+
+	specs := map[string]struct {
+		srcQuery      *wasmvmtypes.StakingQuery
+		distKeeper    types.DistributionKeeper
+		expJsonResult string
+		expErr        *sdkerrors.Error
+	}{
+		"bonded denom": {
+			srcQuery:      &wasmvmtypes.StakingQuery{BondedDenom: &struct{}{}},
+			expJsonResult: `{"denom":"okb"}`,
+		},
+		"all validators": {
+			srcQuery: &wasmvmtypes.StakingQuery{AllValidators: &wasmvmtypes.AllValidatorsQuery{}},
+			expJsonResult: `{"validators":[
+  {"address":"` + otherValAddr.String() + `","commission":"0.050000000000000000","max_commission":"1.000000000000000000","max_change_rate":"0.100000000000000000"},
+  {"address":"` + valAddr.String() + `","commission":"0.100000000000000000","max_commission":"0.200000000000000000","max_change_rate":"0.010000000000000000"}
+]}`,
+		},
+		"validator": {
+			srcQuery: &wasmvmtypes.StakingQuery{Validator: &wasmvmtypes.ValidatorQuery{Address: valAddr.String()}},
+			expJsonResult: `{"validator":
+  {"address":"` + valAddr.String() + `","commission":"0.100000000000000000","max_commission":"0.200000000000000000","max_change_rate":"0.010000000000000000"}
+}`,
+		},
+		"validator - unknown": {
+			srcQuery:      &wasmvmtypes.StakingQuery{Validator: &wasmvmtypes.ValidatorQuery{Address: removedValAddr.String()}},
+			expJsonResult: `{"validator":null}`,
+		},
+		"all delegations - splits the deposit and skips removed validators": {
+			srcQuery: &wasmvmtypes.StakingQuery{AllDelegations: &wasmvmtypes.AllDelegationsQuery{Delegator: delAddr.String()}},
+			expJsonResult: `{"delegations":[
+  {"delegator":"` + delAddr.String() + `","validator":"` + valAddr.String() + `","amount":{"denom":"okb","amount":"3333333333333333333"}},
+  {"delegator":"` + delAddr.String() + `","validator":"` + otherValAddr.String() + `","amount":{"denom":"okb","amount":"3333333333333333333"}}
+]}`,
+		},
+		"all delegations - unknown delegator": {
+			srcQuery:      &wasmvmtypes.StakingQuery{AllDelegations: &wasmvmtypes.AllDelegationsQuery{Delegator: RandomBech32AccountAddress(t)}},
+			expJsonResult: `{"delegations":[]}`,
+		},
+		"all delegations - invalid address": {
+			srcQuery: &wasmvmtypes.StakingQuery{AllDelegations: &wasmvmtypes.AllDelegationsQuery{Delegator: "invalid"}},
+			expErr:   sdkerrors.ErrInvalidAddress,
+		},
+		"delegation": {
+			srcQuery:   &wasmvmtypes.StakingQuery{Delegation: &wasmvmtypes.DelegationQuery{Delegator: delAddr.String(), Validator: valAddr.String()}},
+			distKeeper: distKeeper,
+			expJsonResult: `{"delegation":{
+  "delegator":"` + delAddr.String() + `","validator":"` + valAddr.String() + `",
+  "amount":{"denom":"okb","amount":"3333333333333333333"},
+  "accumulated_rewards":[{"denom":"okb","amount":"1500000000000000000"}],
+  "can_redelegate":{"denom":"okb","amount":"3333333333333333333"}
+}}`,
+		},
+		"delegation - proxy includes delegated tokens": {
+			srcQuery:   &wasmvmtypes.StakingQuery{Delegation: &wasmvmtypes.DelegationQuery{Delegator: proxyAddr.String(), Validator: otherValAddr.String()}},
+			distKeeper: distKeeper,
+			expJsonResult: `{"delegation":{
+  "delegator":"` + proxyAddr.String() + `","validator":"` + otherValAddr.String() + `",
+  "amount":{"denom":"okb","amount":"25500000000000000000"},
+  "accumulated_rewards":[{"denom":"okb","amount":"1500000000000000000"}],
+  "can_redelegate":{"denom":"okb","amount":"25500000000000000000"}
+}}`,
+		},
+		"delegation - no shares added to validator": {
+			srcQuery:      &wasmvmtypes.StakingQuery{Delegation: &wasmvmtypes.DelegationQuery{Delegator: proxyAddr.String(), Validator: valAddr.String()}},
+			expJsonResult: `{}`,
+		},
+		"delegation - removed validator": {
+			srcQuery:      &wasmvmtypes.StakingQuery{Delegation: &wasmvmtypes.DelegationQuery{Delegator: delAddr.String(), Validator: removedValAddr.String()}},
+			expJsonResult: `{}`,
+		},
+		"delegation - rewards error": {
+			srcQuery: &wasmvmtypes.StakingQuery{Delegation: &wasmvmtypes.DelegationQuery{Delegator: delAddr.String(), Validator: valAddr.String()}},
+			distKeeper: distKeeperMock{
+				DelegationRewardsFn: func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+					return nil, sdkerrors.ErrUnknownRequest
+				},
+			},
+			expErr: sdkerrors.ErrUnknownRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			ctx.SetMultiStore(store.NewCommitMultiStore(dbm.NewMemDB()))
+			h := StakingQuerier(stakingKeeper, spec.distKeeper)
+			gotResult, gotErr := h(ctx, spec.srcQuery)
+			require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				return
+			}
+			assert.JSONEq(t, spec.expJsonResult, string(gotResult), string(gotResult))
+		})
+	}
+}
+
 func TestBankQuerierBalance(t *testing.T) {
 	mock := bankKeeperMock{GetBalanceFn: func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 		return sdk.NewCoin(denom, sdk.NewInt(1))
@@ -530,3 +686,49 @@ func (m bankKeeperMock) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk
 	}
 	return m.GetAllBalancesFn(ctx, addr)
 }
+
+type stakingKeeperMock struct {
+	BondDenomFn                  func(ctx sdk.Context) string
+	GetValidatorFn               func(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	GetBondedValidatorsByPowerFn func(ctx sdk.Context) stakingtypes.Validators
+	GetDelegatorFn               func(ctx sdk.Context, delAddr sdk.AccAddress) (stakingtypes.Delegator, bool)
+}
+
+func (m stakingKeeperMock) BondDenom(ctx sdk.Context) string {
+	if m.BondDenomFn == nil {
+		panic("not expected to be called")
+	}
+	return m.BondDenomFn(ctx)
+}
+
+func (m stakingKeeperMock) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	if m.GetValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetValidatorFn(ctx, addr)
+}
+
+func (m stakingKeeperMock) GetBondedValidatorsByPower(ctx sdk.Context) stakingtypes.Validators {
+	if m.GetBondedValidatorsByPowerFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetBondedValidatorsByPowerFn(ctx)
+}
+
+func (m stakingKeeperMock) GetDelegator(ctx sdk.Context, delAddr sdk.AccAddress) (stakingtypes.Delegator, bool) {
+	if m.GetDelegatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetDelegatorFn(ctx, delAddr)
+}
+
+type distKeeperMock struct {
+	DelegationRewardsFn func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error)
+}
+
+func (m distKeeperMock) DelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	if m.DelegationRewardsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DelegationRewardsFn(ctx, delAddr, valAddr)
+}
@@ -1,17 +1,14 @@
 package types
 
 import (
-	"context"
-
 	sdk "github.com/okx/okbchain/libs/cosmos-sdk/types"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/auth"
 	capabilitytypes "github.com/okx/okbchain/libs/cosmos-sdk/x/capability/types"
-	"github.com/okx/okbchain/libs/cosmos-sdk/x/distribution/types"
 	"github.com/okx/okbchain/libs/cosmos-sdk/x/params"
-	stakingtypes "github.com/okx/okbchain/libs/cosmos-sdk/x/staking/types"
 	connectiontypes "github.com/okx/okbchain/libs/ibc-go/modules/core/03-connection/types"
 	channeltypes "github.com/okx/okbchain/libs/ibc-go/modules/core/04-channel/types"
 	ibcexported "github.com/okx/okbchain/libs/ibc-go/modules/core/exported"
+	stakingtypes "github.com/okx/okbchain/x/staking/types"
 )
 
 // BankViewKeeper defines a subset of methods implemented by the cosmos-sdk bank keeper
@@ -42,27 +39,22 @@ type AccountKeeper interface {
 	SetObserverKeeper(observer auth.ObserverI)
 }
 
-// DistributionKeeper defines a subset of methods implemented by the cosmos-sdk distribution keeper
+// DistributionKeeper defines a subset of methods implemented by the okbchain distribution keeper
 type DistributionKeeper interface {
-	DelegationRewards(c context.Context, req *types.QueryDelegationRewardsParams) (*sdk.DecCoins, error)
+	// DelegationRewards calculates the rewards accrued by a delegator from a validator it added shares to
+	DelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error)
 }
 
-// StakingKeeper defines a subset of methods implemented by the cosmos-sdk staking keeper
+// StakingKeeper defines a subset of methods implemented by the okbchain staking keeper
 type StakingKeeper interface {
 	// BondDenom - Bondable coin denomination
 	BondDenom(ctx sdk.Context) (res string)
 	// GetValidator get a single validator
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
 	// GetBondedValidatorsByPower get the current group of bonded validators sorted by power-rank
-	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
-	// GetAllDelegatorDelegations return all delegations for a delegator
-	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
-	// GetDelegation return a specific delegation
-	GetDelegation(ctx sdk.Context,
-		delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
-	// HasReceivingRedelegation check if validator is receiving a redelegation
-	HasReceivingRedelegation(ctx sdk.Context,
-		delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) bool
+	GetBondedValidatorsByPower(ctx sdk.Context) stakingtypes.Validators
+	// GetDelegator get the delegator with the shares it added to validators
+	GetDelegator(ctx sdk.Context, delAddr sdk.AccAddress) (delegator stakingtypes.Delegator, found bool)
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
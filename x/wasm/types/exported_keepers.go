@@ -56,6 +56,9 @@ type ContractOpsKeeper interface {
 	// UnpinCode removes the wasm contract from wasmvm cache
 	UnpinCode(ctx sdk.Context, codeID uint64) error
 
+	// GetContractInfo returns the contract info of the given address, nil if it is not a contract
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.WasmAddress) *ContractInfo
+
 	// SetContractInfoExtension updates the extension point data that is stored with the contract info
 	SetContractInfoExtension(ctx sdk.Context, contract sdk.WasmAddress, extra ContractInfoExtension) error
 